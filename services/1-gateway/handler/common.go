@@ -7,7 +7,8 @@ import (
 	"github.com/gofiber/fiber/v2"
 )
 
-func sendHttpReqToAnotherService(c *fiber.Ctx, url string) (int, []byte, []error) {
+// NOTE: forwardHeaders ARE COPIED AS IS, E.G. THE SIGNATURE OF A PROVIDER WEBHOOK
+func sendHttpReqToAnotherService(c *fiber.Ctx, url string, forwardHeaders ...string) (int, []byte, []error) {
 	a := fiber.AcquireAgent()
 	a.Debug()
 
@@ -16,6 +17,11 @@ func sendHttpReqToAnotherService(c *fiber.Ctx, url string) (int, []byte, []error
 	req.SetRequestURI(url)
 	gatewayToken, _ := c.UserContext().Value("gatewayToken").(string)
 	req.Header.Add("gatewayToken", gatewayToken)
+	for _, h := range forwardHeaders {
+		if v := c.Get(h); v != "" {
+			req.Header.Add(h, v)
+		}
+	}
 
 	tokenStr := c.Cookies("token", "")
 	if tokenStr == "" {
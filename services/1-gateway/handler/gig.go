@@ -110,7 +110,7 @@ func (gh *GigHandler) FindSimilarGigs(c *fiber.Ctx) error {
 }
 
 func (gh *GigHandler) GigQuerySearch(c *fiber.Ctx) error {
-	query := fmt.Sprintf("query=%v&max=%v&delivery_time=%v", c.Query("query"), c.QueryInt("max"), c.QueryInt("delivery_time"))
+	query := fmt.Sprintf("query=%v&max=%v&delivery_time=%v&seller_level=%v", c.Query("query"), c.QueryInt("max"), c.QueryInt("delivery_time"), c.Query("seller_level"))
 	route := gh.base_url + fmt.Sprintf("/api/v1/gigs/search/%s/%s?%s", c.Params("page"), c.Params("size"), query)
 	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
 	if len(errs) > 0 {
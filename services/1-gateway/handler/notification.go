@@ -0,0 +1,437 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+type NotificationHandler struct {
+	base_url string
+}
+
+func NewNotificationHandler(base_url string) *NotificationHandler {
+	return &NotificationHandler{
+		base_url: base_url,
+	}
+}
+
+func (nh *NotificationHandler) HealthCheck(c *fiber.Ctx) error {
+	route := nh.base_url + "/health-check"
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("NOTIFICATION - health check error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).JSON(fiber.Map{
+		"response": string(body),
+	})
+}
+
+func (nh *NotificationHandler) FindOutboxes(c *fiber.Ctx) error {
+	route := nh.base_url + fmt.Sprintf("/api/v1/notifications/admin/outbox/%s/%s/%s", c.Params("status"), c.Params("page"), c.Params("size"))
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("NOTIFICATION - find outboxes error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (nh *NotificationHandler) FindOutboxByID(c *fiber.Ctx) error {
+	route := nh.base_url + fmt.Sprintf("/api/v1/notifications/admin/outbox/id/%s", c.Params("id"))
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("NOTIFICATION - find outbox by id error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (nh *NotificationHandler) ReplayOutbox(c *fiber.Ctx) error {
+	route := nh.base_url + fmt.Sprintf("/api/v1/notifications/admin/outbox/%s/replay", c.Params("id"))
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("NOTIFICATION - replay outbox error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (nh *NotificationHandler) ReplayAllDeadOutboxes(c *fiber.Ctx) error {
+	route := nh.base_url + "/api/v1/notifications/admin/outbox/replay-dead"
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("NOTIFICATION - replay dead outboxes error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (nh *NotificationHandler) FindTemplates(c *fiber.Ctx) error {
+	route := nh.base_url + "/api/v1/notifications/admin/templates"
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("NOTIFICATION - find templates error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (nh *NotificationHandler) PreviewTemplate(c *fiber.Ctx) error {
+	format := c.Params("format", "json")
+	route := nh.base_url + fmt.Sprintf("/api/v1/notifications/admin/templates/%s/locales/%s/preview/%s", c.Params("name"), c.Params("locale", "en"), format)
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("NOTIFICATION - preview template error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	//NOTE: THE PROXIED RESPONSE DOES NOT CARRY ITS CONTENT TYPE
+	if statusCode == fiber.StatusOK {
+		switch format {
+		case "html":
+			c.Type("html", "utf-8")
+		case "text":
+			c.Type("txt", "utf-8")
+		}
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (nh *NotificationHandler) FindMyNotifications(c *fiber.Ctx) error {
+	route := nh.base_url + fmt.Sprintf("/api/v1/notifications/me/%s/%s/%s", c.Params("filter"), c.Params("page"), c.Params("size"))
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("NOTIFICATION - find my notifications error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (nh *NotificationHandler) CountMyUnreadNotifications(c *fiber.Ctx) error {
+	route := nh.base_url + "/api/v1/notifications/me/unread-count"
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("NOTIFICATION - count my unread notifications error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (nh *NotificationHandler) MarkMyNotificationAsRead(c *fiber.Ctx) error {
+	route := nh.base_url + fmt.Sprintf("/api/v1/notifications/me/%s/read", c.Params("id"))
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("NOTIFICATION - mark my notification as read error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (nh *NotificationHandler) MarkAllMyNotificationsAsRead(c *fiber.Ctx) error {
+	route := nh.base_url + "/api/v1/notifications/me/read-all"
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("NOTIFICATION - mark all my notifications as read error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+// PushNotification is called by the notification service to forward a new
+// in-app notification to the receiver's WebSocket connection.
+func PushNotification(c *fiber.Ctx) error {
+	var req struct {
+		ReceiverEmail string          `json:"receiverEmail"`
+		Data          json.RawMessage `json:"data"`
+	}
+	if err := c.BodyParser(&req); err != nil || req.ReceiverEmail == "" || len(req.Data) == 0 {
+		return fiber.NewError(fiber.StatusBadRequest, "invalid push payload")
+	}
+
+	go SendMessageToEmail(req.ReceiverEmail, req.Data)
+
+	return c.SendStatus(fiber.StatusAccepted)
+}
+
+func (nh *NotificationHandler) FindMyPreferences(c *fiber.Ctx) error {
+	route := nh.base_url + "/api/v1/notifications/preferences"
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("NOTIFICATION - find my preferences error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (nh *NotificationHandler) UpdateMyPreferences(c *fiber.Ctx) error {
+	route := nh.base_url + "/api/v1/notifications/preferences"
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("NOTIFICATION - update my preferences error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (nh *NotificationHandler) FindMyLocale(c *fiber.Ctx) error {
+	route := nh.base_url + "/api/v1/notifications/preferences/locale"
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("NOTIFICATION - find my locale error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (nh *NotificationHandler) UpdateMyLocale(c *fiber.Ctx) error {
+	route := nh.base_url + "/api/v1/notifications/preferences/locale"
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("NOTIFICATION - update my locale error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (nh *NotificationHandler) FindMyWebhooks(c *fiber.Ctx) error {
+	route := nh.base_url + "/api/v1/notifications/webhooks"
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("NOTIFICATION - find my webhooks error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (nh *NotificationHandler) FindWebhookEvents(c *fiber.Ctx) error {
+	route := nh.base_url + "/api/v1/notifications/webhooks/events"
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("NOTIFICATION - find webhook events error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (nh *NotificationHandler) CreateWebhook(c *fiber.Ctx) error {
+	route := nh.base_url + "/api/v1/notifications/webhooks"
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("NOTIFICATION - create webhook error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (nh *NotificationHandler) UpdateWebhook(c *fiber.Ctx) error {
+	route := nh.base_url + fmt.Sprintf("/api/v1/notifications/webhooks/%s", c.Params("id"))
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("NOTIFICATION - update webhook error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (nh *NotificationHandler) DeleteWebhook(c *fiber.Ctx) error {
+	route := nh.base_url + fmt.Sprintf("/api/v1/notifications/webhooks/%s", c.Params("id"))
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("NOTIFICATION - delete webhook error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (nh *NotificationHandler) RotateWebhookSecret(c *fiber.Ctx) error {
+	route := nh.base_url + fmt.Sprintf("/api/v1/notifications/webhooks/%s/rotate-secret", c.Params("id"))
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("NOTIFICATION - rotate webhook secret error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (nh *NotificationHandler) FindWebhookDeliveries(c *fiber.Ctx) error {
+	route := nh.base_url + fmt.Sprintf("/api/v1/notifications/webhooks/%s/deliveries/%s/%s", c.Params("id"), c.Params("page"), c.Params("size"))
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("NOTIFICATION - find webhook deliveries error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (nh *NotificationHandler) RedeliverWebhook(c *fiber.Ctx) error {
+	route := nh.base_url + fmt.Sprintf("/api/v1/notifications/webhooks/deliveries/%s/redeliver", c.Params("deliveryId"))
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("NOTIFICATION - redeliver webhook error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (nh *NotificationHandler) FindUnsubscribe(c *fiber.Ctx) error {
+	route := nh.base_url + fmt.Sprintf("/api/v1/notifications/unsubscribe/%s", c.Params("token"))
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("NOTIFICATION - find unsubscribe error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (nh *NotificationHandler) Unsubscribe(c *fiber.Ctx) error {
+	route := nh.base_url + fmt.Sprintf("/api/v1/notifications/unsubscribe/%s", c.Params("token"))
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("NOTIFICATION - unsubscribe error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (nh *NotificationHandler) HandleMailEvents(c *fiber.Ctx) error {
+	route := nh.base_url + "/api/v1/notifications/email-events"
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route, "X-Mail-Signature")
+	if len(errs) > 0 {
+		fmt.Println("NOTIFICATION - handle mail events error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (nh *NotificationHandler) FindMySuppressions(c *fiber.Ctx) error {
+	route := nh.base_url + "/api/v1/notifications/suppressions"
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("NOTIFICATION - find my suppressions error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (nh *NotificationHandler) Resubscribe(c *fiber.Ctx) error {
+	route := nh.base_url + fmt.Sprintf("/api/v1/notifications/suppressions/%s", c.Params("scope"))
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("NOTIFICATION - resubscribe error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (nh *NotificationHandler) FindSuppressions(c *fiber.Ctx) error {
+	route := nh.base_url + fmt.Sprintf("/api/v1/notifications/admin/suppressions/%s/%s", c.Params("page"), c.Params("size"))
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("NOTIFICATION - find suppressions error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (nh *NotificationHandler) RemoveSuppression(c *fiber.Ctx) error {
+	route := nh.base_url + fmt.Sprintf("/api/v1/notifications/admin/suppressions/%s/%s", c.Params("email"), c.Params("scope"))
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("NOTIFICATION - remove suppression error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
@@ -2,6 +2,7 @@ package handler
 
 import (
 	"fmt"
+	"net/http"
 
 	"github.com/gofiber/fiber/v2"
 )
@@ -147,3 +148,678 @@ func (uh *UserHandler) UpdateBuyer(c *fiber.Ctx) error {
 
 	return c.Status(statusCode).Send(body)
 }
+
+func (uh *UserHandler) Withdraw(c *fiber.Ctx) error {
+	route := uh.base_url + fmt.Sprintf("/api/v1/users/sellers/balance/withdraw")
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - withdrawing balance error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) FindMyPayouts(c *fiber.Ctx) error {
+	route := uh.base_url + fmt.Sprintf("/api/v1/users/sellers/balance/payouts/%s/%s", c.Params("page"), c.Params("size"))
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - get my payouts error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) FindMyPayoutByID(c *fiber.Ctx) error {
+	route := uh.base_url + fmt.Sprintf("/api/v1/users/sellers/balance/payouts/id/%s", c.Params("id"))
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - get my payout error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) GetMyBalance(c *fiber.Ctx) error {
+	route := uh.base_url + "/api/v1/users/sellers/balance"
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - get my balance error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) FindMyTransactions(c *fiber.Ctx) error {
+	route := uh.base_url + fmt.Sprintf("/api/v1/users/sellers/balance/transactions/%s/%s", c.Params("page"), c.Params("size"))
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - get my transactions error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) ReconcileLedger(c *fiber.Ctx) error {
+	route := uh.base_url + "/api/v1/users/admin/ledger/reconcile"
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - reconcile ledger error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) AdjustLedger(c *fiber.Ctx) error {
+	route := uh.base_url + "/api/v1/users/admin/ledger/adjustments"
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - adjust ledger error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) ReconcileMyBalance(c *fiber.Ctx) error {
+	route := uh.base_url + "/api/v1/users/sellers/balance/reconcile"
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - reconcile my balance error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) SearchSellers(c *fiber.Ctx) error {
+	route := uh.base_url + fmt.Sprintf("/api/v1/users/sellers/search/%s/%s?%s", c.Params("page"), c.Params("size"), c.Request().URI().QueryString())
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - search sellers error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) CountGigSaves(c *fiber.Ctx) error {
+	route := uh.base_url + fmt.Sprintf("/api/v1/users/collections/saves/gigs/%s", c.Params("gigId"))
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - count gig saves error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) FindMyCollections(c *fiber.Ctx) error {
+	route := uh.base_url + fmt.Sprintf("/api/v1/users/collections/%s", c.Params("kind"))
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - get my collections error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) CreateCollection(c *fiber.Ctx) error {
+	route := uh.base_url + "/api/v1/users/collections"
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - creating collection error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) RenameCollection(c *fiber.Ctx) error {
+	route := uh.base_url + fmt.Sprintf("/api/v1/users/collections/id/%s", c.Params("id"))
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - renaming collection error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) DeleteCollection(c *fiber.Ctx) error {
+	route := uh.base_url + fmt.Sprintf("/api/v1/users/collections/id/%s", c.Params("id"))
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - deleting collection error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) FindCollectionItems(c *fiber.Ctx) error {
+	route := uh.base_url + fmt.Sprintf("/api/v1/users/collections/id/%s/items/%s/%s", c.Params("id"), c.Params("page"), c.Params("size"))
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - get collection items error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) AddCollectionItem(c *fiber.Ctx) error {
+	route := uh.base_url + fmt.Sprintf("/api/v1/users/collections/id/%s/items/%s", c.Params("id"), c.Params("itemId"))
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - adding collection item error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) RemoveCollectionItem(c *fiber.Ctx) error {
+	route := uh.base_url + fmt.Sprintf("/api/v1/users/collections/id/%s/items/%s", c.Params("id"), c.Params("itemId"))
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - removing collection item error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) FindSellerPortfolio(c *fiber.Ctx) error {
+	route := uh.base_url + fmt.Sprintf("/api/v1/users/sellers/id/%s/portfolio", c.Params("sellerId"))
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - finding seller portfolio error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) FindMyPortfolio(c *fiber.Ctx) error {
+	route := uh.base_url + "/api/v1/users/sellers/portfolio"
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - finding my portfolio error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) CreatePortfolioItem(c *fiber.Ctx) error {
+	route := uh.base_url + "/api/v1/users/sellers/portfolio"
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - creating portfolio item error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) ReorderPortfolio(c *fiber.Ctx) error {
+	route := uh.base_url + "/api/v1/users/sellers/portfolio/order"
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - reordering portfolio error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) UpdatePortfolioItem(c *fiber.Ctx) error {
+	route := uh.base_url + fmt.Sprintf("/api/v1/users/sellers/portfolio/id/%s", c.Params("id"))
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - updating portfolio item error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) DeletePortfolioItem(c *fiber.Ctx) error {
+	route := uh.base_url + fmt.Sprintf("/api/v1/users/sellers/portfolio/id/%s", c.Params("id"))
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - deleting portfolio item error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) AddPortfolioMedia(c *fiber.Ctx) error {
+	route := uh.base_url + fmt.Sprintf("/api/v1/users/sellers/portfolio/id/%s/media", c.Params("id"))
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - adding portfolio media error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) ReorderPortfolioMedia(c *fiber.Ctx) error {
+	route := uh.base_url + fmt.Sprintf("/api/v1/users/sellers/portfolio/id/%s/media/order", c.Params("id"))
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - reordering portfolio media error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) RemovePortfolioMedia(c *fiber.Ctx) error {
+	route := uh.base_url + fmt.Sprintf("/api/v1/users/sellers/portfolio/id/%s/media/%s", c.Params("id"), c.Params("mediaId"))
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - removing portfolio media error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) GetMyVacation(c *fiber.Ctx) error {
+	route := uh.base_url + "/api/v1/users/sellers/vacation"
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - getting my vacation error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) SetVacation(c *fiber.Ctx) error {
+	route := uh.base_url + "/api/v1/users/sellers/vacation"
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - setting vacation error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) EndVacation(c *fiber.Ctx) error {
+	route := uh.base_url + "/api/v1/users/sellers/vacation"
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - ending vacation error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) AutocompleteTaxonomy(c *fiber.Ctx) error {
+	route := uh.base_url + fmt.Sprintf("/api/v1/users/taxonomy/%s/autocomplete?%s", c.Params("kind"), c.Request().URI().QueryString())
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - autocompleting taxonomy error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) CreateTaxonomyTerm(c *fiber.Ctx) error {
+	route := uh.base_url + fmt.Sprintf("/api/v1/users/admin/taxonomy/%s", c.Params("kind"))
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - creating taxonomy term error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) MergeTaxonomyTerms(c *fiber.Ctx) error {
+	route := uh.base_url + fmt.Sprintf("/api/v1/users/admin/taxonomy/%s/merge", c.Params("kind"))
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - merging taxonomy terms error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) FindTaxonomyTerm(c *fiber.Ctx) error {
+	route := uh.base_url + fmt.Sprintf("/api/v1/users/admin/taxonomy/%s/id/%s", c.Params("kind"), c.Params("id"))
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - finding taxonomy term error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) AddTaxonomyAlias(c *fiber.Ctx) error {
+	route := uh.base_url + fmt.Sprintf("/api/v1/users/admin/taxonomy/%s/id/%s/aliases", c.Params("kind"), c.Params("id"))
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - adding taxonomy alias error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) RemoveTaxonomyAlias(c *fiber.Ctx) error {
+	route := uh.base_url + fmt.Sprintf("/api/v1/users/admin/taxonomy/%s/id/%s/aliases/%s", c.Params("kind"), c.Params("id"), c.Params("alias"))
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - removing taxonomy alias error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) RequestDataExport(c *fiber.Ctx) error {
+	route := uh.base_url + "/api/v1/users/exports"
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - requesting data export error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) FindMyDataExports(c *fiber.Ctx) error {
+	route := uh.base_url + "/api/v1/users/exports"
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - finding my data exports error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) DownloadDataExport(c *fiber.Ctx) error {
+	route := uh.base_url + fmt.Sprintf("/api/v1/users/exports/id/%s/download", c.Params("id"))
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - downloading data export error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	//INFO: THE RESPONSE HEADERS OF THE USER SERVICE ARE NOT FORWARDED
+	if statusCode == fiber.StatusOK {
+		c.Set(fiber.HeaderContentType, "application/zip")
+		c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="jobber-data-%s.zip"`, c.Params("id")))
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) FindMyBlocks(c *fiber.Ctx) error {
+	route := uh.base_url + "/api/v1/users/blocks"
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - finding my blocked users error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) BlockUser(c *fiber.Ctx) error {
+	route := uh.base_url + fmt.Sprintf("/api/v1/users/blocks/%s", c.Params("userId"))
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - blocking user error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) UnblockUser(c *fiber.Ctx) error {
+	route := uh.base_url + fmt.Sprintf("/api/v1/users/blocks/%s", c.Params("userId"))
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - unblocking user error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) ReportUser(c *fiber.Ctx) error {
+	route := uh.base_url + "/api/v1/users/reports"
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - reporting user error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) FindReportQueue(c *fiber.Ctx) error {
+	route := uh.base_url + fmt.Sprintf("/api/v1/users/admin/reports/%s/%s/%s", c.Params("status"), c.Params("page"), c.Params("size"))
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - finding report queue error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) FindReport(c *fiber.Ctx) error {
+	route := uh.base_url + fmt.Sprintf("/api/v1/users/admin/reports/id/%s", c.Params("id"))
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - finding report error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) ReviewReport(c *fiber.Ctx) error {
+	route := uh.base_url + fmt.Sprintf("/api/v1/users/admin/reports/id/%s", c.Params("id"))
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - reviewing report error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) GetMyVerification(c *fiber.Ctx) error {
+	route := uh.base_url + "/api/v1/users/sellers/verification"
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - getting my verification error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) SubmitVerification(c *fiber.Ctx) error {
+	route := uh.base_url + "/api/v1/users/sellers/verification"
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - submitting verification error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) FindVerificationQueue(c *fiber.Ctx) error {
+	route := uh.base_url + fmt.Sprintf("/api/v1/users/admin/verifications/%s/%s/%s", c.Params("status"), c.Params("page"), c.Params("size"))
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - finding verification queue error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) FindVerification(c *fiber.Ctx) error {
+	route := uh.base_url + fmt.Sprintf("/api/v1/users/admin/verifications/id/%s", c.Params("id"))
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - finding verification error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) ReviewVerification(c *fiber.Ctx) error {
+	route := uh.base_url + fmt.Sprintf("/api/v1/users/admin/verifications/id/%s", c.Params("id"))
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - reviewing verification error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	return c.Status(statusCode).Send(body)
+}
+
+func (uh *UserHandler) FindVerificationDocument(c *fiber.Ctx) error {
+	route := uh.base_url + fmt.Sprintf("/api/v1/users/admin/verifications/id/%s/documents/%s", c.Params("id"), c.Params("documentId"))
+	statusCode, body, errs := sendHttpReqToAnotherService(c, route)
+	if len(errs) > 0 {
+		fmt.Println("USER - finding verification document error", errs)
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"errs": errs,
+		})
+	}
+
+	//INFO: THE RESPONSE HEADERS OF THE USER SERVICE ARE NOT FORWARDED, THE TYPE IS SNIFFED AGAIN
+	if statusCode == fiber.StatusOK {
+		c.Set(fiber.HeaderContentType, http.DetectContentType(body))
+		c.Set(fiber.HeaderCacheControl, "no-store")
+	}
+
+	return c.Status(statusCode).Send(body)
+}
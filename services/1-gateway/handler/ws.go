@@ -20,26 +20,37 @@ type client struct {
 type wsReg struct {
 	wsConn *websocket.Conn
 	userId string
+	email  string
 }
 
 var (
-	clients    = make(map[string]*client)
-	register   = make(chan wsReg)
-	unregister = make(chan wsReg)
-	broadcast  = make(chan string)
+	// NOTE: GUARDS clients AND clientIDsByEmail, runHub WRITES THEM WHILE
+	// HTTP HANDLERS AND NOTIFICATION PUSHES READ THEM FROM OTHER GOROUTINES
+	clientsMu        sync.RWMutex
+	clients          = make(map[string]*client)
+	clientIDsByEmail = make(map[string]string) // NOTE: THE NOTIFICATION SERVICE ONLY KNOWS RECEIVERS BY EMAIL
+	register         = make(chan wsReg)
+	unregister       = make(chan wsReg)
+	broadcast        = make(chan string)
 )
 
 func runHub() {
 	for {
 		select {
 		case connection := <-register:
+			clientsMu.Lock()
 			clients[connection.userId] = &client{
 				wsConn: connection.wsConn,
 			}
+			clientIDsByEmail[connection.email] = connection.userId
+			clientsMu.Unlock()
 			log.Println("connection registered")
 
 		case connection := <-unregister:
+			clientsMu.Lock()
 			delete(clients, connection.userId)
+			delete(clientIDsByEmail, connection.email)
+			clientsMu.Unlock()
 
 			log.Println("connection unregistered")
 		}
@@ -75,6 +86,7 @@ func WsUpgrade(app fiber.Router) {
 		defer func() {
 			unregister <- wsReg{
 				userId: u.UserID,
+				email:  u.Email,
 				wsConn: c,
 			}
 			c.Close()
@@ -82,6 +94,7 @@ func WsUpgrade(app fiber.Router) {
 
 		register <- wsReg{
 			userId: u.UserID,
+			email:  u.Email,
 			wsConn: c,
 		}
 
@@ -123,7 +136,9 @@ func WsUpgrade(app fiber.Router) {
 }
 
 func getClient(id string) (*client, error) {
+	clientsMu.RLock()
 	clientConn, ok := clients[id]
+	clientsMu.RUnlock()
 	if !ok {
 		return nil, fmt.Errorf("Websocket Client with id [%s] did not exists", id)
 	}
@@ -152,3 +167,15 @@ func SendMessage(senderId, receiverId string, data []byte) {
 		// }
 	}
 }
+
+func SendMessageToEmail(email string, data []byte) {
+	clientsMu.RLock()
+	receiverId, ok := clientIDsByEmail[email]
+	clientsMu.RUnlock()
+	if !ok {
+		log.Printf("SendMessageToEmail error: Websocket Client with email [%s] did not exists", email)
+		return
+	}
+
+	SendMessage("Notification Service", receiverId, data)
+}
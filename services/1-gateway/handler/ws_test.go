@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+var startHub sync.Once
+
+// TestHubIsSafeForConcurrentLookups registers connections while pushes look
+// receivers up by email, run it with -race.
+func TestHubIsSafeForConcurrentLookups(t *testing.T) {
+	startHub.Do(func() {
+		go runHub()
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			reg := wsReg{
+				userId: fmt.Sprintf("user-%d", i),
+				email:  fmt.Sprintf("user-%d@example.com", i),
+			}
+			register <- reg
+			unregister <- reg
+		}(i)
+		go func() {
+			defer wg.Done()
+			SendMessageToEmail("nobody@example.com", []byte("{}"))
+		}()
+	}
+	wg.Wait()
+
+	//INFO: THE HUB TAKES ONE MESSAGE AT A TIME, SO ONCE IT TAKES THE LAST
+	// REGISTER EVERY UNREGISTER BEFORE IT IS APPLIED
+	last := wsReg{userId: "last", email: "last@example.com"}
+	register <- last
+	unregister <- last
+	register <- last
+
+	clientsMu.RLock()
+	defer clientsMu.RUnlock()
+	if len(clients) > 1 || len(clientIDsByEmail) > 1 {
+		t.Errorf("got %d clients and %d emails, want at most the last one", len(clients), len(clientIDsByEmail))
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/Akihira77/gojobber/services/1-gateway/types"
 	"github.com/Akihira77/gojobber/services/1-gateway/util"
 	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
 )
 
 var (
@@ -56,6 +57,29 @@ func authOnly(c *fiber.Ctx) error {
 	return c.Next()
 }
 
+// INFO: INTERNAL ENDPOINTS ARE CALLED BY OUR OWN SERVICES,
+// THEY SIGN A SHORT LIVED TOKEN WITH THE SHARED GATEWAY_TOKEN SECRET
+func verifyServiceReq(c *fiber.Ctx) error {
+	serviceToken := c.Get("serviceToken", "")
+	if serviceToken == "" {
+		return fiber.NewError(http.StatusForbidden, "request is not from internal service")
+	}
+
+	_, err := jwt.Parse(serviceToken, func(t *jwt.Token) (interface{}, error) {
+		if method, ok := t.Method.(*jwt.SigningMethodHMAC); !ok || method != jwt.SigningMethodHS256 {
+			return nil, fmt.Errorf("Signing method invalid")
+		}
+
+		return []byte(os.Getenv("GATEWAY_TOKEN")), nil
+	})
+	if err != nil {
+		fmt.Printf("verifyServiceReq error:\n%+v", err)
+		return fiber.NewError(http.StatusForbidden, "invalid service token")
+	}
+
+	return c.Next()
+}
+
 func MainRouter(app *fiber.App) {
 	app.Get("/health-check", func(c *fiber.Ctx) error {
 		return c.Status(http.StatusOK).SendString("API Gateway Service is health and OK!")
@@ -67,6 +91,7 @@ func MainRouter(app *fiber.App) {
 	CHAT_URL := os.Getenv("CHAT_URL")
 	ORDER_URL := os.Getenv("ORDER_URL")
 	REVIEW_URL := os.Getenv("REVIEW_URL")
+	NOTIFICATION_URL := os.Getenv("NOTIFICATION_URL")
 	api := app.Group(BASE_PATH)
 	api.Use(generateGatewayToken)
 
@@ -76,6 +101,11 @@ func MainRouter(app *fiber.App) {
 	chatRouter(CHAT_URL, api.Group("/chats"))
 	orderRouter(ORDER_URL, api.Group("/orders"))
 	reviewRouter(REVIEW_URL, api.Group("/reviews"))
+	notificationRouter(NOTIFICATION_URL, api.Group("/notifications"))
+
+	internal := api.Group("/internal")
+	internal.Use(verifyServiceReq)
+	internal.Post("/notifications/push", handler.PushNotification)
 
 	handler.WsUpgrade(api.Use(authOnly))
 
@@ -117,9 +147,67 @@ func userRouter(base_url string, r fiber.Router) {
 	r.Get("/sellers/id/:id", uh.FindSellerByID)
 	r.Get("/sellers/username/:username", uh.FindSellerByUsername)
 	r.Get("/sellers/random/:count", uh.GetRandomSellers)
+	r.Get("/sellers/search/:page/:size", uh.SearchSellers)
 	r.Post("/sellers", uh.Create)
 	r.Put("/sellers", uh.UpdateSeller)
-
+	r.Get("/sellers/vacation", uh.GetMyVacation)
+	r.Put("/sellers/vacation", uh.SetVacation)
+	r.Delete("/sellers/vacation", uh.EndVacation)
+	r.Get("/sellers/verification", uh.GetMyVerification)
+	r.Post("/sellers/verification", uh.SubmitVerification)
+
+	r.Get("/sellers/id/:sellerId/portfolio", uh.FindSellerPortfolio)
+	r.Get("/sellers/portfolio", uh.FindMyPortfolio)
+	r.Post("/sellers/portfolio", uh.CreatePortfolioItem)
+	r.Put("/sellers/portfolio/order", uh.ReorderPortfolio)
+	r.Put("/sellers/portfolio/id/:id", uh.UpdatePortfolioItem)
+	r.Delete("/sellers/portfolio/id/:id", uh.DeletePortfolioItem)
+	r.Post("/sellers/portfolio/id/:id/media", uh.AddPortfolioMedia)
+	r.Put("/sellers/portfolio/id/:id/media/order", uh.ReorderPortfolioMedia)
+	r.Delete("/sellers/portfolio/id/:id/media/:mediaId", uh.RemovePortfolioMedia)
+
+	r.Post("/sellers/balance/withdraw", uh.Withdraw)
+	r.Get("/sellers/balance/payouts/id/:id", uh.FindMyPayoutByID)
+	r.Get("/sellers/balance/payouts/:page/:size", uh.FindMyPayouts)
+	r.Get("/sellers/balance", uh.GetMyBalance)
+	r.Get("/sellers/balance/reconcile", uh.ReconcileMyBalance)
+	r.Get("/sellers/balance/transactions/:page/:size", uh.FindMyTransactions)
+
+	r.Get("/collections/saves/gigs/:gigId", uh.CountGigSaves)
+	r.Get("/collections/:kind", uh.FindMyCollections)
+	r.Post("/collections", uh.CreateCollection)
+	r.Patch("/collections/id/:id", uh.RenameCollection)
+	r.Delete("/collections/id/:id", uh.DeleteCollection)
+	r.Get("/collections/id/:id/items/:page/:size", uh.FindCollectionItems)
+	r.Post("/collections/id/:id/items/:itemId", uh.AddCollectionItem)
+	r.Delete("/collections/id/:id/items/:itemId", uh.RemoveCollectionItem)
+
+	r.Get("/taxonomy/:kind/autocomplete", uh.AutocompleteTaxonomy)
+
+	r.Get("/blocks", uh.FindMyBlocks)
+	r.Post("/blocks/:userId", uh.BlockUser)
+	r.Delete("/blocks/:userId", uh.UnblockUser)
+
+	r.Post("/reports", uh.ReportUser)
+
+	r.Get("/exports", uh.FindMyDataExports)
+	r.Post("/exports", uh.RequestDataExport)
+	r.Get("/exports/id/:id/download", uh.DownloadDataExport)
+
+	r.Get("/admin/ledger/reconcile", uh.ReconcileLedger)
+	r.Post("/admin/ledger/adjustments", uh.AdjustLedger)
+	r.Post("/admin/taxonomy/:kind", uh.CreateTaxonomyTerm)
+	r.Post("/admin/taxonomy/:kind/merge", uh.MergeTaxonomyTerms)
+	r.Get("/admin/taxonomy/:kind/id/:id", uh.FindTaxonomyTerm)
+	r.Post("/admin/taxonomy/:kind/id/:id/aliases", uh.AddTaxonomyAlias)
+	r.Delete("/admin/taxonomy/:kind/id/:id/aliases/:alias", uh.RemoveTaxonomyAlias)
+	r.Get("/admin/reports/id/:id", uh.FindReport)
+	r.Put("/admin/reports/id/:id", uh.ReviewReport)
+	r.Get("/admin/reports/:status/:page/:size", uh.FindReportQueue)
+	r.Get("/admin/verifications/id/:id", uh.FindVerification)
+	r.Put("/admin/verifications/id/:id", uh.ReviewVerification)
+	r.Get("/admin/verifications/id/:id/documents/:documentId", uh.FindVerificationDocument)
+	r.Get("/admin/verifications/:status/:page/:size", uh.FindVerificationQueue)
 }
 
 func gigRouter(base_url string, r fiber.Router) {
@@ -189,3 +277,40 @@ func reviewRouter(base_url string, r fiber.Router) {
 	r.Patch("/:reviewId", rh.Update)
 	r.Delete("/:reviewId", rh.Remove)
 }
+
+func notificationRouter(base_url string, r fiber.Router) {
+	nh := handler.NewNotificationHandler(base_url)
+	r.Get("/health-check", nh.HealthCheck)
+	r.Get("/unsubscribe/:token", nh.FindUnsubscribe)
+	r.Post("/unsubscribe/:token", nh.Unsubscribe)
+	r.Post("/email-events", nh.HandleMailEvents)
+
+	r.Use(authOnly)
+	r.Get("/preferences", nh.FindMyPreferences)
+	r.Put("/preferences", nh.UpdateMyPreferences)
+	r.Get("/preferences/locale", nh.FindMyLocale)
+	r.Put("/preferences/locale", nh.UpdateMyLocale)
+	r.Get("/suppressions", nh.FindMySuppressions)
+	r.Delete("/suppressions/:scope", nh.Resubscribe)
+	r.Get("/webhooks", nh.FindMyWebhooks)
+	r.Get("/webhooks/events", nh.FindWebhookEvents)
+	r.Post("/webhooks", nh.CreateWebhook)
+	r.Post("/webhooks/deliveries/:deliveryId/redeliver", nh.RedeliverWebhook)
+	r.Put("/webhooks/:id", nh.UpdateWebhook)
+	r.Delete("/webhooks/:id", nh.DeleteWebhook)
+	r.Post("/webhooks/:id/rotate-secret", nh.RotateWebhookSecret)
+	r.Get("/webhooks/:id/deliveries/:page/:size", nh.FindWebhookDeliveries)
+	r.Get("/me/unread-count", nh.CountMyUnreadNotifications)
+	r.Get("/me/:filter/:page/:size", nh.FindMyNotifications)
+	r.Patch("/me/read-all", nh.MarkAllMyNotificationsAsRead)
+	r.Patch("/me/:id/read", nh.MarkMyNotificationAsRead)
+	r.Get("/admin/outbox/id/:id", nh.FindOutboxByID)
+	r.Get("/admin/outbox/:status/:page/:size", nh.FindOutboxes)
+	r.Post("/admin/outbox/replay-dead", nh.ReplayAllDeadOutboxes)
+	r.Post("/admin/outbox/:id/replay", nh.ReplayOutbox)
+	r.Get("/admin/suppressions/:page/:size", nh.FindSuppressions)
+	r.Delete("/admin/suppressions/:email/:scope", nh.RemoveSuppression)
+	r.Get("/admin/templates", nh.FindTemplates)
+	r.Get("/admin/templates/:name/preview/:format?", nh.PreviewTemplate)
+	r.Get("/admin/templates/:name/locales/:locale/preview/:format?", nh.PreviewTemplate)
+}
@@ -6,7 +6,9 @@ import (
 
 	"github.com/Akihira77/gojobber/services/2-notification/handler"
 	"github.com/Akihira77/gojobber/services/2-notification/service"
+	"github.com/Akihira77/gojobber/services/2-notification/util"
 	"google.golang.org/grpc"
+	"gorm.io/gorm"
 )
 
 type gRPCServer struct {
@@ -19,7 +21,7 @@ func NewGRPCServer(addr string) *gRPCServer {
 	}
 }
 
-func (s *gRPCServer) Run() error {
+func (s *gRPCServer) Run(db *gorm.DB) error {
 	lis, err := net.Listen("tcp", s.addr)
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
@@ -28,8 +30,9 @@ func (s *gRPCServer) Run() error {
 	grpcServer := grpc.NewServer()
 
 	// register our grpc services
-	notificationSvc := service.NewNotificationService()
-	handler.NewNotificationGRPCHandler(grpcServer, notificationSvc)
+	outboxSvc := service.NewOutboxService(db)
+	webhookSvc := service.NewWebhookService(db, util.GetEnvBool("WEBHOOK_ALLOW_LOOPBACK", false))
+	handler.NewNotificationGRPCHandler(grpcServer, outboxSvc, webhookSvc)
 
 	log.Println("Starting gRPC server on", s.addr)
 
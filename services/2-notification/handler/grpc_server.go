@@ -2,153 +2,181 @@ package handler
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"time"
 
 	"github.com/Akihira77/gojobber/services/2-notification/service"
+	"github.com/Akihira77/gojobber/services/2-notification/types"
 	"github.com/Akihira77/gojobber/services/common/genproto/notification"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
+// NOTE: EVERY RPC ONLY PERSISTS THE NOTIFICATION INTO THE OUTBOX.
+// DELIVERY AND RETRIES ARE HANDLED BY service.OutboxWorker
 type NotificationGRPCHandler struct {
-	notificationSvc service.NotificationServiceImpl
+	outboxSvc  service.OutboxServiceImpl
+	webhookSvc service.WebhookServiceImpl
 	notification.UnimplementedNotificationServiceServer
 }
 
-func NewNotificationGRPCHandler(grpc *grpc.Server, notificationSvc service.NotificationServiceImpl) {
+func NewNotificationGRPCHandler(grpc *grpc.Server, outboxSvc service.OutboxServiceImpl, webhookSvc service.WebhookServiceImpl) {
 	gRPCHandler := &NotificationGRPCHandler{
-		notificationSvc: notificationSvc,
+		outboxSvc:  outboxSvc,
+		webhookSvc: webhookSvc,
 	}
 
 	notification.RegisterNotificationServiceServer(grpc, gRPCHandler)
 }
 
-func (h *NotificationGRPCHandler) UserVerifyingEmail(ctx context.Context, req *notification.VerifyingEmailRequest) (*emptypb.Empty, error) {
+func (h *NotificationGRPCHandler) Publish(ctx context.Context, req *notification.NotificationEvent) (*emptypb.Empty, error) {
 	log.Println("Receiving data", req)
-	err := h.notificationSvc.UserVerifyingEmail(req.ReceiverEmail, req.HtmlTemplateName, req.VerifyLink)
+	err := h.outboxSvc.Publish(ctx, req)
 
 	if err != nil {
-		log.Printf("UserVerifyingEmail for [%s] is error: %v", req.ReceiverEmail, err)
+		log.Printf("Publish [%s] is error: %v", req.Type, err)
+		return nil, err
 	}
-	return nil, err
+	return &emptypb.Empty{}, nil
 }
 
-func (h *NotificationGRPCHandler) UserForgotPassword(ctx context.Context, req *notification.ForgotPasswordRequest) (*emptypb.Empty, error) {
+// NOTE: WEBHOOKS ARE ALSO DELIVERED BY A WORKER, SEE service.WebhookWorker
+func (h *NotificationGRPCHandler) PublishWebhookEvent(ctx context.Context, req *notification.WebhookEvent) (*emptypb.Empty, error) {
 	log.Println("Receiving data", req)
-	err := h.notificationSvc.UserForgotPassword(req.ReceiverEmail, req.HtmlTemplateName, req.ResetLink, req.Username)
+	if req.OwnerEmail == "" {
+		return nil, fmt.Errorf("%w: owner email is missing", service.ErrInvalidWebhook)
+	}
+
+	data := map[string]interface{}{}
+	if req.Data != nil {
+		data = req.Data.AsMap()
+	}
 
+	err := h.webhookSvc.Dispatch(ctx, req.OwnerEmail, types.WebhookEventName(req.Event), data)
 	if err != nil {
-		log.Printf("UserForgotPassword for [%s] is error: %v", req.ReceiverEmail, err)
+		log.Printf("PublishWebhookEvent [%s] for [%s] is error: %v", req.Event, req.OwnerEmail, err)
+		return nil, err
 	}
-	return nil, err
+	return &emptypb.Empty{}, nil
 }
 
-func (h *NotificationGRPCHandler) UserSuccessResetPassword(ctx context.Context, req *notification.SuccessResetPasswordRequest) (*emptypb.Empty, error) {
-	log.Println("Receiving data", req)
-	err := h.notificationSvc.UserSucessResetPassword(req.ReceiverEmail, req.HtmlTemplateName, req.Username)
-
+// publishOne converts a per-case request into a single recipient event.
+func (h *NotificationGRPCHandler) publishOne(ctx context.Context, eventType notification.NotificationEventType, receiverEmail, locale, templateName string, data map[string]interface{}) (*emptypb.Empty, error) {
+	payload, err := structpb.NewStruct(data)
 	if err != nil {
-		log.Printf("UserSuccessResetPassword for [%s] is error: %v", req.ReceiverEmail, err)
+		log.Printf("%s for [%s] is error: %v", eventType, receiverEmail, err)
+		return nil, err
 	}
-	return nil, err
+
+	return h.Publish(ctx, &notification.NotificationEvent{
+		Type: eventType,
+		Recipients: []*notification.NotificationRecipient{
+			{
+				Email:  receiverEmail,
+				Locale: locale,
+			},
+		},
+		Payload:      payload,
+		TemplateName: templateName,
+	})
 }
 
-func (h *NotificationGRPCHandler) SendEmailChatNotification(ctx context.Context, req *notification.EmailChatNotificationRequest) (*emptypb.Empty, error) {
-	log.Println("Receiving data", req)
-	err := h.notificationSvc.SendEmailChatNotification(req.ReceiverEmail, req.SenderEmail, req.Message)
+func (h *NotificationGRPCHandler) UserVerifyingEmail(ctx context.Context, req *notification.VerifyingEmailRequest) (*emptypb.Empty, error) {
+	return h.publishOne(ctx, notification.NotificationEventType_USER_VERIFYING_EMAIL, req.ReceiverEmail, req.Locale, req.HtmlTemplateName, map[string]interface{}{
+		"VerifyLink": req.VerifyLink,
+	})
+}
 
-	if err != nil {
-		log.Printf("SendEmailChatNotificaion for [%s] is error: %v", req.ReceiverEmail, err)
-	}
-	return nil, err
+func (h *NotificationGRPCHandler) UserForgotPassword(ctx context.Context, req *notification.ForgotPasswordRequest) (*emptypb.Empty, error) {
+	return h.publishOne(ctx, notification.NotificationEventType_USER_FORGOT_PASSWORD, req.ReceiverEmail, req.Locale, req.HtmlTemplateName, map[string]interface{}{
+		"Username":  req.Username,
+		"ResetLink": req.ResetLink,
+	})
 }
 
-func (h *NotificationGRPCHandler) SellerHasCompletedAnOrder(ctx context.Context, req *notification.SellerCompletedAnOrderRequest) (*emptypb.Empty, error) {
-	log.Println("Receiving data", req)
-	err := h.notificationSvc.SellerHasCompletedAnOrder(req)
+func (h *NotificationGRPCHandler) UserSucessResetPassword(ctx context.Context, req *notification.SuccessResetPasswordRequest) (*emptypb.Empty, error) {
+	return h.publishOne(ctx, notification.NotificationEventType_USER_SUCCESS_RESET_PASSWORD, req.ReceiverEmail, req.Locale, req.HtmlTemplateName, map[string]interface{}{
+		"Username": req.Username,
+	})
+}
 
-	if err != nil {
-		log.Printf("SellerHasCompletedAnOrder for [%s] is error: %v", req.ReceiverEmail, err)
-	}
-	return nil, err
+func (h *NotificationGRPCHandler) SendEmailChatNotification(ctx context.Context, req *notification.EmailChatNotificationRequest) (*emptypb.Empty, error) {
+	return h.publishOne(ctx, notification.NotificationEventType_CHAT_MESSAGE, req.ReceiverEmail, req.Locale, "", map[string]interface{}{
+		"SenderEmail":    req.SenderEmail,
+		"Message":        req.Message,
+		"MessageID":      req.MessageId,
+		"ConversationID": req.ConversationId,
+	})
 }
 
-func (h *NotificationGRPCHandler) BuyerDeadlineExtensionResponse(ctx context.Context, req *notification.BuyerDeadlineExtension) (*emptypb.Empty, error) {
-	log.Println("Receiving data", req)
-	err := h.notificationSvc.BuyerDeadlineExtensionResponse(req)
+func (h *NotificationGRPCHandler) SellerHasCompletedAnOrder(ctx context.Context, req *notification.SellerCompletedAnOrderRequest) (*emptypb.Empty, error) {
+	return h.publishOne(ctx, notification.NotificationEventType_SELLER_COMPLETED_ORDER, req.ReceiverEmail, req.Locale, "", map[string]interface{}{
+		"BuyerEmail":           req.BuyerEmail,
+		"OrderID":              req.OrderId,
+		"SellerCurrentBalance": req.SellerCurrentBalance,
+		"OrderURL":             req.Url,
+	})
+}
 
-	if err != nil {
-		log.Printf("BuyerDeadlineExtensionResponse for [%s] is error: %v", req.ReceiverEmail, err)
-	}
-	return nil, err
+func (h *NotificationGRPCHandler) BuyerDeadlineExtensionResponse(ctx context.Context, req *notification.BuyerDeadlineExtension) (*emptypb.Empty, error) {
+	return h.publishOne(ctx, notification.NotificationEventType_BUYER_DEADLINE_EXTENSION_RESPONSE, req.ReceiverEmail, req.Locale, "", map[string]interface{}{
+		"OrderURL": req.Url,
+	})
 }
 
 func (h *NotificationGRPCHandler) BuyerRefundsAnOrder(ctx context.Context, req *notification.BuyerRefundsOrderRequest) (*emptypb.Empty, error) {
-	log.Println("Receiving data", req)
-	err := h.notificationSvc.BuyerRefundsAnOrder(req)
-
-	if err != nil {
-		log.Printf("BuyerRefundsAnOrder for [%s] is error: %v", req.ReceiverEmail, err)
-	}
-	return nil, err
+	return h.publishOne(ctx, notification.NotificationEventType_BUYER_REFUNDS_ORDER, req.ReceiverEmail, req.Locale, "", map[string]interface{}{
+		"OrderURL": req.Url,
+	})
 }
 
 func (h *NotificationGRPCHandler) SellerCanceledAnOrder(ctx context.Context, req *notification.SellerCancelOrderRequest) (*emptypb.Empty, error) {
-	log.Println("Receiving data", req)
-	err := h.notificationSvc.SellerCanceledAnOrder(req)
-
-	if err != nil {
-		log.Printf("SellerCanceledAnOrder for [%s] is error: %v", req.ReceiverEmail, err)
-	}
-	return nil, err
+	return h.publishOne(ctx, notification.NotificationEventType_SELLER_CANCELED_ORDER, req.ReceiverEmail, req.Locale, "", map[string]interface{}{
+		"OrderURL": req.Url,
+	})
 }
 
 func (h *NotificationGRPCHandler) NotifySellerOrderHasBeenMade(ctx context.Context, req *notification.NotifySellerGotAnOrderRequest) (*emptypb.Empty, error) {
-	log.Println("Receiving data", req)
-	err := h.notificationSvc.NotifySellerGotAnOrder(req)
-
-	if err != nil {
-		log.Printf("NotifySellerOrderHasBeenMade for [%s] is error: %v", req.ReceiverEmail, err)
+	data := map[string]interface{}{
+		"Message": req.Message,
+	}
+	if req.Detail != nil {
+		data["GigTitle"] = req.Detail.GigTitle
+		data["GigDescription"] = req.Detail.GigDescription
+		data["Price"] = req.Detail.Price
+		data["ServiceFee"] = req.Detail.ServiceFee
+		data["Deadline"] = req.Detail.Deadline.AsTime().UTC().Format(time.RFC1123)
 	}
-	return nil, err
+
+	return h.publishOne(ctx, notification.NotificationEventType_SELLER_GOT_AN_ORDER, req.ReceiverEmail, req.Locale, "", data)
 }
 
 func (h *NotificationGRPCHandler) NotifySellerGotAReview(ctx context.Context, req *notification.NotifySellerGotAReviewRequest) (*emptypb.Empty, error) {
-	log.Println("Receiving data", req)
-	err := h.notificationSvc.NotifySellerGotAReview(req)
-
-	if err != nil {
-		log.Printf("NotifySellerGotAReview for [%s] is error: %v", req.ReceiverEmail, err)
-	}
-	return nil, err
+	return h.publishOne(ctx, notification.NotificationEventType_SELLER_GOT_A_REVIEW, req.ReceiverEmail, req.Locale, "", map[string]interface{}{
+		"Message": req.Message,
+	})
 }
 
 func (h *NotificationGRPCHandler) NotifyBuyerSellerDeliveredOrder(ctx context.Context, req *notification.NotifyBuyerOrderDeliveredRequest) (*emptypb.Empty, error) {
-	log.Println("Receiving data", req)
-	err := h.notificationSvc.NotifyBuyerSellerDeliveredOrder(req)
-
-	if err != nil {
-		log.Printf("NotifyBuyerSellerDeliveredOrder for [%s] is error: %v", req.ReceiverEmail, err)
-	}
-	return nil, err
+	return h.publishOne(ctx, notification.NotificationEventType_BUYER_ORDER_DELIVERED, req.ReceiverEmail, req.Locale, "", map[string]interface{}{
+		"OrderURL": req.Url,
+	})
 }
 
 func (h *NotificationGRPCHandler) NotifyBuyerOrderHasAcknowledged(ctx context.Context, req *notification.NotifyBuyerOrderAcknowledgeRequest) (*emptypb.Empty, error) {
-	log.Println("Receiving data", req)
-	err := h.notificationSvc.NotifyBuyerSellerProcessedOrder(req)
-
-	if err != nil {
-		log.Printf("NotifyBuyerSellerDeliveredOrder for [%s] is error: %v", req.ReceiverEmail, err)
-	}
-	return nil, err
+	return h.publishOne(ctx, notification.NotificationEventType_BUYER_ORDER_ACKNOWLEDGED, req.ReceiverEmail, req.Locale, "", map[string]interface{}{
+		"OrderURL": req.Url,
+	})
 }
 
 func (h *NotificationGRPCHandler) SellerRequestDeadlineExtension(ctx context.Context, req *notification.SellerDeadlineExtensionRequest) (*emptypb.Empty, error) {
-	log.Println("Receiving data", req)
-	err := h.notificationSvc.SellerRequestDeadlineExtension(req)
+	return h.publishOne(ctx, notification.NotificationEventType_SELLER_REQUEST_DEADLINE_EXTENSION, req.ReceiverEmail, req.Locale, "", map[string]interface{}{
+		"OrderURL": req.Url,
+	})
+}
 
-	if err != nil {
-		log.Printf("NotifyBuyerSellerDeliveredOrder for [%s] is error: %v", req.ReceiverEmail, err)
-	}
-	return nil, err
+func (h *NotificationGRPCHandler) NotifySellerBuyerResponseDeliveredOrder(ctx context.Context, req *notification.NotifySellerBuyerResponseDeliveredOrderRequest) (*emptypb.Empty, error) {
+	return h.publishOne(ctx, notification.NotificationEventType_SELLER_BUYER_RESPONDED_DELIVERY, req.ReceiverEmail, req.Locale, "", map[string]interface{}{})
 }
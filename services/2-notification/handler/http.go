@@ -0,0 +1,368 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Akihira77/gojobber/services/2-notification/helper"
+	"github.com/Akihira77/gojobber/services/2-notification/service"
+	"github.com/Akihira77/gojobber/services/2-notification/types"
+	"github.com/Akihira77/gojobber/services/common/locale"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type NotificationHttpHandler struct {
+	outboxSvc service.OutboxServiceImpl
+	inAppSvc  service.InAppNotificationServiceImpl
+	prefSvc   service.PreferenceServiceImpl
+	templates *helper.TemplateRegistry
+}
+
+func NewNotificationHttpHandler(outboxSvc service.OutboxServiceImpl, inAppSvc service.InAppNotificationServiceImpl, prefSvc service.PreferenceServiceImpl, templates *helper.TemplateRegistry) *NotificationHttpHandler {
+	return &NotificationHttpHandler{
+		outboxSvc: outboxSvc,
+		inAppSvc:  inAppSvc,
+		prefSvc:   prefSvc,
+		templates: templates,
+	}
+}
+
+func (nh *NotificationHttpHandler) FindMyPreferences(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 500*time.Millisecond)
+	defer cancel()
+
+	userInfo, ok := c.UserContext().Value("current_user").(*types.JWTClaims)
+	if !ok {
+		return fiber.NewError(http.StatusUnauthorized, "sign in first")
+	}
+
+	prefs, err := nh.prefSvc.FindAll(ctx, userInfo.Email)
+	if err != nil {
+		log.Printf("FindMyPreferences error:\n+%v", err)
+		return fiber.NewError(http.StatusInternalServerError, "Error while finding notification preferences")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"preferences": prefs,
+	})
+}
+
+func (nh *NotificationHttpHandler) UpdateMyPreferences(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	userInfo, ok := c.UserContext().Value("current_user").(*types.JWTClaims)
+	if !ok {
+		return fiber.NewError(http.StatusUnauthorized, "sign in first")
+	}
+
+	var data types.UpdateNotificationPreferencesDTO
+	if err := c.BodyParser(&data); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid data")
+	}
+
+	err := nh.prefSvc.Update(ctx, userInfo.Email, &data)
+	if err != nil {
+		log.Printf("UpdateMyPreferences error:\n+%v", err)
+		if errors.Is(err, service.ErrInvalidPreference) {
+			return fiber.NewError(http.StatusBadRequest, err.Error())
+		}
+		return fiber.NewError(http.StatusInternalServerError, "Error while updating notification preferences")
+	}
+
+	prefs, err := nh.prefSvc.FindAll(ctx, userInfo.Email)
+	if err != nil {
+		log.Printf("UpdateMyPreferences error:\n+%v", err)
+		return fiber.NewError(http.StatusInternalServerError, "Error while finding notification preferences")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"preferences": prefs,
+	})
+}
+
+func (nh *NotificationHttpHandler) FindMyLocale(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 500*time.Millisecond)
+	defer cancel()
+
+	userInfo, ok := c.UserContext().Value("current_user").(*types.JWTClaims)
+	if !ok {
+		return fiber.NewError(http.StatusUnauthorized, "sign in first")
+	}
+
+	l, err := nh.prefSvc.FindLocale(ctx, userInfo.Email)
+	if err != nil {
+		log.Printf("FindMyLocale error:\n+%v", err)
+		return fiber.NewError(http.StatusInternalServerError, "Error while finding notification locale")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"locale":    l,
+		"available": nh.templates.Locales(),
+	})
+}
+
+// INFO: AN EMPTY LOCALE GOES BACK TO THE ONE GUESSED FROM THE USER'S COUNTRY
+func (nh *NotificationHttpHandler) UpdateMyLocale(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 500*time.Millisecond)
+	defer cancel()
+
+	userInfo, ok := c.UserContext().Value("current_user").(*types.JWTClaims)
+	if !ok {
+		return fiber.NewError(http.StatusUnauthorized, "sign in first")
+	}
+
+	var data types.UpdateLocalePreferenceDTO
+	if err := c.BodyParser(&data); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid data")
+	}
+
+	l := locale.Normalize(data.Locale)
+	if l != "" && !nh.templates.HasLocale(l) {
+		return fiber.NewError(http.StatusBadRequest, fmt.Sprintf("locale must be one of %s", strings.Join(nh.templates.Locales(), ", ")))
+	}
+
+	err := nh.prefSvc.UpdateLocale(ctx, userInfo.Email, l)
+	if err != nil {
+		log.Printf("UpdateMyLocale error:\n+%v", err)
+		return fiber.NewError(http.StatusInternalServerError, "Error while updating notification locale")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"locale": l,
+	})
+}
+
+// INFO: FILTER IS EITHER all OR unread
+func (nh *NotificationHttpHandler) FindMyNotifications(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	userInfo, ok := c.UserContext().Value("current_user").(*types.JWTClaims)
+	if !ok {
+		return fiber.NewError(http.StatusUnauthorized, "sign in first")
+	}
+
+	filter := c.Params("filter")
+	if filter != "all" && filter != "unread" {
+		return fiber.NewError(http.StatusBadRequest, "filter must be all or unread")
+	}
+
+	page, err := strconv.Atoi(c.Params("page", "1"))
+	if err != nil || page < 1 {
+		return fiber.NewError(http.StatusBadRequest, "invalid page number")
+	}
+
+	size, err := strconv.Atoi(c.Params("size", "10"))
+	if err != nil || size < 1 {
+		return fiber.NewError(http.StatusBadRequest, "invalid page size")
+	}
+
+	notifications, total, err := nh.inAppSvc.FindAll(ctx, userInfo.Email, filter == "unread", page, size)
+	if err != nil {
+		log.Printf("FindMyNotifications error:\n+%v", err)
+		return fiber.NewError(http.StatusInternalServerError, "Error while finding notifications")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"total":         total,
+		"notifications": notifications,
+	})
+}
+
+func (nh *NotificationHttpHandler) CountMyUnreadNotifications(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 500*time.Millisecond)
+	defer cancel()
+
+	userInfo, ok := c.UserContext().Value("current_user").(*types.JWTClaims)
+	if !ok {
+		return fiber.NewError(http.StatusUnauthorized, "sign in first")
+	}
+
+	total, err := nh.inAppSvc.CountUnread(ctx, userInfo.Email)
+	if err != nil {
+		log.Printf("CountMyUnreadNotifications error:\n+%v", err)
+		return fiber.NewError(http.StatusInternalServerError, "Error while counting notifications")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"unreadCount": total,
+	})
+}
+
+func (nh *NotificationHttpHandler) MarkMyNotificationAsRead(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 500*time.Millisecond)
+	defer cancel()
+
+	userInfo, ok := c.UserContext().Value("current_user").(*types.JWTClaims)
+	if !ok {
+		return fiber.NewError(http.StatusUnauthorized, "sign in first")
+	}
+
+	n, err := nh.inAppSvc.MarkRead(ctx, userInfo.Email, c.Params("id"))
+	if err != nil {
+		log.Printf("MarkMyNotificationAsRead error:\n+%v", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(http.StatusNotFound, "Notification is not found")
+		}
+		return fiber.NewError(http.StatusInternalServerError, "Error while updating notification")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"notification": n,
+	})
+}
+
+func (nh *NotificationHttpHandler) MarkAllMyNotificationsAsRead(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	userInfo, ok := c.UserContext().Value("current_user").(*types.JWTClaims)
+	if !ok {
+		return fiber.NewError(http.StatusUnauthorized, "sign in first")
+	}
+
+	total, err := nh.inAppSvc.MarkAllRead(ctx, userInfo.Email)
+	if err != nil {
+		log.Printf("MarkAllMyNotificationsAsRead error:\n+%v", err)
+		return fiber.NewError(http.StatusInternalServerError, "Error while updating notifications")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"total": total,
+	})
+}
+
+func (nh *NotificationHttpHandler) FindOutboxes(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	page, err := strconv.Atoi(c.Params("page", "1"))
+	if err != nil || page < 1 {
+		return fiber.NewError(http.StatusBadRequest, "invalid page number")
+	}
+
+	size, err := strconv.Atoi(c.Params("size", "10"))
+	if err != nil || size < 1 {
+		return fiber.NewError(http.StatusBadRequest, "invalid page size")
+	}
+
+	status := types.OutboxStatus(strings.ToUpper(c.Params("status")))
+	if status == "ALL" {
+		status = ""
+	}
+
+	outboxes, total, err := nh.outboxSvc.FindAll(ctx, status, page, size)
+	if err != nil {
+		log.Printf("FindOutboxes error:\n+%v", err)
+		return fiber.NewError(http.StatusInternalServerError, "Error while finding notifications")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"total":         total,
+		"notifications": outboxes,
+	})
+}
+
+func (nh *NotificationHttpHandler) FindOutboxByID(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 500*time.Millisecond)
+	defer cancel()
+
+	outbox, err := nh.outboxSvc.FindByID(ctx, c.Params("id"))
+	if err != nil {
+		log.Printf("FindOutboxByID error:\n+%v", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(http.StatusNotFound, "Notification is not found")
+		}
+		return fiber.NewError(http.StatusInternalServerError, "Error while finding notification")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"notification": outbox,
+	})
+}
+
+func (nh *NotificationHttpHandler) ReplayOutbox(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 500*time.Millisecond)
+	defer cancel()
+
+	outbox, err := nh.outboxSvc.Replay(ctx, c.Params("id"))
+	if err != nil {
+		log.Printf("ReplayOutbox error:\n+%v", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(http.StatusNotFound, "Dead notification is not found")
+		}
+		return fiber.NewError(http.StatusInternalServerError, "Error while replaying notification")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"notification": outbox,
+	})
+}
+
+func (nh *NotificationHttpHandler) ReplayAllDeadOutboxes(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 2*time.Second)
+	defer cancel()
+
+	total, err := nh.outboxSvc.ReplayAllDead(ctx)
+	if err != nil {
+		log.Printf("ReplayAllDeadOutboxes error:\n+%v", err)
+		return fiber.NewError(http.StatusInternalServerError, "Error while replaying notifications")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"total": total,
+	})
+}
+
+func (nh *NotificationHttpHandler) FindTemplates(c *fiber.Ctx) error {
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"templates": nh.templates.Names(),
+		"locales":   nh.templates.Locales(),
+	})
+}
+
+// INFO: FORMAT IS ONE OF json (DEFAULT), html OR text.
+// html AND text ARE SENT RAW SO THEY CAN BE OPENED DIRECTLY IN A BROWSER.
+// LOCALE DEFAULTS TO en
+func (nh *NotificationHttpHandler) PreviewTemplate(c *fiber.Ctx) error {
+	name := c.Params("name")
+	if !nh.templates.Has(name) {
+		return fiber.NewError(http.StatusNotFound, "Template is not found")
+	}
+
+	l := c.Params("locale", locale.DEFAULT)
+	if !nh.templates.HasLocale(l) {
+		return fiber.NewError(http.StatusNotFound, "Locale is not found")
+	}
+
+	email, err := nh.templates.Preview(l, name)
+	if err != nil {
+		log.Printf("PreviewTemplate error:\n+%v", err)
+		return fiber.NewError(http.StatusInternalServerError, "Error while rendering template")
+	}
+
+	switch c.Params("format", "json") {
+	case "html":
+		c.Type("html", "utf-8")
+		return c.Status(http.StatusOK).SendString(email.HTMLBody)
+	case "text":
+		c.Type("txt", "utf-8")
+		return c.Status(http.StatusOK).SendString(email.TextBody)
+	case "json":
+		return c.Status(http.StatusOK).JSON(fiber.Map{
+			"template": name,
+			"locale":   locale.Normalize(l),
+			"email":    email,
+		})
+	default:
+		return fiber.NewError(http.StatusBadRequest, "format must be json, html or text")
+	}
+}
@@ -0,0 +1,207 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Akihira77/gojobber/services/2-notification/helper"
+	"github.com/Akihira77/gojobber/services/2-notification/service"
+	"github.com/Akihira77/gojobber/services/2-notification/types"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type SuppressionHttpHandler struct {
+	suppressionSvc   service.SuppressionServiceImpl
+	unsubscriber     *helper.UnsubscribeSigner
+	mailEventsSecret string
+}
+
+func NewSuppressionHttpHandler(suppressionSvc service.SuppressionServiceImpl, unsubscriber *helper.UnsubscribeSigner, mailEventsSecret string) *SuppressionHttpHandler {
+	return &SuppressionHttpHandler{
+		suppressionSvc:   suppressionSvc,
+		unsubscriber:     unsubscriber,
+		mailEventsSecret: mailEventsSecret,
+	}
+}
+
+func (sh *SuppressionHttpHandler) verifyToken(c *fiber.Ctx) (string, string, error) {
+	if sh.unsubscriber == nil {
+		return "", "", fiber.NewError(http.StatusNotFound, "Unsubscribe link is not valid")
+	}
+
+	email, scope, err := sh.unsubscriber.Verify(c.Params("token"))
+	if err != nil {
+		return "", "", fiber.NewError(http.StatusNotFound, "Unsubscribe link is not valid")
+	}
+
+	return email, scope, nil
+}
+
+// FindUnsubscribe lets the unsubscribe page show what the link is for without
+// changing anything, link scanners open every URL in an email.
+func (sh *SuppressionHttpHandler) FindUnsubscribe(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 500*time.Millisecond)
+	defer cancel()
+
+	email, scope, err := sh.verifyToken(c)
+	if err != nil {
+		return err
+	}
+
+	suppressed, err := sh.suppressionSvc.IsSuppressed(ctx, email, types.OutboxEventType(scope))
+	if err != nil {
+		log.Printf("FindUnsubscribe error:\n+%v", err)
+		return fiber.NewError(http.StatusInternalServerError, "Error while finding subscription")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"email":        email,
+		"scope":        scope,
+		"unsubscribed": suppressed,
+	})
+}
+
+// Unsubscribe also serves the RFC 8058 one-click POST, so it must not need
+// a signed in user.
+func (sh *SuppressionHttpHandler) Unsubscribe(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 500*time.Millisecond)
+	defer cancel()
+
+	email, scope, err := sh.verifyToken(c)
+	if err != nil {
+		return err
+	}
+
+	err = sh.suppressionSvc.Unsubscribe(ctx, email, scope)
+	if err != nil {
+		log.Printf("Unsubscribe error:\n+%v", err)
+		if errors.Is(err, service.ErrInvalidSuppression) {
+			return fiber.NewError(http.StatusBadRequest, err.Error())
+		}
+		return fiber.NewError(http.StatusInternalServerError, "Error while unsubscribing")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"email":        email,
+		"scope":        scope,
+		"unsubscribed": true,
+	})
+}
+
+// HandleMailEvents is the bounce and complaint webhook of the mail provider,
+// it is signed with MAIL_EVENTS_SECRET instead of a user session.
+func (sh *SuppressionHttpHandler) HandleMailEvents(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 2*time.Second)
+	defer cancel()
+
+	if !helper.VerifyMailEvents(sh.mailEventsSecret, c.Body(), c.Get(helper.MAIL_EVENT_SIGNATURE_HEADER)) {
+		return fiber.NewError(http.StatusUnauthorized, "invalid mail events signature")
+	}
+
+	var data types.MailEventsDTO
+	if err := json.Unmarshal(c.Body(), &data); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid data")
+	}
+
+	suppressed, err := sh.suppressionSvc.HandleMailEvents(ctx, data.Events)
+	if err != nil {
+		log.Printf("HandleMailEvents error:\n+%v", err)
+		if errors.Is(err, service.ErrInvalidSuppression) {
+			return fiber.NewError(http.StatusBadRequest, err.Error())
+		}
+		return fiber.NewError(http.StatusInternalServerError, "Error while handling mail events")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"suppressed": suppressed,
+	})
+}
+
+func (sh *SuppressionHttpHandler) FindMySuppressions(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 500*time.Millisecond)
+	defer cancel()
+
+	userInfo, ok := c.UserContext().Value("current_user").(*types.JWTClaims)
+	if !ok {
+		return fiber.NewError(http.StatusUnauthorized, "sign in first")
+	}
+
+	suppressions, err := sh.suppressionSvc.FindByEmail(ctx, userInfo.Email)
+	if err != nil {
+		log.Printf("FindMySuppressions error:\n+%v", err)
+		return fiber.NewError(http.StatusInternalServerError, "Error while finding email suppressions")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"suppressions": suppressions,
+	})
+}
+
+func (sh *SuppressionHttpHandler) Resubscribe(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 500*time.Millisecond)
+	defer cancel()
+
+	userInfo, ok := c.UserContext().Value("current_user").(*types.JWTClaims)
+	if !ok {
+		return fiber.NewError(http.StatusUnauthorized, "sign in first")
+	}
+
+	err := sh.suppressionSvc.Resubscribe(ctx, userInfo.Email, c.Params("scope"))
+	if err != nil {
+		log.Printf("Resubscribe error:\n+%v", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(http.StatusNotFound, "Unsubscribe is not found")
+		}
+		return fiber.NewError(http.StatusInternalServerError, "Error while resubscribing")
+	}
+
+	return c.SendStatus(http.StatusOK)
+}
+
+func (sh *SuppressionHttpHandler) FindSuppressions(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	page, err := strconv.Atoi(c.Params("page", "1"))
+	if err != nil || page < 1 {
+		return fiber.NewError(http.StatusBadRequest, "invalid page number")
+	}
+
+	size, err := strconv.Atoi(c.Params("size", "10"))
+	if err != nil || size < 1 {
+		return fiber.NewError(http.StatusBadRequest, "invalid page size")
+	}
+
+	suppressions, total, err := sh.suppressionSvc.FindAll(ctx, page, size)
+	if err != nil {
+		log.Printf("FindSuppressions error:\n+%v", err)
+		return fiber.NewError(http.StatusInternalServerError, "Error while finding email suppressions")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"total":        total,
+		"suppressions": suppressions,
+	})
+}
+
+func (sh *SuppressionHttpHandler) RemoveSuppression(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 500*time.Millisecond)
+	defer cancel()
+
+	err := sh.suppressionSvc.Remove(ctx, c.Params("email"), c.Params("scope"))
+	if err != nil {
+		log.Printf("RemoveSuppression error:\n+%v", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(http.StatusNotFound, "Email suppression is not found")
+		}
+		return fiber.NewError(http.StatusInternalServerError, "Error while removing email suppression")
+	}
+
+	return c.SendStatus(http.StatusOK)
+}
@@ -0,0 +1,107 @@
+package handler
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/Akihira77/gojobber/services/2-notification/helper"
+	"github.com/Akihira77/gojobber/services/2-notification/service"
+	"github.com/Akihira77/gojobber/services/2-notification/types"
+	"github.com/Akihira77/gojobber/services/common/testdb"
+	"github.com/gofiber/fiber/v2"
+)
+
+const testMailEventsSecret = "mail-events-secret"
+
+// startMailEventsServer serves HandleMailEvents on the gateway path so the
+// FakeMailProvider can post to it the way it posts to the gateway.
+func startMailEventsServer(t *testing.T) (string, service.SuppressionServiceImpl) {
+	t.Helper()
+
+	db := testdb.Open(t, []interface{}{&types.EmailSuppression{}})
+	suppressionSvc := service.NewSuppressionService(db)
+	sh := NewSuppressionHttpHandler(suppressionSvc, nil, testMailEventsSecret)
+
+	app := fiber.New(fiber.Config{
+		DisableStartupMessage: true,
+	})
+	app.Post(helper.GATEWAY_MAIL_EVENTS_PATH, sh.HandleMailEvents)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	go app.Listener(ln)
+	t.Cleanup(func() {
+		app.Shutdown()
+	})
+
+	return "http://" + ln.Addr().String(), suppressionSvc
+}
+
+func TestHandleMailEventsBounce(t *testing.T) {
+	url, suppressionSvc := startMailEventsServer(t)
+	ctx := context.Background()
+
+	provider := helper.NewFakeMailProvider(url, testMailEventsSecret)
+	if err := provider.Bounce("Buyer@Example.com", "550 mailbox does not exist"); err != nil {
+		t.Fatalf("bounce: %v", err)
+	}
+
+	suppressed, err := suppressionSvc.IsSuppressed(ctx, "buyer@example.com", types.EVENT_SELLER_GOT_AN_ORDER)
+	if err != nil {
+		t.Fatalf("is suppressed: %v", err)
+	}
+	if !suppressed {
+		t.Error("a hard bounce did not suppress the address")
+	}
+
+	//INFO: SECURITY EMAILS ARE STILL SENT TO A BOUNCED ADDRESS
+	suppressed, err = suppressionSvc.IsSuppressed(ctx, "buyer@example.com", types.EVENT_USER_FORGOT_PASSWORD)
+	if err != nil {
+		t.Fatalf("is suppressed: %v", err)
+	}
+	if suppressed {
+		t.Error("a mandatory email was suppressed")
+	}
+}
+
+func TestHandleMailEventsSoftBounce(t *testing.T) {
+	url, suppressionSvc := startMailEventsServer(t)
+
+	provider := helper.NewFakeMailProvider(url, testMailEventsSecret)
+	err := provider.Send(types.MailEvent{
+		Type:       types.MAIL_EVENT_BOUNCE,
+		Email:      "buyer@example.com",
+		BounceType: "soft",
+	})
+	if err != nil {
+		t.Fatalf("send: %v", err)
+	}
+
+	suppressed, err := suppressionSvc.IsSuppressed(context.Background(), "buyer@example.com", types.EVENT_SELLER_GOT_AN_ORDER)
+	if err != nil {
+		t.Fatalf("is suppressed: %v", err)
+	}
+	if suppressed {
+		t.Error("a soft bounce suppressed the address")
+	}
+}
+
+func TestHandleMailEventsRejectsBadSignature(t *testing.T) {
+	url, suppressionSvc := startMailEventsServer(t)
+
+	provider := helper.NewFakeMailProvider(url, "wrong-secret")
+	if err := provider.Bounce("buyer@example.com", ""); err == nil {
+		t.Fatal("events signed with the wrong secret were accepted")
+	}
+
+	suppressed, err := suppressionSvc.IsSuppressed(context.Background(), "buyer@example.com", types.EVENT_SELLER_GOT_AN_ORDER)
+	if err != nil {
+		t.Fatalf("is suppressed: %v", err)
+	}
+	if suppressed {
+		t.Error("an unsigned bounce suppressed the address")
+	}
+}
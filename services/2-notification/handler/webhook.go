@@ -0,0 +1,240 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Akihira77/gojobber/services/2-notification/service"
+	"github.com/Akihira77/gojobber/services/2-notification/types"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type WebhookHttpHandler struct {
+	webhookSvc service.WebhookServiceImpl
+}
+
+func NewWebhookHttpHandler(webhookSvc service.WebhookServiceImpl) *WebhookHttpHandler {
+	return &WebhookHttpHandler{
+		webhookSvc: webhookSvc,
+	}
+}
+
+func (wh *WebhookHttpHandler) FindWebhookEvents(c *fiber.Ctx) error {
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"events": types.WebhookEventNames,
+	})
+}
+
+func (wh *WebhookHttpHandler) FindMyWebhooks(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 500*time.Millisecond)
+	defer cancel()
+
+	userInfo, ok := c.UserContext().Value("current_user").(*types.JWTClaims)
+	if !ok {
+		return fiber.NewError(http.StatusUnauthorized, "sign in first")
+	}
+
+	endpoints, err := wh.webhookSvc.FindEndpoints(ctx, userInfo.Email)
+	if err != nil {
+		log.Printf("FindMyWebhooks error:\n+%v", err)
+		return fiber.NewError(http.StatusInternalServerError, "Error while finding webhooks")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"webhooks": endpoints,
+	})
+}
+
+// INFO: THE SIGNING SECRET IS ONLY RETURNED HERE AND WHEN IT IS ROTATED
+func (wh *WebhookHttpHandler) CreateWebhook(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	userInfo, ok := c.UserContext().Value("current_user").(*types.JWTClaims)
+	if !ok {
+		return fiber.NewError(http.StatusUnauthorized, "sign in first")
+	}
+
+	var data types.UpsertWebhookEndpointDTO
+	if err := c.BodyParser(&data); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid data")
+	}
+
+	endpoint, err := wh.webhookSvc.CreateEndpoint(ctx, userInfo.Email, &data)
+	if err != nil {
+		log.Printf("CreateWebhook error:\n+%v", err)
+		if errors.Is(err, service.ErrInvalidWebhook) {
+			return fiber.NewError(http.StatusBadRequest, err.Error())
+		}
+		return fiber.NewError(http.StatusInternalServerError, "Error while saving webhook")
+	}
+
+	return c.Status(http.StatusCreated).JSON(fiber.Map{
+		"webhook": endpoint,
+		"secret":  endpoint.Secret,
+	})
+}
+
+func (wh *WebhookHttpHandler) UpdateWebhook(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	userInfo, ok := c.UserContext().Value("current_user").(*types.JWTClaims)
+	if !ok {
+		return fiber.NewError(http.StatusUnauthorized, "sign in first")
+	}
+
+	id := c.Params("id")
+	if _, err := uuid.Parse(id); err != nil {
+		return fiber.NewError(http.StatusNotFound, "Webhook is not found")
+	}
+
+	var data types.UpsertWebhookEndpointDTO
+	if err := c.BodyParser(&data); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid data")
+	}
+
+	endpoint, err := wh.webhookSvc.UpdateEndpoint(ctx, userInfo.Email, id, &data)
+	if err != nil {
+		log.Printf("UpdateWebhook error:\n+%v", err)
+		if errors.Is(err, service.ErrInvalidWebhook) {
+			return fiber.NewError(http.StatusBadRequest, err.Error())
+		}
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(http.StatusNotFound, "Webhook is not found")
+		}
+		return fiber.NewError(http.StatusInternalServerError, "Error while updating webhook")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"webhook": endpoint,
+	})
+}
+
+func (wh *WebhookHttpHandler) DeleteWebhook(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	userInfo, ok := c.UserContext().Value("current_user").(*types.JWTClaims)
+	if !ok {
+		return fiber.NewError(http.StatusUnauthorized, "sign in first")
+	}
+
+	id := c.Params("id")
+	if _, err := uuid.Parse(id); err != nil {
+		return fiber.NewError(http.StatusNotFound, "Webhook is not found")
+	}
+
+	err := wh.webhookSvc.DeleteEndpoint(ctx, userInfo.Email, id)
+	if err != nil {
+		log.Printf("DeleteWebhook error:\n+%v", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(http.StatusNotFound, "Webhook is not found")
+		}
+		return fiber.NewError(http.StatusInternalServerError, "Error while deleting webhook")
+	}
+
+	return c.SendStatus(http.StatusOK)
+}
+
+func (wh *WebhookHttpHandler) RotateWebhookSecret(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 500*time.Millisecond)
+	defer cancel()
+
+	userInfo, ok := c.UserContext().Value("current_user").(*types.JWTClaims)
+	if !ok {
+		return fiber.NewError(http.StatusUnauthorized, "sign in first")
+	}
+
+	id := c.Params("id")
+	if _, err := uuid.Parse(id); err != nil {
+		return fiber.NewError(http.StatusNotFound, "Webhook is not found")
+	}
+
+	endpoint, err := wh.webhookSvc.RotateSecret(ctx, userInfo.Email, id)
+	if err != nil {
+		log.Printf("RotateWebhookSecret error:\n+%v", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(http.StatusNotFound, "Webhook is not found")
+		}
+		return fiber.NewError(http.StatusInternalServerError, "Error while rotating webhook secret")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"webhook": endpoint,
+		"secret":  endpoint.Secret,
+	})
+}
+
+func (wh *WebhookHttpHandler) FindWebhookDeliveries(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	userInfo, ok := c.UserContext().Value("current_user").(*types.JWTClaims)
+	if !ok {
+		return fiber.NewError(http.StatusUnauthorized, "sign in first")
+	}
+
+	id := c.Params("id")
+	if _, err := uuid.Parse(id); err != nil {
+		return fiber.NewError(http.StatusNotFound, "Webhook is not found")
+	}
+
+	page, err := strconv.Atoi(c.Params("page", "1"))
+	if err != nil || page < 1 {
+		return fiber.NewError(http.StatusBadRequest, "invalid page number")
+	}
+
+	size, err := strconv.Atoi(c.Params("size", "10"))
+	if err != nil || size < 1 {
+		return fiber.NewError(http.StatusBadRequest, "invalid page size")
+	}
+
+	deliveries, total, err := wh.webhookSvc.FindDeliveries(ctx, userInfo.Email, id, page, size)
+	if err != nil {
+		log.Printf("FindWebhookDeliveries error:\n+%v", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(http.StatusNotFound, "Webhook is not found")
+		}
+		return fiber.NewError(http.StatusInternalServerError, "Error while finding webhook deliveries")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"total":      total,
+		"deliveries": deliveries,
+	})
+}
+
+func (wh *WebhookHttpHandler) RedeliverWebhook(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 500*time.Millisecond)
+	defer cancel()
+
+	userInfo, ok := c.UserContext().Value("current_user").(*types.JWTClaims)
+	if !ok {
+		return fiber.NewError(http.StatusUnauthorized, "sign in first")
+	}
+
+	deliveryID := c.Params("deliveryId")
+	if _, err := uuid.Parse(deliveryID); err != nil {
+		return fiber.NewError(http.StatusNotFound, "Webhook delivery is not found")
+	}
+
+	delivery, err := wh.webhookSvc.Redeliver(ctx, userInfo.Email, deliveryID)
+	if err != nil {
+		log.Printf("RedeliverWebhook error:\n+%v", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(http.StatusNotFound, "Webhook delivery is not found")
+		}
+		return fiber.NewError(http.StatusInternalServerError, "Error while redelivering webhook")
+	}
+
+	return c.Status(http.StatusCreated).JSON(fiber.Map{
+		"delivery": delivery,
+	})
+}
@@ -0,0 +1,99 @@
+package helper
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Akihira77/gojobber/services/2-notification/types"
+)
+
+const (
+	MAIL_EVENT_SIGNATURE_HEADER = "X-Mail-Signature"
+	GATEWAY_MAIL_EVENTS_PATH    = "/api/v1/gateway/notifications/email-events"
+)
+
+// SignMailEvents is the hex HMAC-SHA256 of the raw request body with the
+// MAIL_EVENTS_SECRET shared with the mail provider.
+func SignMailEvents(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func VerifyMailEvents(secret string, body []byte, signature string) bool {
+	if secret == "" || signature == "" {
+		return false
+	}
+
+	expected := SignMailEvents(secret, body)
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
+
+// FakeMailProvider plays the mail provider's side of the bounce and complaint
+// webhook, so local setups and tests can drive suppressions without one.
+type FakeMailProvider struct {
+	url    string
+	secret string
+	client *http.Client
+}
+
+func NewFakeMailProvider(gatewayURL, secret string) *FakeMailProvider {
+	return &FakeMailProvider{
+		url:    gatewayURL + GATEWAY_MAIL_EVENTS_PATH,
+		secret: secret,
+		client: &http.Client{
+			Timeout: 3 * time.Second,
+		},
+	}
+}
+
+func (fp *FakeMailProvider) Bounce(email, detail string) error {
+	return fp.Send(types.MailEvent{
+		Type:       types.MAIL_EVENT_BOUNCE,
+		Email:      email,
+		BounceType: types.MAIL_BOUNCE_HARD,
+		Detail:     detail,
+	})
+}
+
+func (fp *FakeMailProvider) Complain(email string) error {
+	return fp.Send(types.MailEvent{
+		Type:  types.MAIL_EVENT_COMPLAINT,
+		Email: email,
+	})
+}
+
+func (fp *FakeMailProvider) Send(events ...types.MailEvent) error {
+	b, err := json.Marshal(types.MailEventsDTO{
+		Events: events,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, fp.url, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(MAIL_EVENT_SIGNATURE_HEADER, SignMailEvents(fp.secret, b))
+
+	res, err := fp.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("mail events responded with status [%d]", res.StatusCode)
+	}
+
+	return nil
+}
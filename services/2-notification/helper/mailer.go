@@ -0,0 +1,256 @@
+package helper
+
+import (
+	"crypto/tls"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"gopkg.in/gomail.v2"
+)
+
+const (
+	MAIL_TRANSPORT_SMTP   = "smtp"
+	MAIL_TRANSPORT_FILE   = "file"
+	MAIL_TRANSPORT_MEMORY = "memory"
+
+	SMTP_TLS_STARTTLS = "starttls"
+	SMTP_TLS_SSL      = "ssl"
+)
+
+type Message struct {
+	From     string
+	FromName string
+	To       string
+	Subject  string
+	HTMLBody string
+	TextBody string
+	Headers  map[string]string
+	SentAt   time.Time
+}
+
+type Mailer interface {
+	Send(msg *Message) error
+}
+
+// NewMailerFromEnv picks the mail transport from MAIL_TRANSPORT, any value
+// other than "smtp", "file" or "memory" is an error. Deployments from before
+// MAIL_TRANSPORT only set the SMTP variables, so an unset MAIL_TRANSPORT
+// still means SMTP when SMTP_HOST or PASSWORD_SENDER is present and is an
+// error otherwise.
+func NewMailerFromEnv() (Mailer, error) {
+	from := os.Getenv("EMAIL_SENDER")
+	fromName := os.Getenv("EMAIL_SENDER_NAME")
+
+	transport := os.Getenv("MAIL_TRANSPORT")
+	if transport == "" {
+		if os.Getenv("SMTP_HOST") == "" && os.Getenv("PASSWORD_SENDER") == "" {
+			return nil, fmt.Errorf("MAIL_TRANSPORT is not set, must be %s, %s or %s", MAIL_TRANSPORT_SMTP, MAIL_TRANSPORT_FILE, MAIL_TRANSPORT_MEMORY)
+		}
+		transport = MAIL_TRANSPORT_SMTP
+	}
+	log.Printf("Using [%s] mail transport", transport)
+
+	switch transport {
+	case MAIL_TRANSPORT_FILE:
+		path := os.Getenv("MAIL_FILE_PATH")
+		if path == "" {
+			path = "mails.mbox"
+		}
+		return NewFileMailer(path, from, fromName), nil
+	case MAIL_TRANSPORT_MEMORY:
+		return NewMemoryMailer(from, fromName), nil
+	case MAIL_TRANSPORT_SMTP:
+		host := os.Getenv("SMTP_HOST")
+		if host == "" {
+			host = "smtp.gmail.com"
+		}
+
+		port := 587
+		if p := os.Getenv("SMTP_PORT"); p != "" {
+			v, err := strconv.Atoi(p)
+			if err != nil {
+				return nil, fmt.Errorf("invalid SMTP_PORT [%s]: %w", p, err)
+			}
+			port = v
+		}
+
+		tlsMode := os.Getenv("SMTP_TLS")
+		if tlsMode == "" {
+			tlsMode = SMTP_TLS_STARTTLS
+		}
+		if tlsMode != SMTP_TLS_STARTTLS && tlsMode != SMTP_TLS_SSL {
+			return nil, fmt.Errorf("invalid SMTP_TLS [%s], must be %s or %s", tlsMode, SMTP_TLS_STARTTLS, SMTP_TLS_SSL)
+		}
+
+		return &SMTPMailer{
+			Host:               host,
+			Port:               port,
+			Username:           from,
+			Password:           os.Getenv("PASSWORD_SENDER"),
+			From:               from,
+			FromName:           fromName,
+			TLSMode:            tlsMode,
+			InsecureSkipVerify: os.Getenv("SMTP_TLS_SKIP_VERIFY") == "true",
+		}, nil
+	default:
+		return nil, fmt.Errorf("invalid MAIL_TRANSPORT [%s], must be %s, %s or %s", transport, MAIL_TRANSPORT_SMTP, MAIL_TRANSPORT_FILE, MAIL_TRANSPORT_MEMORY)
+	}
+}
+
+func SendMail(mailer Mailer, to string, email *RenderedEmail) error {
+	return mailer.Send(&Message{
+		To:       to,
+		Subject:  email.Subject,
+		HTMLBody: email.HTMLBody,
+		TextBody: email.TextBody,
+		Headers:  email.Headers,
+	})
+}
+
+func buildGomailMessage(msg *Message) *gomail.Message {
+	m := gomail.NewMessage()
+	m.SetHeader("From", msg.From)
+	m.SetHeader("To", msg.To)
+	if msg.FromName != "" {
+		m.SetAddressHeader("Cc", msg.From, msg.FromName)
+	}
+	m.SetHeader("Subject", msg.Subject)
+	m.SetDateHeader("Date", msg.SentAt)
+	for k, v := range msg.Headers {
+		m.SetHeader(k, v)
+	}
+	// NOTE: MAIL CLIENTS PREFER THE LAST ALTERNATIVE THEY SUPPORT,
+	// SO THE PLAIN TEXT PART MUST COME BEFORE THE HTML ONE
+	if msg.TextBody != "" {
+		m.SetBody("text/plain", msg.TextBody)
+		m.AddAlternative("text/html", msg.HTMLBody)
+	} else {
+		m.SetBody("text/html", msg.HTMLBody)
+	}
+
+	return m
+}
+
+func fillSender(msg *Message, from, fromName string) {
+	if msg.From == "" {
+		msg.From = from
+	}
+	if msg.FromName == "" {
+		msg.FromName = fromName
+	}
+	if msg.SentAt.IsZero() {
+		msg.SentAt = time.Now()
+	}
+}
+
+// INFO: SMTP TRANSPORT
+type SMTPMailer struct {
+	Host               string
+	Port               int
+	Username           string
+	Password           string
+	From               string
+	FromName           string
+	TLSMode            string
+	InsecureSkipVerify bool
+}
+
+func (sm *SMTPMailer) Send(msg *Message) error {
+	fillSender(msg, sm.From, sm.FromName)
+
+	dialer := gomail.NewDialer(sm.Host, sm.Port, sm.Username, sm.Password)
+	dialer.SSL = sm.TLSMode == SMTP_TLS_SSL
+	dialer.TLSConfig = &tls.Config{
+		ServerName:         sm.Host,
+		InsecureSkipVerify: sm.InsecureSkipVerify,
+	}
+
+	return dialer.DialAndSend(buildGomailMessage(msg))
+}
+
+// INFO: FILE (MBOX) TRANSPORT
+// Every message is appended to a single mbox file so it can be opened
+// with any mail client while developing locally.
+type FileMailer struct {
+	mutex    sync.Mutex
+	Path     string
+	From     string
+	FromName string
+}
+
+func NewFileMailer(path, from, fromName string) *FileMailer {
+	return &FileMailer{
+		Path:     path,
+		From:     from,
+		FromName: fromName,
+	}
+}
+
+func (fm *FileMailer) Send(msg *Message) error {
+	fillSender(msg, fm.From, fm.FromName)
+
+	fm.mutex.Lock()
+	defer fm.mutex.Unlock()
+
+	f, err := os.OpenFile(fm.Path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("open mbox file: %w", err)
+	}
+	defer f.Close()
+
+	_, err = fmt.Fprintf(f, "From %s %s\n", msg.From, msg.SentAt.UTC().Format(time.ANSIC))
+	if err != nil {
+		return err
+	}
+
+	if _, err = buildGomailMessage(msg).WriteTo(f); err != nil {
+		return err
+	}
+
+	_, err = f.WriteString("\n\n")
+	return err
+}
+
+// INFO: IN-MEMORY TRANSPORT
+type MemoryMailer struct {
+	mutex    sync.RWMutex
+	messages []Message
+	From     string
+	FromName string
+}
+
+func NewMemoryMailer(from, fromName string) *MemoryMailer {
+	return &MemoryMailer{
+		From:     from,
+		FromName: fromName,
+	}
+}
+
+func (mm *MemoryMailer) Send(msg *Message) error {
+	fillSender(msg, mm.From, mm.FromName)
+
+	mm.mutex.Lock()
+	defer mm.mutex.Unlock()
+	mm.messages = append(mm.messages, *msg)
+
+	return nil
+}
+
+func (mm *MemoryMailer) Messages() []Message {
+	mm.mutex.RLock()
+	defer mm.mutex.RUnlock()
+
+	messages := make([]Message, len(mm.messages))
+	copy(messages, mm.messages)
+	return messages
+}
+
+func (mm *MemoryMailer) Reset() {
+	mm.mutex.Lock()
+	defer mm.mutex.Unlock()
+	mm.messages = nil
+}
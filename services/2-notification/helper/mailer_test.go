@@ -0,0 +1,45 @@
+package helper
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestNewMailerFromEnv(t *testing.T) {
+	tests := []struct {
+		name      string
+		transport string
+		smtpHost  string
+		want      string
+	}{
+		{name: "unset", transport: ""},
+		{name: "unset with SMTP_HOST", transport: "", smtpHost: "smtp.example.com", want: "*helper.SMTPMailer"},
+		{name: "file", transport: MAIL_TRANSPORT_FILE, want: "*helper.FileMailer"},
+		{name: "memory", transport: MAIL_TRANSPORT_MEMORY, want: "*helper.MemoryMailer"},
+		{name: "smtp", transport: MAIL_TRANSPORT_SMTP, want: "*helper.SMTPMailer"},
+		{name: "unknown", transport: "smpt"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("MAIL_TRANSPORT", tt.transport)
+			t.Setenv("SMTP_HOST", tt.smtpHost)
+			t.Setenv("PASSWORD_SENDER", "")
+			t.Setenv("MAIL_FILE_PATH", t.TempDir()+"/mails.mbox")
+
+			mailer, err := NewMailerFromEnv()
+			if tt.want == "" {
+				if err == nil {
+					t.Fatalf("got %T, want an error", mailer)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := fmt.Sprintf("%T", mailer); got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package helper
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/Akihira77/gojobber/services/2-notification/util"
+)
+
+const GATEWAY_PUSH_PATH = "/api/v1/gateway/internal/notifications/push"
+
+// Pusher delivers realtime payloads to the user's open WebSocket connections.
+type Pusher interface {
+	Push(receiverEmail string, data []byte) error
+}
+
+// INFO: WEBSOCKET CONNECTIONS LIVE IN THE GATEWAY, SO PUSHES ARE FORWARDED
+// THERE AND SIGNED WITH THE SHARED GATEWAY_TOKEN SECRET
+type GatewayPusher struct {
+	url    string
+	secret string
+	client *http.Client
+}
+
+func NewGatewayPusher(gatewayURL, secret string) *GatewayPusher {
+	return &GatewayPusher{
+		url:    gatewayURL + GATEWAY_PUSH_PATH,
+		secret: secret,
+		client: &http.Client{
+			Timeout: 3 * time.Second,
+		},
+	}
+}
+
+func (gp *GatewayPusher) Push(receiverEmail string, data []byte) error {
+	token, err := util.GenerateServiceJWT(gp.secret)
+	if err != nil {
+		return err
+	}
+
+	b, err := json.Marshal(map[string]interface{}{
+		"receiverEmail": receiverEmail,
+		"data":          json.RawMessage(data),
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, gp.url, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("serviceToken", token)
+
+	res, err := gp.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("gateway responded push with status [%d]", res.StatusCode)
+	}
+
+	return nil
+}
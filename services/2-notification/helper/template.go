@@ -0,0 +1,434 @@
+package helper
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/Akihira77/gojobber/services/common/locale"
+)
+
+const (
+	TEMPLATE_VERIFY_EMAIL                      = "verifyEmail"
+	TEMPLATE_RESET_PASSWORD                    = "resetPassword"
+	TEMPLATE_RESET_PASSWORD_SUCCESS            = "resetPasswordSuccess"
+	TEMPLATE_CHAT_NOTIFICATION                 = "chatNotification"
+	TEMPLATE_CHAT_DIGEST                       = "chatDigest"
+	TEMPLATE_SELLER_ORDER_COMPLETED            = "sellerOrderCompleted"
+	TEMPLATE_SELLER_DEADLINE_EXTENSION         = "sellerDeadlineExtension"
+	TEMPLATE_SELLER_CANCELED_ORDER             = "sellerCanceledOrder"
+	TEMPLATE_BUYER_DEADLINE_EXTENSION_RESPONSE = "buyerDeadlineExtensionResponse"
+	TEMPLATE_BUYER_REFUNDED_ORDER              = "buyerRefundedOrder"
+	TEMPLATE_SELLER_GOT_AN_ORDER               = "sellerGotAnOrder"
+	TEMPLATE_SELLER_GOT_A_REVIEW               = "sellerGotAReview"
+	TEMPLATE_BUYER_ORDER_DELIVERED             = "buyerOrderDelivered"
+	TEMPLATE_BUYER_ORDER_ACKNOWLEDGED          = "buyerOrderAcknowledged"
+	TEMPLATE_SELLER_BUYER_RESPONDED_DELIVERY   = "sellerBuyerRespondedDelivery"
+	TEMPLATE_SELLER_PAYOUT_PAID                = "sellerPayoutPaid"
+	TEMPLATE_SELLER_PAYOUT_FAILED              = "sellerPayoutFailed"
+	TEMPLATE_USER_DATA_EXPORT_READY            = "userDataExportReady"
+
+	APP_ICON = "https://i.ibb.co/Kyp2m0t/cover.png"
+)
+
+type TemplateDefinition struct {
+	Name       string
+	SampleData map[string]interface{}
+}
+
+// INFO: EVERY NOTIFICATION TYPE MUST BE REGISTERED HERE AND HAVE
+// emails/<name>.html, emails/<name>.txt AND A SUBJECT IN emails/subjects.json.
+// A TRANSLATION LIVES IN emails/<locale>/ AND ANY FILE OR SUBJECT IT DOES NOT
+// HAVE FALLS BACK TO THE ENGLISH ONE
+var templateDefinitions = []TemplateDefinition{
+	{
+		Name: TEMPLATE_VERIFY_EMAIL,
+		SampleData: map[string]interface{}{
+			"VerifyLink": "http://localhost:3000/confirm_email?token=sample-token",
+		},
+	},
+	{
+		Name: TEMPLATE_RESET_PASSWORD,
+		SampleData: map[string]interface{}{
+			"Username":  "johndoe",
+			"ResetLink": "http://localhost:3000/reset_password?token=sample-token",
+		},
+	},
+	{
+		Name: TEMPLATE_RESET_PASSWORD_SUCCESS,
+		SampleData: map[string]interface{}{
+			"Username": "johndoe",
+		},
+	},
+	{
+		Name: TEMPLATE_CHAT_NOTIFICATION,
+		SampleData: map[string]interface{}{
+			"SenderEmail": "janedoe@example.com",
+			"Message":     "Hi, are you available to start a new project this week?",
+		},
+	},
+	{
+		Name: TEMPLATE_CHAT_DIGEST,
+		SampleData: map[string]interface{}{
+			"MessageCount": 3,
+			"SenderCount":  2,
+			"Senders": []map[string]interface{}{
+				{
+					"SenderEmail": "janedoe@example.com",
+					"Messages":    []string{"Hi, are you available this week?", "I have a small API project."},
+				},
+				{
+					"SenderEmail": "johnsmith@example.com",
+					"Messages":    []string{"Thanks for the quick delivery!"},
+				},
+			},
+			"UnsubscribeURL": "http://localhost:3000/unsubscribe?token=sample-token",
+		},
+	},
+	{
+		Name: TEMPLATE_SELLER_ORDER_COMPLETED,
+		SampleData: map[string]interface{}{
+			"BuyerEmail":           "janedoe@example.com",
+			"OrderID":              "JOsampleorderid",
+			"SellerCurrentBalance": "120",
+			"OrderURL":             "http://localhost:3000/orders/JOsampleorderid",
+		},
+	},
+	{
+		Name: TEMPLATE_SELLER_DEADLINE_EXTENSION,
+		SampleData: map[string]interface{}{
+			"OrderURL": "http://localhost:3000/orders/JOsampleorderid",
+		},
+	},
+	{
+		Name: TEMPLATE_SELLER_CANCELED_ORDER,
+		SampleData: map[string]interface{}{
+			"OrderURL": "http://localhost:3000/orders/JOsampleorderid",
+		},
+	},
+	{
+		Name: TEMPLATE_BUYER_DEADLINE_EXTENSION_RESPONSE,
+		SampleData: map[string]interface{}{
+			"OrderURL": "http://localhost:3000/orders/JOsampleorderid",
+		},
+	},
+	{
+		Name: TEMPLATE_BUYER_REFUNDED_ORDER,
+		SampleData: map[string]interface{}{
+			"OrderURL": "http://localhost:3000/orders/JOsampleorderid",
+		},
+	},
+	{
+		Name: TEMPLATE_SELLER_GOT_AN_ORDER,
+		SampleData: map[string]interface{}{
+			"Message":        "Buyer Has Purchased Your Gig",
+			"GigTitle":       "I will build your REST API in Go",
+			"GigDescription": "A production ready REST API with authentication and tests.",
+			"Price":          uint64(100),
+			"ServiceFee":     uint64(3),
+			"Deadline":       "Mon, 02 Jan 2006 15:04 UTC",
+		},
+	},
+	{
+		Name: TEMPLATE_SELLER_GOT_A_REVIEW,
+		SampleData: map[string]interface{}{
+			"Message":        "janedoe gave you 5 stars:\nGreat work, delivered ahead of schedule!",
+			"UnsubscribeURL": "http://localhost:3000/unsubscribe?token=sample-token",
+		},
+	},
+	{
+		Name: TEMPLATE_BUYER_ORDER_DELIVERED,
+		SampleData: map[string]interface{}{
+			"OrderURL": "http://localhost:3000/orders/JOsampleorderid",
+		},
+	},
+	{
+		Name: TEMPLATE_BUYER_ORDER_ACKNOWLEDGED,
+		SampleData: map[string]interface{}{
+			"OrderURL": "http://localhost:3000/orders/JOsampleorderid",
+		},
+	},
+	{
+		Name: TEMPLATE_SELLER_BUYER_RESPONDED_DELIVERY,
+		SampleData: map[string]interface{}{
+			"OrderURL": "http://localhost:3000/orders/JOsampleorderid",
+		},
+	},
+	{
+		Name: TEMPLATE_SELLER_PAYOUT_PAID,
+		SampleData: map[string]interface{}{
+			"PayoutID":  "3f8a1c2e-5b7d-4e9f-a1c3-5e7f9b1d3a5c",
+			"Amount":    150,
+			"PayoutURL": "http://localhost:3000/seller/payouts",
+		},
+	},
+	{
+		Name: TEMPLATE_SELLER_PAYOUT_FAILED,
+		SampleData: map[string]interface{}{
+			"PayoutID":       "3f8a1c2e-5b7d-4e9f-a1c3-5e7f9b1d3a5c",
+			"Amount":         150,
+			"Reason":         "The destination account can not receive transfers yet.",
+			"CurrentBalance": 320,
+			"PayoutURL":      "http://localhost:3000/seller/payouts",
+		},
+	},
+	{
+		Name: TEMPLATE_USER_DATA_EXPORT_READY,
+		SampleData: map[string]interface{}{
+			"ExportURL": "http://localhost:3000/settings/data-exports/3f8a1c2e-5b7d-4e9f-a1c3-5e7f9b1d3a5c",
+			"ExpiresAt": "Thu, 22 Oct 2026 08:00 UTC",
+		},
+	},
+}
+
+type RenderedEmail struct {
+	Subject  string `json:"subject"`
+	HTMLBody string `json:"html"`
+	TextBody string `json:"text"`
+	// NOTE: THE TEXT CONTENT WITHOUT LAYOUT, USED BY IN-APP NOTIFICATIONS
+	Summary string `json:"summary"`
+	// NOTE: EXTRA MAIL HEADERS, E.G. List-Unsubscribe FOR NON-TRANSACTIONAL EMAILS
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+type emailTemplate struct {
+	def     TemplateDefinition
+	subject *texttemplate.Template
+	html    *htmltemplate.Template
+	text    *texttemplate.Template
+}
+
+type TemplateRegistry struct {
+	appLink string
+	// NOTE: LOCALE -> TEMPLATE NAME -> PARSED TEMPLATE
+	locales map[string]map[string]*emailTemplate
+}
+
+// LoadTemplateRegistry parses every registered template together with the
+// shared layout once, so sending an email never touches the filesystem.
+// The English templates in dir are required, every sub directory of dir is
+// loaded as an additional locale.
+func LoadTemplateRegistry(dir string) (*TemplateRegistry, error) {
+	r := &TemplateRegistry{
+		appLink: os.Getenv("CLIENT_URL"),
+		locales: make(map[string]map[string]*emailTemplate),
+	}
+
+	subjects, err := loadSubjects(filepath.Join(dir, "subjects.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	r.locales[locale.DEFAULT], err = loadLocale(dir, "", subjects)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		l := locale.Normalize(entry.Name())
+		if l == locale.DEFAULT {
+			continue
+		}
+
+		localeSubjects, err := loadSubjects(filepath.Join(dir, entry.Name(), "subjects.json"))
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+		for name, subject := range subjects {
+			if _, ok := localeSubjects[name]; !ok {
+				localeSubjects[name] = subject
+			}
+		}
+
+		r.locales[l], err = loadLocale(dir, filepath.Join(dir, entry.Name()), localeSubjects)
+		if err != nil {
+			return nil, fmt.Errorf("locale [%s]: %w", l, err)
+		}
+	}
+
+	return r, nil
+}
+
+func loadSubjects(path string) (map[string]string, error) {
+	subjects := make(map[string]string)
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return subjects, err
+	}
+
+	if err := json.Unmarshal(b, &subjects); err != nil {
+		return nil, fmt.Errorf("parse subjects [%s]: %w", path, err)
+	}
+
+	return subjects, nil
+}
+
+// loadLocale parses the templates of one locale, a file that is missing in
+// localeDir is taken from baseDir instead.
+func loadLocale(baseDir string, localeDir string, subjects map[string]string) (map[string]*emailTemplate, error) {
+	funcs := map[string]interface{}{
+		"dict": dict,
+	}
+
+	file := func(name string) string {
+		if localeDir != "" {
+			path := filepath.Join(localeDir, name)
+			if _, err := os.Stat(path); err == nil {
+				return path
+			}
+		}
+
+		return filepath.Join(baseDir, name)
+	}
+
+	templates := make(map[string]*emailTemplate, len(templateDefinitions))
+	for _, def := range templateDefinitions {
+		subjectText, ok := subjects[def.Name]
+		if !ok {
+			return nil, fmt.Errorf("subject of template [%s] is not found", def.Name)
+		}
+
+		subject, err := texttemplate.New("subject").Parse(subjectText)
+		if err != nil {
+			return nil, fmt.Errorf("parse subject of template [%s]: %w", def.Name, err)
+		}
+
+		html, err := htmltemplate.New(def.Name).
+			Funcs(funcs).
+			ParseFiles(file("layout.html"), file(def.Name+".html"))
+		if err != nil {
+			return nil, fmt.Errorf("parse html of template [%s]: %w", def.Name, err)
+		}
+
+		text, err := texttemplate.New(def.Name).
+			Funcs(funcs).
+			ParseFiles(file("layout.txt"), file(def.Name+".txt"))
+		if err != nil {
+			return nil, fmt.Errorf("parse text of template [%s]: %w", def.Name, err)
+		}
+
+		templates[def.Name] = &emailTemplate{
+			def:     def,
+			subject: subject,
+			html:    html,
+			text:    text,
+		}
+	}
+
+	return templates, nil
+}
+
+func (r *TemplateRegistry) Names() []string {
+	names := make([]string, 0, len(templateDefinitions))
+	for _, def := range templateDefinitions {
+		names = append(names, def.Name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func (r *TemplateRegistry) Locales() []string {
+	locales := make([]string, 0, len(r.locales))
+	for l := range r.locales {
+		locales = append(locales, l)
+	}
+	sort.Strings(locales)
+
+	return locales
+}
+
+func (r *TemplateRegistry) HasLocale(l string) bool {
+	_, ok := r.locales[locale.Normalize(l)]
+	return ok
+}
+
+// Render renders a template in the requested locale, an unknown or empty
+// locale is rendered in English.
+func (r *TemplateRegistry) Render(l string, name string, data map[string]interface{}) (*RenderedEmail, error) {
+	templates, ok := r.locales[locale.Normalize(l)]
+	if !ok {
+		templates = r.locales[locale.DEFAULT]
+	}
+
+	t, ok := templates[name]
+	if !ok {
+		return nil, fmt.Errorf("email template [%s] is not registered", name)
+	}
+
+	payload := make(map[string]interface{}, len(data)+2)
+	for k, v := range data {
+		payload[k] = v
+	}
+	payload["AppLink"] = r.appLink
+	payload["AppIcon"] = APP_ICON
+
+	var subject, html, text, summary bytes.Buffer
+	if err := t.subject.Execute(&subject, payload); err != nil {
+		return nil, fmt.Errorf("render subject of template [%s]: %w", name, err)
+	}
+	if err := t.html.ExecuteTemplate(&html, "layout", payload); err != nil {
+		return nil, fmt.Errorf("render html of template [%s]: %w", name, err)
+	}
+	if err := t.text.ExecuteTemplate(&text, "layout", payload); err != nil {
+		return nil, fmt.Errorf("render text of template [%s]: %w", name, err)
+	}
+	if err := t.text.ExecuteTemplate(&summary, "content", payload); err != nil {
+		return nil, fmt.Errorf("render summary of template [%s]: %w", name, err)
+	}
+
+	return &RenderedEmail{
+		Subject:  subject.String(),
+		HTMLBody: html.String(),
+		TextBody: text.String(),
+		Summary:  strings.TrimSpace(summary.String()),
+	}, nil
+}
+
+// Preview renders a template with its registered sample data.
+func (r *TemplateRegistry) Preview(l string, name string) (*RenderedEmail, error) {
+	t, ok := r.locales[locale.DEFAULT][name]
+	if !ok {
+		return nil, fmt.Errorf("email template [%s] is not registered", name)
+	}
+
+	return r.Render(l, name, t.def.SampleData)
+}
+
+func (r *TemplateRegistry) Has(name string) bool {
+	_, ok := r.locales[locale.DEFAULT][name]
+	return ok
+}
+
+func dict(pairs ...interface{}) (map[string]interface{}, error) {
+	if len(pairs)%2 != 0 {
+		return nil, fmt.Errorf("dict expects key/value pairs")
+	}
+
+	m := make(map[string]interface{}, len(pairs)/2)
+	for i := 0; i < len(pairs); i += 2 {
+		key, ok := pairs[i].(string)
+		if !ok {
+			return nil, fmt.Errorf("dict key must be a string, got %T", pairs[i])
+		}
+		m[key] = pairs[i+1]
+	}
+
+	return m, nil
+}
@@ -0,0 +1,115 @@
+package helper
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRenderThroughMemoryMailer(t *testing.T) {
+	t.Setenv("CLIENT_URL", "http://localhost:3000")
+
+	templates, err := LoadTemplateRegistry("../emails")
+	if err != nil {
+		t.Fatalf("load templates: %v", err)
+	}
+
+	email, err := templates.Render("en", TEMPLATE_SELLER_PAYOUT_PAID, map[string]interface{}{
+		"PayoutID":  "3f8a1c2e-5b7d-4e9f-a1c3-5e7f9b1d3a5c",
+		"Amount":    150,
+		"PayoutURL": "http://localhost:3000/seller/payouts",
+	})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+
+	signer := NewUnsubscribeSigner("secret", "http://localhost:4000/unsubscribe", "http://localhost:3000/unsubscribe")
+	email.Headers = signer.Headers(signer.Token("seller@example.com", "sellerPayoutPaid"))
+
+	mailer := NewMemoryMailer("noreply@gojobber.test", "Gojobber")
+	if err := SendMail(mailer, "seller@example.com", email); err != nil {
+		t.Fatalf("send: %v", err)
+	}
+
+	messages := mailer.Messages()
+	if len(messages) != 1 {
+		t.Fatalf("got %d messages, want 1", len(messages))
+	}
+
+	msg := messages[0]
+	if msg.To != "seller@example.com" || msg.From != "noreply@gojobber.test" || msg.FromName != "Gojobber" {
+		t.Errorf("unexpected envelope: to=%q from=%q fromName=%q", msg.To, msg.From, msg.FromName)
+	}
+	if msg.Subject != "Your Withdrawal Of $150 Has Been Paid" {
+		t.Errorf("subject = %q", msg.Subject)
+	}
+	if !strings.Contains(msg.HTMLBody, "http://localhost:3000/seller/payouts") {
+		t.Error("html body is missing the payout link")
+	}
+	if !strings.Contains(msg.TextBody, "http://localhost:3000/seller/payouts") {
+		t.Error("text body is missing the payout link")
+	}
+	if msg.Headers["List-Unsubscribe-Post"] != "List-Unsubscribe=One-Click" {
+		t.Errorf("headers = %v", msg.Headers)
+	}
+
+	mailer.Reset()
+	if len(mailer.Messages()) != 0 {
+		t.Error("Reset kept the sent messages")
+	}
+}
+
+func TestPreviewRendersEveryTemplate(t *testing.T) {
+	templates, err := LoadTemplateRegistry("../emails")
+	if err != nil {
+		t.Fatalf("load templates: %v", err)
+	}
+
+	for _, def := range templateDefinitions {
+		email, err := templates.Preview("en", def.Name)
+		if err != nil {
+			t.Errorf("preview [%s]: %v", def.Name, err)
+			continue
+		}
+		if email.Subject == "" || email.HTMLBody == "" || email.TextBody == "" {
+			t.Errorf("preview [%s] rendered an empty part", def.Name)
+		}
+	}
+}
+
+func TestRenderUnknownTemplate(t *testing.T) {
+	templates, err := LoadTemplateRegistry("../emails")
+	if err != nil {
+		t.Fatalf("load templates: %v", err)
+	}
+
+	if _, err := templates.Render("en", "doesNotExist", nil); err == nil {
+		t.Error("rendering an unregistered template did not fail")
+	}
+}
+
+func TestIndonesianTemplatesAreComplete(t *testing.T) {
+	templates, err := LoadTemplateRegistry("../emails")
+	if err != nil {
+		t.Fatalf("load templates: %v", err)
+	}
+
+	//INFO: A MISSING FILE SILENTLY FALLS BACK TO ENGLISH, SO EVERY TEMPLATE MUST BE TRANSLATED
+	for _, def := range templateDefinitions {
+		for _, ext := range []string{".html", ".txt"} {
+			if _, err := os.Stat(filepath.Join("../emails/id", def.Name+ext)); err != nil {
+				t.Errorf("template [%s%s] is not translated: %v", def.Name, ext, err)
+			}
+		}
+
+		email, err := templates.Preview("id", def.Name)
+		if err != nil {
+			t.Errorf("preview [%s]: %v", def.Name, err)
+			continue
+		}
+		if !strings.Contains(email.TextBody, "Tim Jobber") {
+			t.Errorf("preview [%s] is not rendered with the Indonesian layout", def.Name)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package helper
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strings"
+)
+
+const GATEWAY_UNSUBSCRIBE_PATH = "/api/v1/gateway/notifications/unsubscribe"
+
+var ErrInvalidUnsubscribeToken = errors.New("invalid unsubscribe token")
+
+type unsubscribeClaims struct {
+	Email string `json:"e"`
+	Scope string `json:"s"`
+}
+
+// INFO: UNSUBSCRIBE TOKENS DO NOT EXPIRE, AN OLD EMAIL MUST STILL BE ABLE TO
+// UNSUBSCRIBE. ROTATING UNSUBSCRIBE_SECRET INVALIDATES EVERY SENT LINK
+type UnsubscribeSigner struct {
+	secret  []byte
+	apiURL  string
+	pageURL string
+}
+
+// NewUnsubscribeSigner signs tokens for the one-click endpoint at apiURL
+// (List-Unsubscribe header) and the confirmation page at pageURL (email footer).
+func NewUnsubscribeSigner(secret, apiURL, pageURL string) *UnsubscribeSigner {
+	return &UnsubscribeSigner{
+		secret:  []byte(secret),
+		apiURL:  strings.TrimSuffix(apiURL, "/"),
+		pageURL: pageURL,
+	}
+}
+
+// Token encodes "<base64 claims>.<base64 HMAC-SHA256 of the claims>".
+func (us *UnsubscribeSigner) Token(email, scope string) string {
+	b, _ := json.Marshal(unsubscribeClaims{
+		Email: email,
+		Scope: scope,
+	})
+
+	payload := base64.RawURLEncoding.EncodeToString(b)
+	return payload + "." + base64.RawURLEncoding.EncodeToString(us.sign(payload))
+}
+
+func (us *UnsubscribeSigner) Verify(token string) (string, string, error) {
+	payload, signature, ok := strings.Cut(token, ".")
+	if !ok {
+		return "", "", ErrInvalidUnsubscribeToken
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil || !hmac.Equal(sig, us.sign(payload)) {
+		return "", "", ErrInvalidUnsubscribeToken
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return "", "", ErrInvalidUnsubscribeToken
+	}
+
+	var claims unsubscribeClaims
+	if err := json.Unmarshal(b, &claims); err != nil || claims.Email == "" || claims.Scope == "" {
+		return "", "", ErrInvalidUnsubscribeToken
+	}
+
+	return claims.Email, claims.Scope, nil
+}
+
+func (us *UnsubscribeSigner) PageURL(token string) string {
+	return us.pageURL + "?token=" + url.QueryEscape(token)
+}
+
+// Headers follows RFC 8058 so mail clients can unsubscribe with a single
+// POST to the API without opening the page.
+func (us *UnsubscribeSigner) Headers(token string) map[string]string {
+	return map[string]string{
+		"List-Unsubscribe":      "<" + us.apiURL + "/" + token + ">",
+		"List-Unsubscribe-Post": "List-Unsubscribe=One-Click",
+	}
+}
+
+func (us *UnsubscribeSigner) sign(payload string) []byte {
+	mac := hmac.New(sha256.New, us.secret)
+	mac.Write([]byte(payload))
+
+	return mac.Sum(nil)
+}
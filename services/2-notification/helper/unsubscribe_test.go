@@ -0,0 +1,55 @@
+package helper
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestUnsubscribeTokenRoundTrip(t *testing.T) {
+	signer := NewUnsubscribeSigner("secret", "http://localhost:4000/unsubscribe/", "http://localhost:3000/unsubscribe")
+
+	token := signer.Token("buyer@example.com", "all")
+	email, scope, err := signer.Verify(token)
+	if err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+	if email != "buyer@example.com" || scope != "all" {
+		t.Errorf("got (%q, %q), want (buyer@example.com, all)", email, scope)
+	}
+
+	headers := signer.Headers(token)
+	if headers["List-Unsubscribe"] != "<http://localhost:4000/unsubscribe/"+token+">" {
+		t.Errorf("List-Unsubscribe = %q", headers["List-Unsubscribe"])
+	}
+	if !strings.HasPrefix(signer.PageURL(token), "http://localhost:3000/unsubscribe?token=") {
+		t.Errorf("PageURL = %q", signer.PageURL(token))
+	}
+}
+
+func TestUnsubscribeTokenRejected(t *testing.T) {
+	signer := NewUnsubscribeSigner("secret", "http://localhost:4000/unsubscribe", "http://localhost:3000/unsubscribe")
+	token := signer.Token("buyer@example.com", "all")
+	payload, signature, _ := strings.Cut(token, ".")
+
+	other := NewUnsubscribeSigner("other-secret", "http://localhost:4000/unsubscribe", "http://localhost:3000/unsubscribe")
+	forged := other.Token("victim@example.com", "all")
+	forgedPayload, _, _ := strings.Cut(forged, ".")
+
+	tests := map[string]string{
+		"empty":             "",
+		"no signature":      payload,
+		"bad signature":     payload + ".not-base64!",
+		"other secret":      forged,
+		"swapped payload":   forgedPayload + "." + signature,
+		"signature only":    "." + signature,
+		"truncated payload": payload[1:] + "." + signature,
+	}
+	for name, token := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, _, err := signer.Verify(token); !errors.Is(err, ErrInvalidUnsubscribeToken) {
+				t.Errorf("err = %v, want ErrInvalidUnsubscribeToken", err)
+			}
+		})
+	}
+}
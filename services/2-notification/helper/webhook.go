@@ -0,0 +1,141 @@
+package helper
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+const (
+	WEBHOOK_SIGNATURE_HEADER = "X-Jobber-Signature"
+	WEBHOOK_EVENT_HEADER     = "X-Jobber-Event"
+	WEBHOOK_DELIVERY_HEADER  = "X-Jobber-Delivery"
+)
+
+type WebhookRequest struct {
+	URL        string
+	Secret     string
+	Event      string
+	DeliveryID string
+	Payload    []byte
+}
+
+// WebhookSender posts a delivery and returns the status code the endpoint
+// responded with, 0 when there was no response at all.
+type WebhookSender interface {
+	Send(req WebhookRequest) (int, error)
+}
+
+var ErrWebhookAddressNotAllowed = errors.New("webhook address is not allowed")
+
+// sharedAddressSpace is 100.64.0.0/10 (RFC 6598), used inside carrier and
+// cloud networks and not covered by net.IP.IsPrivate.
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// WebhookIPAllowed reports whether a delivery may connect to ip. Private,
+// link-local (cloud metadata included) and other internal addresses are never
+// allowed, loopback only with allowLoopback for local setups.
+func WebhookIPAllowed(ip net.IP, allowLoopback bool) bool {
+	if ip == nil {
+		return false
+	}
+	if ip.IsLoopback() {
+		return allowLoopback
+	}
+
+	return !(ip.IsPrivate() ||
+		ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() ||
+		ip.IsMulticast() ||
+		sharedAddressSpace.Contains(ip))
+}
+
+type HttpWebhookSender struct {
+	client *http.Client
+}
+
+// NewHttpWebhookSender checks the address every connection is made to, after
+// DNS resolution, so a hostname that resolves (or later rebinds) to an
+// internal address is refused as well.
+func NewHttpWebhookSender(timeout time.Duration, allowLoopback bool) *HttpWebhookSender {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if !WebhookIPAllowed(net.ParseIP(host), allowLoopback) {
+				return fmt.Errorf("%w: %s", ErrWebhookAddressNotAllowed, host)
+			}
+			return nil
+		},
+	}
+
+	return &HttpWebhookSender{
+		client: &http.Client{
+			Timeout: timeout,
+			// NOTE: NO PROXY, THE DIALER MUST SEE THE ENDPOINT ADDRESS ITSELF
+			Transport: &http.Transport{
+				DialContext:         dialer.DialContext,
+				TLSHandshakeTimeout: timeout,
+				MaxIdleConns:        100,
+				IdleConnTimeout:     90 * time.Second,
+			},
+			// NOTE: A REDIRECT WOULD SEND THE SIGNED PAYLOAD SOMEWHERE THE SELLER DID NOT REGISTER
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+func (s *HttpWebhookSender) Send(wr WebhookRequest) (int, error) {
+	req, err := http.NewRequest(http.MethodPost, wr.URL, bytes.NewReader(wr.Payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Jobber-Webhooks/1.0")
+	req.Header.Set(WEBHOOK_EVENT_HEADER, wr.Event)
+	req.Header.Set(WEBHOOK_DELIVERY_HEADER, wr.DeliveryID)
+	req.Header.Set(WEBHOOK_SIGNATURE_HEADER, SignWebhook(wr.Secret, time.Now(), wr.Payload))
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 64*1024))
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		return res.StatusCode, fmt.Errorf("endpoint responded with status [%d]", res.StatusCode)
+	}
+
+	return res.StatusCode, nil
+}
+
+// SignWebhook builds the signature header "t=<unix>,v1=<hex>", where v1 is
+// HMAC-SHA256 of "<unix>.<payload>" with the endpoint secret. Receivers
+// should recompute it and reject old timestamps to prevent replays.
+func SignWebhook(secret string, at time.Time, payload []byte) string {
+	timestamp := strconv.FormatInt(at.Unix(), 10)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+
+	return fmt.Sprintf("t=%s,v1=%s", timestamp, hex.EncodeToString(mac.Sum(nil)))
+}
@@ -1,9 +1,17 @@
 package main
 
 import (
+	"context"
 	"log"
 	"os"
+	"time"
 
+	"github.com/Akihira77/gojobber/services/2-notification/handler"
+	"github.com/Akihira77/gojobber/services/2-notification/helper"
+	"github.com/Akihira77/gojobber/services/2-notification/service"
+	"github.com/Akihira77/gojobber/services/2-notification/types"
+	"github.com/Akihira77/gojobber/services/2-notification/util"
+	"github.com/Akihira77/gojobber/services/common/genproto/chat"
 	"github.com/joho/godotenv"
 )
 
@@ -17,6 +25,102 @@ func main() {
 	// go q.ConsumeFromAuthService()
 	// q.ConsumeFromChatService()
 
+	db, _ := NewStore()
+	db.Debug().Exec(`CREATE EXTENSION IF NOT EXISTS "uuid-ossp";`)
+	err = db.
+		Debug().
+		AutoMigrate(
+			&types.NotificationOutbox{},
+			&types.InAppNotification{},
+			&types.NotificationPreference{},
+			&types.LocalePreference{},
+			&types.ChatDigestItem{},
+			&types.WebhookEndpoint{},
+			&types.WebhookDelivery{},
+			&types.EmailSuppression{},
+		)
+	if err != nil {
+		log.Fatal("Error migrating notification tables", err)
+	}
+
+	mailer, err := helper.NewMailerFromEnv()
+	if err != nil {
+		log.Fatal("Error configuring mail transport", err)
+	}
+
+	templatesDir := os.Getenv("EMAIL_TEMPLATES_DIR")
+	if templatesDir == "" {
+		templatesDir = "emails"
+	}
+	templates, err := helper.LoadTemplateRegistry(templatesDir)
+	if err != nil {
+		log.Fatal("Error loading email templates", err)
+	}
+
+	var pusher helper.Pusher
+	if gatewayURL := os.Getenv("GATEWAY_URL"); gatewayURL != "" {
+		pusher = helper.NewGatewayPusher(gatewayURL, os.Getenv("GATEWAY_TOKEN"))
+	}
+
+	//NOTE: WITHOUT UNSUBSCRIBE_SECRET NON-TRANSACTIONAL EMAILS ARE SENT WITHOUT AN UNSUBSCRIBE LINK
+	var unsubscriber *helper.UnsubscribeSigner
+	if secret := os.Getenv("UNSUBSCRIBE_SECRET"); secret != "" {
+		apiURL := os.Getenv("UNSUBSCRIBE_URL")
+		if apiURL == "" {
+			apiURL = os.Getenv("GATEWAY_URL") + helper.GATEWAY_UNSUBSCRIBE_PATH
+		}
+		unsubscriber = helper.NewUnsubscribeSigner(secret, apiURL, os.Getenv("CLIENT_URL")+"/unsubscribe")
+	} else {
+		log.Println("UNSUBSCRIBE_SECRET is not set, unsubscribe links are disabled")
+	}
+
+	inAppSvc := service.NewInAppNotificationService(db)
+	prefSvc := service.NewPreferenceService(db)
+	digestSvc := service.NewChatDigestService(db)
+	suppressionSvc := service.NewSuppressionService(db)
+	notificationSvc := service.NewNotificationService(mailer, templates, inAppSvc, prefSvc, digestSvc, suppressionSvc, pusher, unsubscriber)
+	outboxSvc := service.NewOutboxService(db)
+	outboxWorker := service.NewOutboxWorker(outboxSvc, notificationSvc, service.OutboxWorkerConfig{
+		Workers:      util.GetEnvInt("OUTBOX_WORKERS", 4),
+		BatchSize:    util.GetEnvInt("OUTBOX_BATCH_SIZE", 20),
+		MaxAttempts:  util.GetEnvInt("OUTBOX_MAX_ATTEMPTS", 5),
+		PollInterval: util.GetEnvDuration("OUTBOX_POLL_INTERVAL", 2*time.Second),
+		BaseBackoff:  util.GetEnvDuration("OUTBOX_BASE_BACKOFF", 30*time.Second),
+		MaxBackoff:   util.GetEnvDuration("OUTBOX_MAX_BACKOFF", 1*time.Hour),
+		LockDuration: util.GetEnvDuration("OUTBOX_LOCK_DURATION", 5*time.Minute),
+	})
+	go outboxWorker.Run(context.Background())
+
+	//NOTE: WEBHOOK_ALLOW_LOOPBACK IS FOR LOCAL SETUPS ONLY, IT LETS DELIVERIES REACH localhost
+	allowLoopback := util.GetEnvBool("WEBHOOK_ALLOW_LOOPBACK", false)
+	webhookWorker := service.NewWebhookWorker(service.NewWebhookService(db, allowLoopback), helper.NewHttpWebhookSender(util.GetEnvDuration("WEBHOOK_TIMEOUT", 10*time.Second), allowLoopback), service.WebhookWorkerConfig{
+		Workers:      util.GetEnvInt("WEBHOOK_WORKERS", 4),
+		BatchSize:    util.GetEnvInt("WEBHOOK_BATCH_SIZE", 20),
+		MaxAttempts:  util.GetEnvInt("WEBHOOK_MAX_ATTEMPTS", 8),
+		PollInterval: util.GetEnvDuration("WEBHOOK_POLL_INTERVAL", 2*time.Second),
+		BaseBackoff:  util.GetEnvDuration("WEBHOOK_BASE_BACKOFF", 1*time.Minute),
+		MaxBackoff:   util.GetEnvDuration("WEBHOOK_MAX_BACKOFF", 6*time.Hour),
+		LockDuration: util.GetEnvDuration("WEBHOOK_LOCK_DURATION", 2*time.Minute),
+	})
+	go webhookWorker.Run(context.Background())
+
+	ccs := handler.NewGRPCClients()
+	defer ccs.CloseAll()
+	if err = ccs.AddClient(types.CHAT_SERVICE, os.Getenv("CHAT_GRPC_PORT")); err != nil {
+		log.Fatal("Error connecting to chat grpc server", err)
+	}
+	cc, _ := ccs.GetClient(types.CHAT_SERVICE)
+	digestWorker := service.NewChatDigestWorker(digestSvc, chat.NewChatServiceClient(cc), service.ChatDigestWorkerConfig{
+		Window:       util.GetEnvDuration("CHAT_DIGEST_WINDOW", 15*time.Minute),
+		PollInterval: util.GetEnvDuration("CHAT_DIGEST_POLL_INTERVAL", 1*time.Minute),
+	})
+	go digestWorker.Run(context.Background())
+
+	go NewHttpServer(db, templates, unsubscriber)
+
 	grpcServer := NewGRPCServer(os.Getenv("NOTIFICATION_GRPC_PORT"))
-	grpcServer.Run()
+	err = grpcServer.Run(db)
+	if err != nil {
+		log.Fatal("Error listen GRPC")
+	}
 }
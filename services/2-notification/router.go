@@ -0,0 +1,153 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/Akihira77/gojobber/services/2-notification/handler"
+	"github.com/Akihira77/gojobber/services/2-notification/helper"
+	"github.com/Akihira77/gojobber/services/2-notification/service"
+	"github.com/Akihira77/gojobber/services/2-notification/types"
+	"github.com/Akihira77/gojobber/services/2-notification/util"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v5"
+	"gorm.io/gorm"
+)
+
+const (
+	BASE_PATH = "/api/v1/notifications"
+)
+
+func MainRouter(db *gorm.DB, templates *helper.TemplateRegistry, unsubscriber *helper.UnsubscribeSigner, app *fiber.App) {
+	app.Get("/health-check", func(c *fiber.Ctx) error {
+		return c.Status(http.StatusOK).SendString("Notification Service is healthy and OK.")
+	})
+
+	api := app.Group(BASE_PATH)
+	api.Use(verifyGatewayReq)
+
+	//INFO: OPENED FROM AN EMAIL OR CALLED BY THE MAIL PROVIDER, THE TOKEN OR
+	// THE SIGNATURE IS THE AUTHENTICATION
+	ss := service.NewSuppressionService(db)
+	sh := handler.NewSuppressionHttpHandler(ss, unsubscriber, os.Getenv("MAIL_EVENTS_SECRET"))
+	api.Get("/unsubscribe/:token", sh.FindUnsubscribe)
+	api.Post("/unsubscribe/:token", sh.Unsubscribe)
+	api.Post("/email-events", sh.HandleMailEvents)
+
+	api.Use(authOnly)
+
+	obs := service.NewOutboxService(db)
+	ias := service.NewInAppNotificationService(db)
+	ps := service.NewPreferenceService(db)
+	nh := handler.NewNotificationHttpHandler(obs, ias, ps, templates)
+
+	api.Get("/preferences", nh.FindMyPreferences)
+	api.Put("/preferences", nh.UpdateMyPreferences)
+	api.Get("/preferences/locale", nh.FindMyLocale)
+	api.Put("/preferences/locale", nh.UpdateMyLocale)
+
+	api.Get("/suppressions", sh.FindMySuppressions)
+	api.Delete("/suppressions/:scope", sh.Resubscribe)
+
+	ws := service.NewWebhookService(db, util.GetEnvBool("WEBHOOK_ALLOW_LOOPBACK", false))
+	wh := handler.NewWebhookHttpHandler(ws)
+
+	api.Get("/webhooks", wh.FindMyWebhooks)
+	api.Get("/webhooks/events", wh.FindWebhookEvents)
+	api.Post("/webhooks", wh.CreateWebhook)
+	api.Post("/webhooks/deliveries/:deliveryId/redeliver", wh.RedeliverWebhook)
+	api.Put("/webhooks/:id", wh.UpdateWebhook)
+	api.Delete("/webhooks/:id", wh.DeleteWebhook)
+	api.Post("/webhooks/:id/rotate-secret", wh.RotateWebhookSecret)
+	api.Get("/webhooks/:id/deliveries/:page/:size", wh.FindWebhookDeliveries)
+
+	api.Get("/me/unread-count", nh.CountMyUnreadNotifications)
+	api.Get("/me/:filter/:page/:size", nh.FindMyNotifications)
+	api.Patch("/me/read-all", nh.MarkAllMyNotificationsAsRead)
+	api.Patch("/me/:id/read", nh.MarkMyNotificationAsRead)
+
+	admin := api.Group("/admin")
+	admin.Use(adminOnly)
+	admin.Get("/outbox/id/:id", nh.FindOutboxByID)
+	admin.Get("/outbox/:status/:page/:size", nh.FindOutboxes)
+	admin.Post("/outbox/replay-dead", nh.ReplayAllDeadOutboxes)
+	admin.Post("/outbox/:id/replay", nh.ReplayOutbox)
+	admin.Get("/suppressions/:page/:size", sh.FindSuppressions)
+	admin.Delete("/suppressions/:email/:scope", sh.RemoveSuppression)
+	admin.Get("/templates", nh.FindTemplates)
+	admin.Get("/templates/:name/preview/:format?", nh.PreviewTemplate)
+	admin.Get("/templates/:name/locales/:locale/preview/:format?", nh.PreviewTemplate)
+}
+
+func verifyGatewayReq(c *fiber.Ctx) error {
+	gatewayToken := c.Get("gatewayToken", "")
+
+	if gatewayToken == "" {
+		return fiber.NewError(http.StatusForbidden, "request is not from Gateway")
+	}
+
+	GATEWAY_TOKEN := os.Getenv("GATEWAY_TOKEN")
+
+	token, err := jwt.Parse(gatewayToken, func(t *jwt.Token) (interface{}, error) {
+		if method, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("Signing method invalid")
+		} else if method != jwt.SigningMethodHS256 {
+			return nil, fmt.Errorf("Signing method invalid")
+		}
+
+		return []byte(GATEWAY_TOKEN), nil
+	})
+
+	if err != nil {
+		fmt.Printf("verifyGatewayReq error:\n%+v", err)
+		return fiber.NewError(http.StatusForbidden, "invalid gateway token")
+	}
+
+	c.Set("gatewayToken", token.Raw)
+	return c.Next()
+}
+
+func authOnly(c *fiber.Ctx) error {
+	tokenStr := c.Cookies("token")
+	if tokenStr == "" {
+		authHeader := c.Get("Authorization")
+		if authHeader == "" || len(strings.Split(authHeader, " ")) <= 1 {
+			return fiber.NewError(http.StatusUnauthorized, "sign in first")
+		}
+		tokenStr = strings.Split(authHeader, " ")[1]
+	}
+	token, err := util.VerifyingJWT(os.Getenv("JWT_SECRET"), tokenStr)
+	if err != nil {
+		fmt.Printf("authOnly error:\n%+v", err)
+		return fiber.NewError(http.StatusUnauthorized, "sign in first")
+	}
+
+	claims, ok := token.Claims.(*types.JWTClaims)
+	if !ok {
+		log.Println("token is not matched with claims: claims", token.Claims)
+		return fiber.NewError(http.StatusUnauthorized, "sign in first")
+	}
+
+	c.SetUserContext(context.WithValue(c.UserContext(), "current_user", claims))
+	return c.Next()
+}
+
+// NOTE: ADMINS ARE CONFIGURED THROUGH A COMMA SEPARATED ADMIN_EMAILS ENV
+func adminOnly(c *fiber.Ctx) error {
+	claims, ok := c.UserContext().Value("current_user").(*types.JWTClaims)
+	if !ok {
+		return fiber.NewError(http.StatusUnauthorized, "sign in first")
+	}
+
+	admins := strings.Split(os.Getenv("ADMIN_EMAILS"), ",")
+	if !slices.Contains(admins, claims.Email) {
+		return fiber.NewError(http.StatusForbidden, "admin only")
+	}
+
+	return c.Next()
+}
@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/Akihira77/gojobber/services/2-notification/types"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ChatDigestService struct {
+	db *gorm.DB
+}
+
+type ChatDigestServiceImpl interface {
+	Add(ctx context.Context, item *types.ChatDigestItem) error
+	FindDueReceivers(ctx context.Context, window time.Duration) ([]string, error)
+	FindPending(ctx context.Context, receiverEmail string) ([]types.ChatDigestItem, error)
+	Flush(ctx context.Context, receiverEmail string, ids []uuid.UUID, digest proto.Message) error
+}
+
+func NewChatDigestService(db *gorm.DB) ChatDigestServiceImpl {
+	return &ChatDigestService{
+		db: db,
+	}
+}
+
+// Add buffers a chat email once per outbox row, retries are ignored.
+func (cds *ChatDigestService) Add(ctx context.Context, item *types.ChatDigestItem) error {
+	if item.CreatedAt.IsZero() {
+		item.CreatedAt = time.Now()
+	}
+
+	result := cds.db.
+		WithContext(ctx).
+		Model(&types.ChatDigestItem{}).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "outbox_id"}},
+			DoNothing: true,
+		}).
+		Create(item)
+
+	return result.Error
+}
+
+// FindDueReceivers returns the receivers whose oldest pending message has
+// waited for at least one digest window.
+func (cds *ChatDigestService) FindDueReceivers(ctx context.Context, window time.Duration) ([]string, error) {
+	var receivers []string
+	result := cds.db.
+		WithContext(ctx).
+		Model(&types.ChatDigestItem{}).
+		Where("digested_at IS NULL").
+		Group("receiver_email").
+		Having("MIN(created_at) <= ?", time.Now().Add(-window)).
+		Pluck("receiver_email", &receivers)
+
+	return receivers, result.Error
+}
+
+func (cds *ChatDigestService) FindPending(ctx context.Context, receiverEmail string) ([]types.ChatDigestItem, error) {
+	var items []types.ChatDigestItem
+	result := cds.db.
+		WithContext(ctx).
+		Model(&types.ChatDigestItem{}).
+		Where("receiver_email = ? AND digested_at IS NULL", receiverEmail).
+		Order("created_at ASC").
+		Find(&items)
+
+	return items, result.Error
+}
+
+// Flush marks the items as digested and, when there is something left to
+// tell, enqueues the digest email in the same transaction.
+func (cds *ChatDigestService) Flush(ctx context.Context, receiverEmail string, ids []uuid.UUID, digest proto.Message) error {
+	tx := cds.db.
+		WithContext(ctx).
+		Begin()
+
+	result := tx.
+		Model(&types.ChatDigestItem{}).
+		Where("id IN ?", ids).
+		Update("digested_at", time.Now())
+	if result.Error != nil {
+		tx.Rollback()
+		return result.Error
+	}
+
+	if digest != nil {
+		outbox, err := newOutbox(types.EVENT_CHAT_DIGEST, receiverEmail, digest)
+		if err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		result = tx.
+			Model(&types.NotificationOutbox{}).
+			Create(outbox)
+		if result.Error != nil {
+			tx.Rollback()
+			return result.Error
+		}
+	}
+
+	return tx.Commit().Error
+}
@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/Akihira77/gojobber/services/common/genproto/chat"
+	"github.com/Akihira77/gojobber/services/common/genproto/notification"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type ChatDigestWorkerConfig struct {
+	Window       time.Duration
+	PollInterval time.Duration
+}
+
+type ChatDigestWorker struct {
+	digestSvc  ChatDigestServiceImpl
+	chatClient chat.ChatServiceClient
+	cfg        ChatDigestWorkerConfig
+}
+
+func NewChatDigestWorker(digestSvc ChatDigestServiceImpl, chatClient chat.ChatServiceClient, cfg ChatDigestWorkerConfig) *ChatDigestWorker {
+	return &ChatDigestWorker{
+		digestSvc:  digestSvc,
+		chatClient: chatClient,
+		cfg:        cfg,
+	}
+}
+
+// Run flushes every receiver whose digest window has passed until ctx is
+// canceled.
+func (w *ChatDigestWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.cfg.PollInterval)
+	defer ticker.Stop()
+
+	log.Printf("chat digest worker started with [%v] window", w.cfg.Window)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			receivers, err := w.digestSvc.FindDueReceivers(ctx, w.cfg.Window)
+			if err != nil {
+				log.Printf("chat digest worker finding receivers error:\n%+v", err)
+				continue
+			}
+
+			for _, receiver := range receivers {
+				if err := w.flush(ctx, receiver); err != nil {
+					log.Printf("chat digest for [%s] error:\n%+v", receiver, err)
+				}
+			}
+		}
+	}
+}
+
+// flush leaves out the messages the receiver has already read in the app.
+// When the chat service can not be reached the digest waits for the next tick.
+func (w *ChatDigestWorker) flush(ctx context.Context, receiver string) error {
+	items, err := w.digestSvc.FindPending(ctx, receiver)
+	if err != nil || len(items) == 0 {
+		return err
+	}
+
+	messageIDs := make([]string, 0, len(items))
+	for _, item := range items {
+		if item.MessageID != "" {
+			messageIDs = append(messageIDs, item.MessageID)
+		}
+	}
+
+	unread := make(map[string]bool, len(messageIDs))
+	if len(messageIDs) > 0 {
+		res, err := w.chatClient.FindUnreadMessages(ctx, &chat.FindUnreadMessagesRequest{
+			MessageIds: messageIDs,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, id := range res.UnreadMessageIds {
+			unread[id] = true
+		}
+	}
+
+	ids := make([]uuid.UUID, 0, len(items))
+	digest := &notification.ChatDigest{
+		ReceiverEmail: receiver,
+	}
+	for _, item := range items {
+		ids = append(ids, item.ID)
+		if item.MessageID != "" && !unread[item.MessageID] {
+			continue
+		}
+
+		//NOTE: THE DIGEST IS WRITTEN IN THE LOCALE OF THE LATEST MESSAGE
+		if item.Locale != "" {
+			digest.Locale = item.Locale
+		}
+
+		digest.Messages = append(digest.Messages, &notification.ChatDigestMessage{
+			SenderEmail:    item.SenderEmail,
+			Message:        item.Message,
+			MessageId:      item.MessageID,
+			ConversationId: item.ConversationID,
+			CreatedAt:      timestamppb.New(item.CreatedAt),
+		})
+	}
+
+	var payload proto.Message
+	if len(digest.Messages) > 0 {
+		payload = digest
+	}
+
+	return w.digestSvc.Flush(ctx, receiver, ids, payload)
+}
@@ -0,0 +1,259 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Akihira77/gojobber/services/2-notification/helper"
+	"github.com/Akihira77/gojobber/services/2-notification/types"
+	"github.com/Akihira77/gojobber/services/common/genproto/notification"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// publishedEvents maps the event types of Publish to the outbox event types
+// preferences are saved with.
+var publishedEvents = map[notification.NotificationEventType]types.OutboxEventType{
+	notification.NotificationEventType_USER_VERIFYING_EMAIL:              types.EVENT_USER_VERIFYING_EMAIL,
+	notification.NotificationEventType_USER_FORGOT_PASSWORD:              types.EVENT_USER_FORGOT_PASSWORD,
+	notification.NotificationEventType_USER_SUCCESS_RESET_PASSWORD:       types.EVENT_USER_SUCCESS_RESET_PASSWORD,
+	notification.NotificationEventType_CHAT_MESSAGE:                      types.EVENT_CHAT_NOTIFICATION,
+	notification.NotificationEventType_SELLER_COMPLETED_ORDER:            types.EVENT_SELLER_COMPLETED_ORDER,
+	notification.NotificationEventType_SELLER_REQUEST_DEADLINE_EXTENSION: types.EVENT_SELLER_REQUEST_DEADLINE_EXTENSION,
+	notification.NotificationEventType_SELLER_CANCELED_ORDER:             types.EVENT_SELLER_CANCELED_ORDER,
+	notification.NotificationEventType_BUYER_DEADLINE_EXTENSION_RESPONSE: types.EVENT_BUYER_DEADLINE_EXTENSION_RESPONSE,
+	notification.NotificationEventType_BUYER_REFUNDS_ORDER:               types.EVENT_BUYER_REFUNDS_ORDER,
+	notification.NotificationEventType_SELLER_GOT_AN_ORDER:               types.EVENT_SELLER_GOT_AN_ORDER,
+	notification.NotificationEventType_SELLER_GOT_A_REVIEW:               types.EVENT_SELLER_GOT_A_REVIEW,
+	notification.NotificationEventType_BUYER_ORDER_DELIVERED:             types.EVENT_BUYER_ORDER_DELIVERED,
+	notification.NotificationEventType_BUYER_ORDER_ACKNOWLEDGED:          types.EVENT_BUYER_ORDER_ACKNOWLEDGED,
+	notification.NotificationEventType_SELLER_BUYER_RESPONDED_DELIVERY:   types.EVENT_SELLER_BUYER_RESPONDED_DELIVERY,
+	notification.NotificationEventType_SELLER_PAYOUT_PAID:                types.EVENT_SELLER_PAYOUT_PAID,
+	notification.NotificationEventType_SELLER_PAYOUT_FAILED:              types.EVENT_SELLER_PAYOUT_FAILED,
+	notification.NotificationEventType_USER_DATA_EXPORT_READY:            types.EVENT_USER_DATA_EXPORT_READY,
+}
+
+var eventTemplates = map[types.OutboxEventType]string{
+	types.EVENT_USER_VERIFYING_EMAIL:              helper.TEMPLATE_VERIFY_EMAIL,
+	types.EVENT_USER_FORGOT_PASSWORD:              helper.TEMPLATE_RESET_PASSWORD,
+	types.EVENT_USER_SUCCESS_RESET_PASSWORD:       helper.TEMPLATE_RESET_PASSWORD_SUCCESS,
+	types.EVENT_CHAT_NOTIFICATION:                 helper.TEMPLATE_CHAT_NOTIFICATION,
+	types.EVENT_SELLER_COMPLETED_ORDER:            helper.TEMPLATE_SELLER_ORDER_COMPLETED,
+	types.EVENT_SELLER_REQUEST_DEADLINE_EXTENSION: helper.TEMPLATE_SELLER_DEADLINE_EXTENSION,
+	types.EVENT_SELLER_CANCELED_ORDER:             helper.TEMPLATE_SELLER_CANCELED_ORDER,
+	types.EVENT_BUYER_DEADLINE_EXTENSION_RESPONSE: helper.TEMPLATE_BUYER_DEADLINE_EXTENSION_RESPONSE,
+	types.EVENT_BUYER_REFUNDS_ORDER:               helper.TEMPLATE_BUYER_REFUNDED_ORDER,
+	types.EVENT_SELLER_GOT_AN_ORDER:               helper.TEMPLATE_SELLER_GOT_AN_ORDER,
+	types.EVENT_SELLER_GOT_A_REVIEW:               helper.TEMPLATE_SELLER_GOT_A_REVIEW,
+	types.EVENT_BUYER_ORDER_DELIVERED:             helper.TEMPLATE_BUYER_ORDER_DELIVERED,
+	types.EVENT_BUYER_ORDER_ACKNOWLEDGED:          helper.TEMPLATE_BUYER_ORDER_ACKNOWLEDGED,
+	types.EVENT_SELLER_BUYER_RESPONDED_DELIVERY:   helper.TEMPLATE_SELLER_BUYER_RESPONDED_DELIVERY,
+	types.EVENT_SELLER_PAYOUT_PAID:                helper.TEMPLATE_SELLER_PAYOUT_PAID,
+	types.EVENT_SELLER_PAYOUT_FAILED:              helper.TEMPLATE_SELLER_PAYOUT_FAILED,
+	types.EVENT_USER_DATA_EXPORT_READY:            helper.TEMPLATE_USER_DATA_EXPORT_READY,
+}
+
+// buildTemplateData decodes the outbox payload and maps it to the email
+// template and the data it is rendered with.
+func (ns *NotificationService) buildTemplateData(o types.NotificationOutbox) (string, map[string]interface{}, error) {
+	if o.PayloadVersion != types.OUTBOX_PAYLOAD_V2 {
+		return ns.requestTemplateData(o)
+	}
+
+	event, err := decodeEvent(o)
+	if err != nil {
+		return "", nil, err
+	}
+
+	name, ok := eventTemplates[o.EventType]
+	if !ok {
+		return "", nil, fmt.Errorf("unknown notification event type [%s]", o.EventType)
+	}
+
+	data := map[string]interface{}{}
+	if event.Payload != nil {
+		data = event.Payload.AsMap()
+	}
+
+	return ns.templateOrDefault(event.TemplateName, name), data, nil
+}
+
+func decodeEvent(o types.NotificationOutbox) (*notification.NotificationEvent, error) {
+	var event notification.NotificationEvent
+	if err := protojson.Unmarshal([]byte(o.Payload), &event); err != nil {
+		return nil, err
+	}
+
+	return &event, nil
+}
+
+// requestTemplateData decodes a V1 payload, the request message of the
+// per-case RPC that enqueued it.
+func (ns *NotificationService) requestTemplateData(o types.NotificationOutbox) (string, map[string]interface{}, error) {
+	payload := []byte(o.Payload)
+
+	switch o.EventType {
+	case types.EVENT_USER_VERIFYING_EMAIL:
+		var req notification.VerifyingEmailRequest
+		if err := protojson.Unmarshal(payload, &req); err != nil {
+			return "", nil, err
+		}
+		return ns.templateOrDefault(req.HtmlTemplateName, helper.TEMPLATE_VERIFY_EMAIL), map[string]interface{}{
+			"VerifyLink": req.VerifyLink,
+		}, nil
+	case types.EVENT_USER_FORGOT_PASSWORD:
+		var req notification.ForgotPasswordRequest
+		if err := protojson.Unmarshal(payload, &req); err != nil {
+			return "", nil, err
+		}
+		return ns.templateOrDefault(req.HtmlTemplateName, helper.TEMPLATE_RESET_PASSWORD), map[string]interface{}{
+			"Username":  req.Username,
+			"ResetLink": req.ResetLink,
+		}, nil
+	case types.EVENT_USER_SUCCESS_RESET_PASSWORD:
+		var req notification.SuccessResetPasswordRequest
+		if err := protojson.Unmarshal(payload, &req); err != nil {
+			return "", nil, err
+		}
+		return ns.templateOrDefault(req.HtmlTemplateName, helper.TEMPLATE_RESET_PASSWORD_SUCCESS), map[string]interface{}{
+			"Username": req.Username,
+		}, nil
+	case types.EVENT_CHAT_NOTIFICATION:
+		var req notification.EmailChatNotificationRequest
+		if err := protojson.Unmarshal(payload, &req); err != nil {
+			return "", nil, err
+		}
+		return helper.TEMPLATE_CHAT_NOTIFICATION, map[string]interface{}{
+			"SenderEmail":    req.SenderEmail,
+			"Message":        req.Message,
+			"MessageID":      req.MessageId,
+			"ConversationID": req.ConversationId,
+		}, nil
+	case types.EVENT_SELLER_COMPLETED_ORDER:
+		var req notification.SellerCompletedAnOrderRequest
+		if err := protojson.Unmarshal(payload, &req); err != nil {
+			return "", nil, err
+		}
+		return helper.TEMPLATE_SELLER_ORDER_COMPLETED, map[string]interface{}{
+			"BuyerEmail":           req.BuyerEmail,
+			"OrderID":              req.OrderId,
+			"SellerCurrentBalance": req.SellerCurrentBalance,
+			"OrderURL":             req.Url,
+		}, nil
+	case types.EVENT_SELLER_REQUEST_DEADLINE_EXTENSION:
+		var req notification.SellerDeadlineExtensionRequest
+		if err := protojson.Unmarshal(payload, &req); err != nil {
+			return "", nil, err
+		}
+		return helper.TEMPLATE_SELLER_DEADLINE_EXTENSION, map[string]interface{}{
+			"OrderURL": req.Url,
+		}, nil
+	case types.EVENT_SELLER_CANCELED_ORDER:
+		var req notification.SellerCancelOrderRequest
+		if err := protojson.Unmarshal(payload, &req); err != nil {
+			return "", nil, err
+		}
+		return helper.TEMPLATE_SELLER_CANCELED_ORDER, map[string]interface{}{
+			"OrderURL": req.Url,
+		}, nil
+	case types.EVENT_BUYER_DEADLINE_EXTENSION_RESPONSE:
+		var req notification.BuyerDeadlineExtension
+		if err := protojson.Unmarshal(payload, &req); err != nil {
+			return "", nil, err
+		}
+		return helper.TEMPLATE_BUYER_DEADLINE_EXTENSION_RESPONSE, map[string]interface{}{
+			"OrderURL": req.Url,
+		}, nil
+	case types.EVENT_BUYER_REFUNDS_ORDER:
+		var req notification.BuyerRefundsOrderRequest
+		if err := protojson.Unmarshal(payload, &req); err != nil {
+			return "", nil, err
+		}
+		return helper.TEMPLATE_BUYER_REFUNDED_ORDER, map[string]interface{}{
+			"OrderURL": req.Url,
+		}, nil
+	case types.EVENT_SELLER_GOT_AN_ORDER:
+		var req notification.NotifySellerGotAnOrderRequest
+		if err := protojson.Unmarshal(payload, &req); err != nil {
+			return "", nil, err
+		}
+		if req.Detail == nil {
+			return "", nil, fmt.Errorf("order detail is missing")
+		}
+		return helper.TEMPLATE_SELLER_GOT_AN_ORDER, map[string]interface{}{
+			"Message":        req.Message,
+			"GigTitle":       req.Detail.GigTitle,
+			"GigDescription": req.Detail.GigDescription,
+			"Price":          req.Detail.Price,
+			"ServiceFee":     req.Detail.ServiceFee,
+			"Deadline":       req.Detail.Deadline.AsTime().UTC().Format(time.RFC1123),
+		}, nil
+	case types.EVENT_SELLER_GOT_A_REVIEW:
+		var req notification.NotifySellerGotAReviewRequest
+		if err := protojson.Unmarshal(payload, &req); err != nil {
+			return "", nil, err
+		}
+		return helper.TEMPLATE_SELLER_GOT_A_REVIEW, map[string]interface{}{
+			"Message": req.Message,
+		}, nil
+	case types.EVENT_BUYER_ORDER_DELIVERED:
+		var req notification.NotifyBuyerOrderDeliveredRequest
+		if err := protojson.Unmarshal(payload, &req); err != nil {
+			return "", nil, err
+		}
+		return helper.TEMPLATE_BUYER_ORDER_DELIVERED, map[string]interface{}{
+			"OrderURL": req.Url,
+		}, nil
+	case types.EVENT_BUYER_ORDER_ACKNOWLEDGED:
+		var req notification.NotifyBuyerOrderAcknowledgeRequest
+		if err := protojson.Unmarshal(payload, &req); err != nil {
+			return "", nil, err
+		}
+		return helper.TEMPLATE_BUYER_ORDER_ACKNOWLEDGED, map[string]interface{}{
+			"OrderURL": req.Url,
+		}, nil
+	case types.EVENT_CHAT_DIGEST:
+		var req notification.ChatDigest
+		if err := protojson.Unmarshal(payload, &req); err != nil {
+			return "", nil, err
+		}
+		return helper.TEMPLATE_CHAT_DIGEST, chatDigestData(&req), nil
+	default:
+		return "", nil, fmt.Errorf("unknown notification event type [%s]", o.EventType)
+	}
+}
+
+// NOTE: PRODUCERS MAY SEND THE TEMPLATE NAME THEMSELVES,
+// UNKNOWN NAMES FALL BACK TO THE DEFAULT ONE
+func (ns *NotificationService) templateOrDefault(name, def string) string {
+	if name != "" && ns.templates.Has(name) {
+		return name
+	}
+
+	return def
+}
+
+// chatDigestData groups the digest messages by sender, keeping the order
+// the senders first wrote in.
+func chatDigestData(digest *notification.ChatDigest) map[string]interface{} {
+	senders := []map[string]interface{}{}
+	index := make(map[string]int)
+	for _, m := range digest.Messages {
+		i, ok := index[m.SenderEmail]
+		if !ok {
+			i = len(senders)
+			index[m.SenderEmail] = i
+			senders = append(senders, map[string]interface{}{
+				"SenderEmail": m.SenderEmail,
+				"Messages":    []string{},
+			})
+		}
+
+		senders[i]["Messages"] = append(senders[i]["Messages"].([]string), m.Message)
+	}
+
+	return map[string]interface{}{
+		"MessageCount": len(digest.Messages),
+		"SenderCount":  len(senders),
+		"Senders":      senders,
+	}
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/Akihira77/gojobber/services/2-notification/types"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type InAppNotificationService struct {
+	db *gorm.DB
+}
+
+type InAppNotificationServiceImpl interface {
+	Save(ctx context.Context, n *types.InAppNotification) (bool, error)
+	FindAll(ctx context.Context, receiverEmail string, unreadOnly bool, page, size int) ([]types.InAppNotification, int64, error)
+	CountUnread(ctx context.Context, receiverEmail string) (int64, error)
+	MarkRead(ctx context.Context, receiverEmail, id string) (*types.InAppNotification, error)
+	MarkAllRead(ctx context.Context, receiverEmail string) (int64, error)
+}
+
+func NewInAppNotificationService(db *gorm.DB) InAppNotificationServiceImpl {
+	return &InAppNotificationService{
+		db: db,
+	}
+}
+
+// Save stores the notification once per outbox row, so a retried delivery
+// does not show up twice. It reports whether a new row was created.
+func (is *InAppNotificationService) Save(ctx context.Context, n *types.InAppNotification) (bool, error) {
+	if n.CreatedAt.IsZero() {
+		n.CreatedAt = time.Now()
+	}
+
+	result := is.db.
+		WithContext(ctx).
+		Model(&types.InAppNotification{}).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "outbox_id"}},
+			DoNothing: true,
+		}).
+		Create(n)
+
+	return result.RowsAffected > 0, result.Error
+}
+
+func (is *InAppNotificationService) FindAll(ctx context.Context, receiverEmail string, unreadOnly bool, page, size int) ([]types.InAppNotification, int64, error) {
+	dbExec := is.db.
+		WithContext(ctx).
+		Model(&types.InAppNotification{}).
+		Where("receiver_email = ?", receiverEmail)
+	if unreadOnly {
+		dbExec = dbExec.Where("read = ?", false)
+	}
+
+	var total int64
+	result := dbExec.Count(&total)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+
+	var notifications []types.InAppNotification
+	result = dbExec.
+		Order("created_at DESC").
+		Offset((page - 1) * size).
+		Limit(size).
+		Find(&notifications)
+
+	return notifications, total, result.Error
+}
+
+func (is *InAppNotificationService) CountUnread(ctx context.Context, receiverEmail string) (int64, error) {
+	var total int64
+	result := is.db.
+		WithContext(ctx).
+		Model(&types.InAppNotification{}).
+		Where("receiver_email = ? AND read = ?", receiverEmail, false).
+		Count(&total)
+
+	return total, result.Error
+}
+
+func (is *InAppNotificationService) MarkRead(ctx context.Context, receiverEmail, id string) (*types.InAppNotification, error) {
+	var n types.InAppNotification
+	result := is.db.
+		WithContext(ctx).
+		Raw(`
+			UPDATE in_app_notifications
+			SET read = true, read_at = COALESCE(read_at, ?)
+			WHERE id = ? AND receiver_email = ?
+			RETURNING *
+		`, time.Now(), id, receiverEmail).
+		Scan(&n)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return &n, nil
+}
+
+func (is *InAppNotificationService) MarkAllRead(ctx context.Context, receiverEmail string) (int64, error) {
+	result := is.db.
+		WithContext(ctx).
+		Model(&types.InAppNotification{}).
+		Where("receiver_email = ? AND read = ?", receiverEmail, false).
+		Updates(map[string]interface{}{
+			"read":    true,
+			"read_at": time.Now(),
+		})
+
+	return result.RowsAffected, result.Error
+}
@@ -1,269 +1,219 @@
 package service
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
-	"sync"
+	"log"
 
 	"github.com/Akihira77/gojobber/services/2-notification/helper"
-	"github.com/Akihira77/gojobber/services/common/genproto/notification"
+	"github.com/Akihira77/gojobber/services/2-notification/types"
 )
 
 type NotificationService struct {
-	errCh chan error
+	mailer         helper.Mailer
+	templates      *helper.TemplateRegistry
+	inAppSvc       InAppNotificationServiceImpl
+	prefSvc        PreferenceServiceImpl
+	digestSvc      ChatDigestServiceImpl
+	suppressionSvc SuppressionServiceImpl
+	pusher         helper.Pusher
+	unsubscriber   *helper.UnsubscribeSigner
 }
 
 type NotificationServiceImpl interface {
-	UserVerifyingEmail(receiverEmail, htmlTemplateName, verifyLink string) error
-	UserForgotPassword(receiverEmail, htmlTemplateName, resetLink, username string) error
-	UserSucessResetPassword(receiverEmail, htmlTemplateName, username string) error
-	SendEmailChatNotification(receiverEmail, senderEmail, message string) error
-	SellerHasCompletedAnOrder(data *notification.SellerCompletedAnOrderRequest) error
-	SellerRequestDeadlineExtension(data *notification.SellerDeadlineExtensionRequest) error
-	BuyerDeadlineExtensionResponse(data *notification.BuyerDeadlineExtension) error
-	BuyerRefundsAnOrder(data *notification.BuyerRefundsOrderRequest) error
-	SellerCanceledAnOrder(data *notification.SellerCancelOrderRequest) error
-	NotifySellerGotAnOrder(data *notification.NotifySellerGotAnOrderRequest) error
-	NotifySellerGotAReview(data *notification.NotifySellerGotAReviewRequest) error
-	NotifyBuyerSellerDeliveredOrder(data *notification.NotifyBuyerOrderDeliveredRequest) error
-	NotifyBuyerSellerProcessedOrder(data *notification.NotifyBuyerOrderAcknowledgeRequest) error
+	Deliver(ctx context.Context, o types.NotificationOutbox) error
 }
 
-func NewNotificationService() NotificationServiceImpl {
+// NewNotificationService accepts a nil pusher or unsubscriber, realtime pushes
+// or unsubscribe links are then left out.
+func NewNotificationService(mailer helper.Mailer, templates *helper.TemplateRegistry, inAppSvc InAppNotificationServiceImpl, prefSvc PreferenceServiceImpl, digestSvc ChatDigestServiceImpl, suppressionSvc SuppressionServiceImpl, pusher helper.Pusher, unsubscriber *helper.UnsubscribeSigner) NotificationServiceImpl {
 	return &NotificationService{
-		errCh: make(chan error, 1),
+		mailer:         mailer,
+		templates:      templates,
+		inAppSvc:       inAppSvc,
+		prefSvc:        prefSvc,
+		digestSvc:      digestSvc,
+		suppressionSvc: suppressionSvc,
+		pusher:         pusher,
+		unsubscriber:   unsubscriber,
 	}
 }
 
-// TODO: IMPLEMENT HTML TEMPLATE
-func (ns *NotificationService) NotifyBuyerSellerProcessedOrder(data *notification.NotifyBuyerOrderAcknowledgeRequest) error {
-	errCh := make(chan error, 1)
-	var wg sync.WaitGroup
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		errCh <- helper.
-			SendMail(
-				data.ReceiverEmail,
-				fmt.Sprint("Seller Has Acknowledge Your Order And Start Working On It"),
-				fmt.Sprintf("Check your order %s", data.Url),
-			)
-	}()
-
-	wg.Wait()
-	close(errCh)
-	return <-errCh
-}
+// Deliver renders the outbox event once and fans it out to the channels the
+// receiver has chosen for it. It is safe to retry, the in-app copy is only
+// stored once per outbox row.
+func (ns *NotificationService) Deliver(ctx context.Context, o types.NotificationOutbox) error {
+	//NOTE: A DIGEST FOLLOWS THE CHAT PREFERENCE AND IS EMAIL ONLY,
+	// EVERY MESSAGE IN IT ALREADY HAS ITS OWN IN-APP NOTIFICATION
+	preferenceEvent := o.EventType
+	if o.EventType == types.EVENT_CHAT_DIGEST {
+		preferenceEvent = types.EVENT_CHAT_NOTIFICATION
+	}
 
-// TODO: IMPLEMENT HTML TEMPLATE
-// AND REFACTORE request payload to ADD THE URL TO OUR PLATFORM
-func (ns *NotificationService) NotifyBuyerSellerDeliveredOrder(data *notification.NotifyBuyerOrderDeliveredRequest) error {
-	errCh := make(chan error, 1)
-	var wg sync.WaitGroup
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		errCh <- helper.SendMail(
-			data.ReceiverEmail,
-			fmt.Sprintf("Seller Has Sent Your Order Progress. Check Out Your Order!"),
-			fmt.Sprintf("Check your order %s", data.Url),
-		)
-	}()
-
-	wg.Wait()
-	close(errCh)
-	return <-errCh
-}
+	channel, err := ns.prefSvc.FindChannel(ctx, o.Receiver, preferenceEvent)
+	if err != nil {
+		return err
+	}
 
-// TODO: IMPLEMENT HTML TEMPLATE
-func (ns *NotificationService) NotifySellerGotAReview(data *notification.NotifySellerGotAReviewRequest) error {
-	errCh := make(chan error, 1)
-	var wg sync.WaitGroup
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		errCh <- helper.SendMail(data.ReceiverEmail, fmt.Sprintf("User Giving You Review"), data.Message)
-	}()
-
-	wg.Wait()
-	close(errCh)
-	return <-errCh
-}
+	if channel == types.CHANNEL_OFF {
+		log.Printf("outbox [%s] %s is skipped, [%s] turned it off", o.ID, o.EventType, o.Receiver)
+		return nil
+	}
+
+	sendEmail := channel.Email()
+	emailEvent := types.EmailEvent(o.EventType)
+	if sendEmail {
+		suppressed, err := ns.suppressionSvc.IsSuppressed(ctx, o.Receiver, emailEvent)
+		if err != nil {
+			return err
+		}
+		if suppressed {
+			log.Printf("outbox [%s] %s email is skipped, [%s] is suppressed", o.ID, o.EventType, o.Receiver)
+			sendEmail = false
+		}
+	}
 
-// TODO: IMPLEMENT HTML TEMPLATE
-func (ns *NotificationService) NotifySellerGotAnOrder(data *notification.NotifySellerGotAnOrderRequest) error {
-	b, err := json.Marshal(data.Detail)
+	templateName, data, err := ns.buildTemplateData(o)
 	if err != nil {
-		return fmt.Errorf("Error parsing payload data %v", err)
+		return err
 	}
 
-	errCh := make(chan error, 1)
-	var wg sync.WaitGroup
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		errCh <- helper.SendMail(data.ReceiverEmail, data.Message, string(b))
-	}()
-
-	wg.Wait()
-	close(errCh)
-	return <-errCh
-}
+	//NOTE: A BUFFERED CHAT MESSAGE GETS ITS LINK WHEN THE DIGEST IS RENDERED
+	var unsubscribeToken string
+	if sendEmail && ns.unsubscriber != nil && o.EventType == emailEvent && types.UnsubscribableEvents[emailEvent] {
+		unsubscribeToken = ns.unsubscriber.Token(o.Receiver, string(emailEvent))
+		if data == nil {
+			data = make(map[string]interface{})
+		}
+		data["UnsubscribeURL"] = ns.unsubscriber.PageURL(unsubscribeToken)
+	}
 
-func (ns *NotificationService) SellerCanceledAnOrder(data *notification.SellerCancelOrderRequest) error {
-	errCh := make(chan error, 1)
-	var wg sync.WaitGroup
-	wg.Add(1)
-
-	go func() {
-		defer wg.Done()
-		errCh <- helper.SendMail(
-			data.ReceiverEmail,
-			fmt.Sprintf("Seller Has Canceled Your Order"),
-			fmt.Sprintf("Check your order %s", data.Url),
-		)
-	}()
-
-	wg.Wait()
-	close(errCh)
-	return <-errCh
-}
+	l, err := ns.findLocale(ctx, o)
+	if err != nil {
+		return err
+	}
 
-// TODO: REFACTORE
-func (ns *NotificationService) SellerRequestDeadlineExtension(data *notification.SellerDeadlineExtensionRequest) error {
-	errCh := make(chan error, 1)
-	var wg sync.WaitGroup
-	wg.Add(1)
-
-	go func() {
-		defer wg.Done()
-		errCh <- helper.SendMail(
-			data.ReceiverEmail,
-			fmt.Sprintf("Seller Requested A Deadline Extension"),
-			fmt.Sprintf("Check your order %s", data.Url),
-		)
-	}()
-
-	wg.Wait()
-	close(errCh)
-	return <-errCh
-}
+	email, err := ns.templates.Render(l, templateName, data)
+	if err != nil {
+		return err
+	}
 
-// TODO: REFACTORE
-func (ns *NotificationService) BuyerDeadlineExtensionResponse(data *notification.BuyerDeadlineExtension) error {
-	errCh := make(chan error, 1)
-	var wg sync.WaitGroup
-	wg.Add(1)
-
-	go func() {
-		defer wg.Done()
-		errCh <- helper.SendMail(
-			data.ReceiverEmail,
-			fmt.Sprintf("Buyer Response Your Deadline Extension"),
-			fmt.Sprintf("Check your order %s", data.Url),
-		)
-	}()
-
-	wg.Wait()
-	close(errCh)
-	return <-errCh
-}
+	if channel.InApp() && o.EventType != types.EVENT_CHAT_DIGEST {
+		if err := ns.saveInApp(ctx, o, email, data); err != nil {
+			return err
+		}
+	}
 
-// TODO: REFACTORE
-func (ns *NotificationService) BuyerRefundsAnOrder(data *notification.BuyerRefundsOrderRequest) error {
-	errCh := make(chan error, 1)
-	var wg sync.WaitGroup
-	wg.Add(1)
-
-	go func() {
-		defer wg.Done()
-		errCh <- helper.SendMail(
-			data.ReceiverEmail,
-			fmt.Sprintf("Buyer Refunds The Order"),
-			fmt.Sprintf("Check your order %s", data.Url),
-		)
-	}()
-
-	wg.Wait()
-	close(errCh)
-	return <-errCh
-}
+	if !sendEmail {
+		return nil
+	}
+
+	if o.EventType == types.EVENT_CHAT_NOTIFICATION {
+		return ns.bufferChatEmail(ctx, o, l, data)
+	}
 
-func (ns *NotificationService) SendEmailChatNotification(receiverEmail string, senderEmail string, message string) error {
-	errCh := make(chan error, 1)
-	var wg sync.WaitGroup
-	wg.Add(1)
+	if unsubscribeToken != "" {
+		email.Headers = ns.unsubscriber.Headers(unsubscribeToken)
+	}
 
-	go func() {
-		defer wg.Done()
-		errCh <- helper.SendMail(receiverEmail, fmt.Sprintf("You receive message from user: %s", senderEmail), message)
-	}()
+	return helper.SendMail(ns.mailer, o.Receiver, email)
+}
+
+// bufferChatEmail holds the chat email back so it can be sent together with
+// the other messages of the digest window, see ChatDigestWorker.
+func (ns *NotificationService) bufferChatEmail(ctx context.Context, o types.NotificationOutbox, l string, data map[string]interface{}) error {
+	senderEmail, _ := data["SenderEmail"].(string)
+	message, _ := data["Message"].(string)
+	messageID, _ := data["MessageID"].(string)
+	conversationID, _ := data["ConversationID"].(string)
+
+	return ns.digestSvc.Add(ctx, &types.ChatDigestItem{
+		OutboxID:       o.ID,
+		ReceiverEmail:  o.Receiver,
+		SenderEmail:    senderEmail,
+		MessageID:      messageID,
+		ConversationID: conversationID,
+		Message:        message,
+		Locale:         l,
+		CreatedAt:      o.CreatedAt,
+	})
+}
+
+// findLocale prefers the locale the receiver has picked over the one the
+// sending service put in the payload. The registry falls back to English.
+func (ns *NotificationService) findLocale(ctx context.Context, o types.NotificationOutbox) (string, error) {
+	l, err := ns.prefSvc.FindLocale(ctx, o.Receiver)
+	if err != nil || l != "" {
+		return l, err
+	}
 
-	wg.Wait()
-	close(errCh)
-	return <-errCh
-}
-func (ns *NotificationService) UserForgotPassword(receiverEmail string, htmlTemplateName string, resetLink string, username string) error {
-	errCh := make(chan error, 1)
-	var wg sync.WaitGroup
-	wg.Add(1)
-
-	go func() {
-		defer wg.Done()
-		helper.ForgotPasswordMail(errCh, receiverEmail, "Reset Password URL", resetLink, username)
-	}()
-
-	wg.Wait()
-	close(errCh)
-	return <-errCh
-}
-func (ns *NotificationService) UserSucessResetPassword(receiverEmail string, htmlTemplateName string, username string) error {
-	errCh := make(chan error, 1)
-	var wg sync.WaitGroup
-	wg.Add(1)
-
-	go func() {
-		defer wg.Done()
-		helper.ResetPasswordSuccessMail(errCh, receiverEmail, "Success Reseting Your Password", username)
-	}()
-
-	wg.Wait()
-	close(errCh)
-	return <-errCh
+	if o.PayloadVersion == types.OUTBOX_PAYLOAD_V2 {
+		event, err := decodeEvent(o)
+		if err != nil || len(event.Recipients) == 0 {
+			return "", err
+		}
+
+		return event.Recipients[0].Locale, nil
+	}
+
+	//NOTE: EVERY V1 REQUEST HAS A locale FIELD
+	var payload struct {
+		Locale string `json:"locale"`
+	}
+	if err := json.Unmarshal([]byte(o.Payload), &payload); err != nil {
+		return "", err
+	}
+
+	return payload.Locale, nil
 }
 
-func (ns *NotificationService) UserVerifyingEmail(receiverEmail string, htmlTemplateName string, verifyLink string) error {
-	errCh := make(chan error, 1)
-	var wg sync.WaitGroup
-	wg.Add(1)
+func (ns *NotificationService) saveInApp(ctx context.Context, o types.NotificationOutbox, email *helper.RenderedEmail, data map[string]interface{}) error {
+	link, _ := data["OrderURL"].(string)
+	if link == "" {
+		link, _ = data["PayoutURL"].(string)
+	}
+	if link == "" {
+		link, _ = data["ExportURL"].(string)
+	}
+	n := &types.InAppNotification{
+		OutboxID:      o.ID,
+		ReceiverEmail: o.Receiver,
+		EventType:     o.EventType,
+		Title:         email.Subject,
+		Body:          email.Summary,
+		Link:          link,
+	}
 
-	go func() {
-		defer wg.Done()
-		helper.VerifyAccountURLMail(errCh, receiverEmail, "Verify Account URL", verifyLink)
-	}()
+	created, err := ns.inAppSvc.Save(ctx, n)
+	if err != nil || !created {
+		return err
+	}
 
-	wg.Wait()
-	close(errCh)
-	return <-errCh
+	go ns.push(*n)
+	return nil
 }
 
-func (ns *NotificationService) SellerHasCompletedAnOrder(data *notification.SellerCompletedAnOrderRequest) error {
-	errCh := make(chan error, 1)
-	var wg sync.WaitGroup
-	wg.Add(1)
-
-	go func() {
-		defer wg.Done()
-		// helper.SellerOrderHasCompleted(errCh, receiverEmail, fmt.Sprintf("Your Order [%s] Has Marked As Complete By Buyer %s", orderID, buyerEmail), buyerEmail, orderID, sellerCurrentBalance)
-
-		errCh <- helper.SendMail(
-			data.ReceiverEmail,
-			fmt.Sprintf("Buyer [%s] Mark Your Order [%s] As COMPLETED", data.BuyerEmail, data.OrderId),
-			fmt.Sprintf("Your Current Balance is: %s. \nCheck Your Order %s", data.SellerCurrentBalance, data.Url),
-		)
-	}()
-
-	wg.Wait()
-	close(errCh)
-	return <-errCh
+func (ns *NotificationService) push(n types.InAppNotification) {
+	if ns.pusher == nil {
+		return
+	}
+
+	unread, err := ns.inAppSvc.CountUnread(context.Background(), n.ReceiverEmail)
+	if err != nil {
+		log.Printf("counting unread notifications of [%s] error:\n%+v", n.ReceiverEmail, err)
+	}
+
+	b, err := json.Marshal(types.InAppNotificationPush{
+		Topic:        "Notification",
+		Notification: n,
+		UnreadCount:  unread,
+	})
+	if err != nil {
+		log.Printf("encoding notification push error:\n%+v", err)
+		return
+	}
+
+	if err := ns.pusher.Push(n.ReceiverEmail, b); err != nil {
+		log.Printf("pushing notification to [%s] error:\n%+v", n.ReceiverEmail, err)
+	}
 }
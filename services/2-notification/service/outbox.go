@@ -0,0 +1,245 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Akihira77/gojobber/services/2-notification/types"
+	"github.com/Akihira77/gojobber/services/common/genproto/notification"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+)
+
+type OutboxService struct {
+	db *gorm.DB
+}
+
+var ErrInvalidEvent = errors.New("invalid notification event")
+
+type OutboxServiceImpl interface {
+	Publish(ctx context.Context, event *notification.NotificationEvent) error
+	ClaimDue(ctx context.Context, limit int, lockFor time.Duration) ([]types.NotificationOutbox, error)
+	MarkSent(ctx context.Context, id string) error
+	MarkFailed(ctx context.Context, id string, attempts int, lastErr string, nextAttemptAt time.Time) error
+	MarkDead(ctx context.Context, id string, attempts int, lastErr string) error
+	FindAll(ctx context.Context, status types.OutboxStatus, page, size int) ([]types.NotificationOutbox, int64, error)
+	FindByID(ctx context.Context, id string) (*types.NotificationOutbox, error)
+	Replay(ctx context.Context, id string) (*types.NotificationOutbox, error)
+	ReplayAllDead(ctx context.Context) (int64, error)
+}
+
+func NewOutboxService(db *gorm.DB) OutboxServiceImpl {
+	return &OutboxService{
+		db: db,
+	}
+}
+
+func newOutbox(eventType types.OutboxEventType, receiver string, payload proto.Message) (*types.NotificationOutbox, error) {
+	b, err := protojson.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("Error encoding notification payload %v", err)
+	}
+
+	now := time.Now()
+	return &types.NotificationOutbox{
+		EventType:      eventType,
+		Receiver:       receiver,
+		Payload:        string(b),
+		PayloadVersion: types.OUTBOX_PAYLOAD_V1,
+		Status:         types.OUTBOX_PENDING,
+		NextAttemptAt:  now,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}, nil
+}
+
+// Publish fans the event out into one outbox row per recipient, so every
+// recipient is retried, replayed and delivered on its own.
+func (os *OutboxService) Publish(ctx context.Context, event *notification.NotificationEvent) error {
+	eventType, ok := publishedEvents[event.Type]
+	if !ok {
+		return fmt.Errorf("%w: event type [%s] is not supported", ErrInvalidEvent, event.Type)
+	}
+	if len(event.Recipients) == 0 {
+		return fmt.Errorf("%w: event [%s] has no recipient", ErrInvalidEvent, event.Type)
+	}
+
+	outboxes := make([]*types.NotificationOutbox, 0, len(event.Recipients))
+	for _, recipient := range event.Recipients {
+		if recipient.Email == "" {
+			return fmt.Errorf("%w: event [%s] has a recipient without email", ErrInvalidEvent, event.Type)
+		}
+
+		single := proto.Clone(event).(*notification.NotificationEvent)
+		single.Recipients = []*notification.NotificationRecipient{recipient}
+
+		outbox, err := newOutbox(eventType, recipient.Email, single)
+		if err != nil {
+			return err
+		}
+		outbox.PayloadVersion = types.OUTBOX_PAYLOAD_V2
+		outboxes = append(outboxes, outbox)
+	}
+
+	result := os.db.
+		WithContext(ctx).
+		Model(&types.NotificationOutbox{}).
+		Create(&outboxes)
+
+	return result.Error
+}
+
+// ClaimDue locks a batch of deliverable notifications for one worker.
+// Rows stuck in PROCESSING (e.g. the worker crashed) are picked up again
+// once their lock expires.
+func (os *OutboxService) ClaimDue(ctx context.Context, limit int, lockFor time.Duration) ([]types.NotificationOutbox, error) {
+	now := time.Now()
+	var outboxes []types.NotificationOutbox
+	result := os.db.
+		WithContext(ctx).
+		Raw(`
+			UPDATE notification_outboxes
+			SET status = ?, locked_until = ?, updated_at = ?
+			WHERE id IN (
+				SELECT id FROM notification_outboxes
+				WHERE (status = ? AND next_attempt_at <= ?)
+				OR (status = ? AND locked_until < ?)
+				ORDER BY next_attempt_at
+				LIMIT ?
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING *
+		`,
+			types.OUTBOX_PROCESSING, now.Add(lockFor), now,
+			types.OUTBOX_PENDING, now,
+			types.OUTBOX_PROCESSING, now,
+			limit,
+		).
+		Scan(&outboxes)
+
+	return outboxes, result.Error
+}
+
+func (os *OutboxService) MarkSent(ctx context.Context, id string) error {
+	now := time.Now()
+	return os.db.
+		WithContext(ctx).
+		Model(&types.NotificationOutbox{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":       types.OUTBOX_SENT,
+			"attempts":     gorm.Expr("attempts + 1"),
+			"last_error":   "",
+			"locked_until": nil,
+			"sent_at":      now,
+			"updated_at":   now,
+		}).
+		Error
+}
+
+func (os *OutboxService) MarkFailed(ctx context.Context, id string, attempts int, lastErr string, nextAttemptAt time.Time) error {
+	return os.db.
+		WithContext(ctx).
+		Model(&types.NotificationOutbox{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":          types.OUTBOX_PENDING,
+			"attempts":        attempts,
+			"last_error":      lastErr,
+			"next_attempt_at": nextAttemptAt,
+			"locked_until":    nil,
+			"updated_at":      time.Now(),
+		}).
+		Error
+}
+
+func (os *OutboxService) MarkDead(ctx context.Context, id string, attempts int, lastErr string) error {
+	return os.db.
+		WithContext(ctx).
+		Model(&types.NotificationOutbox{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":       types.OUTBOX_DEAD,
+			"attempts":     attempts,
+			"last_error":   lastErr,
+			"locked_until": nil,
+			"updated_at":   time.Now(),
+		}).
+		Error
+}
+
+func (os *OutboxService) FindAll(ctx context.Context, status types.OutboxStatus, page, size int) ([]types.NotificationOutbox, int64, error) {
+	dbExec := os.db.
+		WithContext(ctx).
+		Model(&types.NotificationOutbox{})
+	if status != "" {
+		dbExec = dbExec.Where("status = ?", status)
+	}
+
+	var total int64
+	result := dbExec.Count(&total)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+
+	var outboxes []types.NotificationOutbox
+	result = dbExec.
+		Order("created_at DESC").
+		Offset((page - 1) * size).
+		Limit(size).
+		Find(&outboxes)
+
+	return outboxes, total, result.Error
+}
+
+func (os *OutboxService) FindByID(ctx context.Context, id string) (*types.NotificationOutbox, error) {
+	var outbox types.NotificationOutbox
+	result := os.db.
+		WithContext(ctx).
+		Model(&types.NotificationOutbox{}).
+		First(&outbox, "id = ?", id)
+
+	return &outbox, result.Error
+}
+
+func (os *OutboxService) Replay(ctx context.Context, id string) (*types.NotificationOutbox, error) {
+	var outbox types.NotificationOutbox
+	result := os.db.
+		WithContext(ctx).
+		Raw(`
+			UPDATE notification_outboxes
+			SET status = ?, attempts = 0, next_attempt_at = ?, locked_until = NULL, updated_at = ?
+			WHERE id = ? AND status = ?
+			RETURNING *
+		`, types.OUTBOX_PENDING, time.Now(), time.Now(), id, types.OUTBOX_DEAD).
+		Scan(&outbox)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	return &outbox, nil
+}
+
+func (os *OutboxService) ReplayAllDead(ctx context.Context) (int64, error) {
+	now := time.Now()
+	result := os.db.
+		WithContext(ctx).
+		Model(&types.NotificationOutbox{}).
+		Where("status = ?", types.OUTBOX_DEAD).
+		Updates(map[string]interface{}{
+			"status":          types.OUTBOX_PENDING,
+			"attempts":        0,
+			"next_attempt_at": now,
+			"locked_until":    nil,
+			"updated_at":      now,
+		})
+
+	return result.RowsAffected, result.Error
+}
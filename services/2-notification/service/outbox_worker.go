@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"log"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/Akihira77/gojobber/services/2-notification/types"
+)
+
+type OutboxWorkerConfig struct {
+	Workers      int
+	BatchSize    int
+	MaxAttempts  int
+	PollInterval time.Duration
+	BaseBackoff  time.Duration
+	MaxBackoff   time.Duration
+	LockDuration time.Duration
+}
+
+type OutboxWorker struct {
+	outboxSvc       OutboxServiceImpl
+	notificationSvc NotificationServiceImpl
+	cfg             OutboxWorkerConfig
+}
+
+func NewOutboxWorker(outboxSvc OutboxServiceImpl, notificationSvc NotificationServiceImpl, cfg OutboxWorkerConfig) *OutboxWorker {
+	return &OutboxWorker{
+		outboxSvc:       outboxSvc,
+		notificationSvc: notificationSvc,
+		cfg:             cfg,
+	}
+}
+
+// Run polls the outbox and fans due notifications out to a fixed pool of
+// workers until ctx is canceled.
+func (w *OutboxWorker) Run(ctx context.Context) {
+	jobs := make(chan types.NotificationOutbox, w.cfg.BatchSize)
+
+	var wg sync.WaitGroup
+	for i := 0; i < w.cfg.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for o := range jobs {
+				w.process(ctx, o)
+			}
+		}()
+	}
+
+	ticker := time.NewTicker(w.cfg.PollInterval)
+	defer func() {
+		ticker.Stop()
+		close(jobs)
+		wg.Wait()
+	}()
+
+	log.Printf("outbox worker started with [%d] workers", w.cfg.Workers)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			outboxes, err := w.outboxSvc.ClaimDue(ctx, w.cfg.BatchSize, w.cfg.LockDuration)
+			if err != nil {
+				log.Printf("outbox worker claiming error:\n%+v", err)
+				continue
+			}
+
+			for _, o := range outboxes {
+				jobs <- o
+			}
+		}
+	}
+}
+
+func (w *OutboxWorker) process(ctx context.Context, o types.NotificationOutbox) {
+	err := w.notificationSvc.Deliver(ctx, o)
+	if err == nil {
+		if err = w.outboxSvc.MarkSent(ctx, o.ID.String()); err != nil {
+			log.Printf("outbox [%s] marking as sent error:\n%+v", o.ID, err)
+		}
+		return
+	}
+
+	attempts := o.Attempts + 1
+	log.Printf("outbox [%s] %s to [%s] attempt %d failed:\n%+v", o.ID, o.EventType, o.Receiver, attempts, err)
+	if attempts >= w.cfg.MaxAttempts {
+		if err := w.outboxSvc.MarkDead(ctx, o.ID.String(), attempts, err.Error()); err != nil {
+			log.Printf("outbox [%s] marking as dead error:\n%+v", o.ID, err)
+		}
+		return
+	}
+
+	nextAttemptAt := time.Now().Add(w.backoff(attempts))
+	if err := w.outboxSvc.MarkFailed(ctx, o.ID.String(), attempts, err.Error(), nextAttemptAt); err != nil {
+		log.Printf("outbox [%s] marking as failed error:\n%+v", o.ID, err)
+	}
+}
+
+// backoff doubles the delay on every attempt: base, 2*base, 4*base, ...
+func (w *OutboxWorker) backoff(attempts int) time.Duration {
+	d := time.Duration(float64(w.cfg.BaseBackoff) * math.Pow(2, float64(attempts-1)))
+	if d <= 0 || d > w.cfg.MaxBackoff {
+		return w.cfg.MaxBackoff
+	}
+
+	return d
+}
@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/Akihira77/gojobber/services/2-notification/types"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var ErrInvalidPreference = errors.New("invalid notification preference")
+
+type PreferenceService struct {
+	db *gorm.DB
+}
+
+type PreferenceServiceImpl interface {
+	FindAll(ctx context.Context, receiverEmail string) ([]types.NotificationPreferenceDTO, error)
+	FindChannel(ctx context.Context, receiverEmail string, eventType types.OutboxEventType) (types.NotificationChannel, error)
+	Update(ctx context.Context, receiverEmail string, data *types.UpdateNotificationPreferencesDTO) error
+	FindLocale(ctx context.Context, receiverEmail string) (string, error)
+	UpdateLocale(ctx context.Context, receiverEmail string, l string) error
+}
+
+func NewPreferenceService(db *gorm.DB) PreferenceServiceImpl {
+	return &PreferenceService{
+		db: db,
+	}
+}
+
+func (ps *PreferenceService) FindAll(ctx context.Context, receiverEmail string) ([]types.NotificationPreferenceDTO, error) {
+	var saved []types.NotificationPreference
+	result := ps.db.
+		WithContext(ctx).
+		Model(&types.NotificationPreference{}).
+		Where("receiver_email = ?", receiverEmail).
+		Find(&saved)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	channels := make(map[types.OutboxEventType]types.NotificationChannel, len(saved))
+	for _, p := range saved {
+		channels[p.EventType] = p.Channel
+	}
+
+	prefs := make([]types.NotificationPreferenceDTO, 0, len(types.ConfigurableEvents))
+	for _, eventType := range types.ConfigurableEvents {
+		channel, ok := channels[eventType]
+		if !ok {
+			channel = types.DefaultChannel(eventType)
+		}
+
+		prefs = append(prefs, types.NotificationPreferenceDTO{
+			EventType: eventType,
+			Channel:   channel,
+			IsDefault: !ok,
+		})
+	}
+
+	return prefs, nil
+}
+
+// FindChannel resolves where an event should be delivered to. Mandatory
+// security emails ignore whatever the user has saved.
+func (ps *PreferenceService) FindChannel(ctx context.Context, receiverEmail string, eventType types.OutboxEventType) (types.NotificationChannel, error) {
+	if types.MandatoryEmailEvents[eventType] {
+		return types.CHANNEL_EMAIL, nil
+	}
+
+	var p types.NotificationPreference
+	result := ps.db.
+		WithContext(ctx).
+		Model(&types.NotificationPreference{}).
+		Where("receiver_email = ? AND event_type = ?", receiverEmail, eventType).
+		First(&p)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return types.DefaultChannel(eventType), nil
+	}
+	if result.Error != nil {
+		return "", result.Error
+	}
+
+	return p.Channel, nil
+}
+
+func (ps *PreferenceService) Update(ctx context.Context, receiverEmail string, data *types.UpdateNotificationPreferencesDTO) error {
+	now := time.Now()
+	prefs := make([]types.NotificationPreference, 0, len(data.Preferences))
+	for _, p := range data.Preferences {
+		if !slices.Contains(types.ConfigurableEvents, p.EventType) {
+			return fmt.Errorf("%w: notification [%s] can not be configured", ErrInvalidPreference, p.EventType)
+		}
+		if !p.Channel.Valid() {
+			return fmt.Errorf("%w: channel [%s] is invalid", ErrInvalidPreference, p.Channel)
+		}
+
+		prefs = append(prefs, types.NotificationPreference{
+			ReceiverEmail: receiverEmail,
+			EventType:     p.EventType,
+			Channel:       p.Channel,
+			UpdatedAt:     now,
+		})
+	}
+
+	if len(prefs) == 0 {
+		return nil
+	}
+
+	result := ps.db.
+		WithContext(ctx).
+		Model(&types.NotificationPreference{}).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "receiver_email"}, {Name: "event_type"}},
+			DoUpdates: clause.AssignmentColumns([]string{"channel", "updated_at"}),
+		}).
+		Create(&prefs)
+
+	return result.Error
+}
+
+// FindLocale returns an empty locale when the user has not picked one.
+func (ps *PreferenceService) FindLocale(ctx context.Context, receiverEmail string) (string, error) {
+	var p types.LocalePreference
+	result := ps.db.
+		WithContext(ctx).
+		Model(&types.LocalePreference{}).
+		Where("receiver_email = ?", receiverEmail).
+		First(&p)
+	if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return "", nil
+	}
+
+	return p.Locale, result.Error
+}
+
+// UpdateLocale saves the locale, an empty locale goes back to the one
+// guessed from the user's country.
+func (ps *PreferenceService) UpdateLocale(ctx context.Context, receiverEmail string, l string) error {
+	if l == "" {
+		result := ps.db.
+			WithContext(ctx).
+			Where("receiver_email = ?", receiverEmail).
+			Delete(&types.LocalePreference{})
+		return result.Error
+	}
+
+	result := ps.db.
+		WithContext(ctx).
+		Model(&types.LocalePreference{}).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "receiver_email"}},
+			DoUpdates: clause.AssignmentColumns([]string{"locale", "updated_at"}),
+		}).
+		Create(&types.LocalePreference{
+			ReceiverEmail: receiverEmail,
+			Locale:        l,
+			UpdatedAt:     time.Now(),
+		})
+
+	return result.Error
+}
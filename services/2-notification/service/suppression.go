@@ -0,0 +1,182 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Akihira77/gojobber/services/2-notification/types"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var ErrInvalidSuppression = errors.New("invalid email suppression")
+
+type SuppressionService struct {
+	db *gorm.DB
+}
+
+type SuppressionServiceImpl interface {
+	IsSuppressed(ctx context.Context, email string, eventType types.OutboxEventType) (bool, error)
+	Unsubscribe(ctx context.Context, email, scope string) error
+	Resubscribe(ctx context.Context, email, scope string) error
+	HandleMailEvents(ctx context.Context, events []types.MailEvent) (int, error)
+	FindByEmail(ctx context.Context, email string) ([]types.EmailSuppression, error)
+	FindAll(ctx context.Context, page, size int) ([]types.EmailSuppression, int64, error)
+	Remove(ctx context.Context, email, scope string) error
+}
+
+func NewSuppressionService(db *gorm.DB) SuppressionServiceImpl {
+	return &SuppressionService{
+		db: db,
+	}
+}
+
+// NOTE: PROVIDERS DO NOT KEEP THE CASE OF THE ADDRESS WE SENT TO
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// IsSuppressed is checked before every email is sent. Mandatory security
+// emails are never suppressed, and an unsubscribe only covers the event it
+// was made for.
+func (ss *SuppressionService) IsSuppressed(ctx context.Context, email string, eventType types.OutboxEventType) (bool, error) {
+	if types.MandatoryEmailEvents[eventType] {
+		return false, nil
+	}
+
+	var total int64
+	result := ss.db.
+		WithContext(ctx).
+		Model(&types.EmailSuppression{}).
+		Where("email = ? AND scope IN ?", normalizeEmail(email), []string{types.SUPPRESSION_SCOPE_ALL, string(eventType)}).
+		Count(&total)
+
+	return total > 0, result.Error
+}
+
+func (ss *SuppressionService) Unsubscribe(ctx context.Context, email, scope string) error {
+	if !types.UnsubscribableEvents[types.OutboxEventType(scope)] {
+		return fmt.Errorf("%w: [%s] can not be unsubscribed from", ErrInvalidSuppression, scope)
+	}
+
+	return ss.suppress(ctx, email, scope, types.SUPPRESSION_UNSUBSCRIBED, "")
+}
+
+// Resubscribe only lifts the user's own unsubscribes, a bounce or complaint
+// has to be removed by an admin.
+func (ss *SuppressionService) Resubscribe(ctx context.Context, email, scope string) error {
+	result := ss.db.
+		WithContext(ctx).
+		Where("email = ? AND scope = ? AND reason = ?", normalizeEmail(email), scope, types.SUPPRESSION_UNSUBSCRIBED).
+		Delete(&types.EmailSuppression{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// HandleMailEvents suppresses every address the provider reported a hard
+// bounce or a complaint for and returns how many were suppressed.
+func (ss *SuppressionService) HandleMailEvents(ctx context.Context, events []types.MailEvent) (int, error) {
+	suppressed := 0
+	for _, e := range events {
+		if e.Email == "" {
+			return suppressed, fmt.Errorf("%w: email of [%s] event is missing", ErrInvalidSuppression, e.Type)
+		}
+
+		var reason types.SuppressionReason
+		switch e.Type {
+		case types.MAIL_EVENT_BOUNCE:
+			if e.BounceType != types.MAIL_BOUNCE_HARD {
+				continue
+			}
+			reason = types.SUPPRESSION_BOUNCED
+		case types.MAIL_EVENT_COMPLAINT:
+			reason = types.SUPPRESSION_COMPLAINED
+		default:
+			return suppressed, fmt.Errorf("%w: mail event [%s] is not supported", ErrInvalidSuppression, e.Type)
+		}
+
+		if err := ss.suppress(ctx, e.Email, types.SUPPRESSION_SCOPE_ALL, reason, e.Detail); err != nil {
+			return suppressed, err
+		}
+		suppressed++
+	}
+
+	return suppressed, nil
+}
+
+func (ss *SuppressionService) suppress(ctx context.Context, email, scope string, reason types.SuppressionReason, detail string) error {
+	result := ss.db.
+		WithContext(ctx).
+		Model(&types.EmailSuppression{}).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "email"}, {Name: "scope"}},
+			DoUpdates: clause.AssignmentColumns([]string{"reason", "detail"}),
+		}).
+		Create(&types.EmailSuppression{
+			Email:     normalizeEmail(email),
+			Scope:     scope,
+			Reason:    reason,
+			Detail:    detail,
+			CreatedAt: time.Now(),
+		})
+
+	return result.Error
+}
+
+func (ss *SuppressionService) FindByEmail(ctx context.Context, email string) ([]types.EmailSuppression, error) {
+	var suppressions []types.EmailSuppression
+	result := ss.db.
+		WithContext(ctx).
+		Model(&types.EmailSuppression{}).
+		Where("email = ?", normalizeEmail(email)).
+		Order("created_at DESC").
+		Find(&suppressions)
+
+	return suppressions, result.Error
+}
+
+func (ss *SuppressionService) FindAll(ctx context.Context, page, size int) ([]types.EmailSuppression, int64, error) {
+	var total int64
+	result := ss.db.
+		WithContext(ctx).
+		Model(&types.EmailSuppression{}).
+		Count(&total)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+
+	var suppressions []types.EmailSuppression
+	result = ss.db.
+		WithContext(ctx).
+		Model(&types.EmailSuppression{}).
+		Order("created_at DESC").
+		Offset((page - 1) * size).
+		Limit(size).
+		Find(&suppressions)
+
+	return suppressions, total, result.Error
+}
+
+func (ss *SuppressionService) Remove(ctx context.Context, email, scope string) error {
+	result := ss.db.
+		WithContext(ctx).
+		Where("email = ? AND scope = ?", normalizeEmail(email), scope).
+		Delete(&types.EmailSuppression{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
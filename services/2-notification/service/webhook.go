@@ -0,0 +1,480 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Akihira77/gojobber/services/2-notification/helper"
+	"github.com/Akihira77/gojobber/services/2-notification/types"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const MAX_WEBHOOK_ENDPOINTS = 10
+
+var ErrInvalidWebhook = errors.New("invalid webhook")
+
+type WebhookService struct {
+	db *gorm.DB
+	// allowLoopback lets local setups register plain http endpoints on
+	// localhost, see WEBHOOK_ALLOW_LOOPBACK.
+	allowLoopback bool
+}
+
+type WebhookServiceImpl interface {
+	FindEndpoints(ctx context.Context, ownerEmail string) ([]types.WebhookEndpoint, error)
+	CreateEndpoint(ctx context.Context, ownerEmail string, data *types.UpsertWebhookEndpointDTO) (*types.WebhookEndpoint, error)
+	UpdateEndpoint(ctx context.Context, ownerEmail, id string, data *types.UpsertWebhookEndpointDTO) (*types.WebhookEndpoint, error)
+	DeleteEndpoint(ctx context.Context, ownerEmail, id string) error
+	RotateSecret(ctx context.Context, ownerEmail, id string) (*types.WebhookEndpoint, error)
+	Dispatch(ctx context.Context, ownerEmail string, event types.WebhookEventName, data map[string]interface{}) error
+	FindDeliveries(ctx context.Context, ownerEmail, endpointID string, page, size int) ([]types.WebhookDelivery, int64, error)
+	Redeliver(ctx context.Context, ownerEmail, deliveryID string) (*types.WebhookDelivery, error)
+	ClaimDue(ctx context.Context, limit int, lockFor time.Duration) ([]types.WebhookDelivery, error)
+	MarkSucceeded(ctx context.Context, id string, responseStatus int) error
+	MarkFailed(ctx context.Context, id string, attempts, responseStatus int, lastErr string, nextAttemptAt time.Time) error
+	MarkDead(ctx context.Context, id string, attempts, responseStatus int, lastErr string) error
+}
+
+func NewWebhookService(db *gorm.DB, allowLoopback bool) WebhookServiceImpl {
+	return &WebhookService{
+		db:            db,
+		allowLoopback: allowLoopback,
+	}
+}
+
+func (ws *WebhookService) FindEndpoints(ctx context.Context, ownerEmail string) ([]types.WebhookEndpoint, error) {
+	var endpoints []types.WebhookEndpoint
+	result := ws.db.
+		WithContext(ctx).
+		Model(&types.WebhookEndpoint{}).
+		Where("owner_email = ?", ownerEmail).
+		Order("created_at ASC").
+		Find(&endpoints)
+
+	return endpoints, result.Error
+}
+
+func (ws *WebhookService) findEndpoint(ctx context.Context, ownerEmail, id string) (*types.WebhookEndpoint, error) {
+	var endpoint types.WebhookEndpoint
+	result := ws.db.
+		WithContext(ctx).
+		Model(&types.WebhookEndpoint{}).
+		Where("id = ? AND owner_email = ?", id, ownerEmail).
+		First(&endpoint)
+
+	return &endpoint, result.Error
+}
+
+// CreateEndpoint returns the endpoint with its secret, it is the only time
+// the secret is shown besides RotateSecret.
+func (ws *WebhookService) CreateEndpoint(ctx context.Context, ownerEmail string, data *types.UpsertWebhookEndpointDTO) (*types.WebhookEndpoint, error) {
+	if err := validateWebhook(data, ws.allowLoopback); err != nil {
+		return nil, err
+	}
+
+	var total int64
+	result := ws.db.
+		WithContext(ctx).
+		Model(&types.WebhookEndpoint{}).
+		Where("owner_email = ?", ownerEmail).
+		Count(&total)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if total >= MAX_WEBHOOK_ENDPOINTS {
+		return nil, fmt.Errorf("%w: a seller can register at most %d endpoints", ErrInvalidWebhook, MAX_WEBHOOK_ENDPOINTS)
+	}
+
+	secret, err := generateWebhookSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	endpoint := &types.WebhookEndpoint{
+		OwnerEmail:  ownerEmail,
+		URL:         data.URL,
+		Description: data.Description,
+		Events:      uniqueEvents(data.Events),
+		Secret:      secret,
+		Active:      data.Active == nil || *data.Active,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	result = ws.db.
+		WithContext(ctx).
+		Model(&types.WebhookEndpoint{}).
+		Create(endpoint)
+
+	return endpoint, result.Error
+}
+
+func (ws *WebhookService) UpdateEndpoint(ctx context.Context, ownerEmail, id string, data *types.UpsertWebhookEndpointDTO) (*types.WebhookEndpoint, error) {
+	if err := validateWebhook(data, ws.allowLoopback); err != nil {
+		return nil, err
+	}
+
+	endpoint, err := ws.findEndpoint(ctx, ownerEmail, id)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint.URL = data.URL
+	endpoint.Description = data.Description
+	endpoint.Events = uniqueEvents(data.Events)
+	if data.Active != nil {
+		endpoint.Active = *data.Active
+	}
+	endpoint.UpdatedAt = time.Now()
+
+	result := ws.db.
+		WithContext(ctx).
+		Save(endpoint)
+
+	return endpoint, result.Error
+}
+
+// DeleteEndpoint also removes the delivery log of the endpoint.
+func (ws *WebhookService) DeleteEndpoint(ctx context.Context, ownerEmail, id string) error {
+	return ws.db.
+		WithContext(ctx).
+		Transaction(func(tx *gorm.DB) error {
+			result := tx.
+				Where("id = ? AND owner_email = ?", id, ownerEmail).
+				Delete(&types.WebhookEndpoint{})
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return gorm.ErrRecordNotFound
+			}
+
+			return tx.
+				Where("endpoint_id = ?", id).
+				Delete(&types.WebhookDelivery{}).
+				Error
+		})
+}
+
+// RotateSecret replaces the signing secret right away, deliveries that are
+// still retrying are signed with the new one.
+func (ws *WebhookService) RotateSecret(ctx context.Context, ownerEmail, id string) (*types.WebhookEndpoint, error) {
+	endpoint, err := ws.findEndpoint(ctx, ownerEmail, id)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint.Secret, err = generateWebhookSecret()
+	if err != nil {
+		return nil, err
+	}
+	endpoint.UpdatedAt = time.Now()
+
+	result := ws.db.
+		WithContext(ctx).
+		Model(endpoint).
+		Updates(map[string]interface{}{
+			"secret":     endpoint.Secret,
+			"updated_at": endpoint.UpdatedAt,
+		})
+
+	return endpoint, result.Error
+}
+
+// Dispatch enqueues the event for every active endpoint of the owner that is
+// subscribed to it. All endpoints share the same event id so receivers can
+// deduplicate.
+func (ws *WebhookService) Dispatch(ctx context.Context, ownerEmail string, event types.WebhookEventName, data map[string]interface{}) error {
+	if !event.Valid() {
+		return fmt.Errorf("%w: event [%s] is not supported", ErrInvalidWebhook, event)
+	}
+
+	var endpoints []types.WebhookEndpoint
+	result := ws.db.
+		WithContext(ctx).
+		Model(&types.WebhookEndpoint{}).
+		Where("owner_email = ? AND active = ?", ownerEmail, true).
+		Find(&endpoints)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	now := time.Now()
+	eventID := uuid.New()
+	b, err := json.Marshal(map[string]interface{}{
+		"id":        eventID,
+		"event":     event,
+		"createdAt": now.UTC(),
+		"data":      data,
+	})
+	if err != nil {
+		return err
+	}
+
+	deliveries := []types.WebhookDelivery{}
+	for _, endpoint := range endpoints {
+		if !endpoint.Subscribed(event) {
+			continue
+		}
+
+		deliveries = append(deliveries, types.WebhookDelivery{
+			EndpointID:    endpoint.ID,
+			EventID:       eventID,
+			Event:         event,
+			Payload:       string(b),
+			Status:        types.WEBHOOK_PENDING,
+			NextAttemptAt: now,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		})
+	}
+
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	result = ws.db.
+		WithContext(ctx).
+		Model(&types.WebhookDelivery{}).
+		Create(&deliveries)
+
+	return result.Error
+}
+
+func (ws *WebhookService) FindDeliveries(ctx context.Context, ownerEmail, endpointID string, page, size int) ([]types.WebhookDelivery, int64, error) {
+	if _, err := ws.findEndpoint(ctx, ownerEmail, endpointID); err != nil {
+		return nil, 0, err
+	}
+
+	dbExec := ws.db.
+		WithContext(ctx).
+		Model(&types.WebhookDelivery{}).
+		Where("endpoint_id = ?", endpointID)
+
+	var total int64
+	result := dbExec.Count(&total)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+
+	var deliveries []types.WebhookDelivery
+	result = dbExec.
+		Order("created_at DESC").
+		Offset((page - 1) * size).
+		Limit(size).
+		Find(&deliveries)
+
+	return deliveries, total, result.Error
+}
+
+// Redeliver sends the exact same payload again as a new delivery, whatever
+// the outcome of the original one was.
+func (ws *WebhookService) Redeliver(ctx context.Context, ownerEmail, deliveryID string) (*types.WebhookDelivery, error) {
+	var original types.WebhookDelivery
+	result := ws.db.
+		WithContext(ctx).
+		Model(&types.WebhookDelivery{}).
+		Select("webhook_deliveries.*").
+		Joins("INNER JOIN webhook_endpoints ON webhook_endpoints.id = webhook_deliveries.endpoint_id").
+		Where("webhook_deliveries.id = ? AND webhook_endpoints.owner_email = ?", deliveryID, ownerEmail).
+		First(&original)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	now := time.Now()
+	delivery := &types.WebhookDelivery{
+		EndpointID:    original.EndpointID,
+		EventID:       original.EventID,
+		Event:         original.Event,
+		Payload:       original.Payload,
+		Status:        types.WEBHOOK_PENDING,
+		RedeliveryOf:  &original.ID,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	result = ws.db.
+		WithContext(ctx).
+		Model(&types.WebhookDelivery{}).
+		Create(delivery)
+
+	return delivery, result.Error
+}
+
+// ClaimDue locks a batch of due deliveries for one worker and attaches their
+// endpoint. Rows stuck in PROCESSING are picked up again once their lock
+// expires.
+func (ws *WebhookService) ClaimDue(ctx context.Context, limit int, lockFor time.Duration) ([]types.WebhookDelivery, error) {
+	now := time.Now()
+	var deliveries []types.WebhookDelivery
+	result := ws.db.
+		WithContext(ctx).
+		Raw(`
+			UPDATE webhook_deliveries
+			SET status = ?, locked_until = ?, updated_at = ?
+			WHERE id IN (
+				SELECT id FROM webhook_deliveries
+				WHERE (status = ? AND next_attempt_at <= ?)
+				OR (status = ? AND locked_until < ?)
+				ORDER BY next_attempt_at
+				LIMIT ?
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING *
+		`,
+			types.WEBHOOK_PROCESSING, now.Add(lockFor), now,
+			types.WEBHOOK_PENDING, now,
+			types.WEBHOOK_PROCESSING, now,
+			limit,
+		).
+		Scan(&deliveries)
+	if result.Error != nil || len(deliveries) == 0 {
+		return deliveries, result.Error
+	}
+
+	endpointIDs := make([]uuid.UUID, 0, len(deliveries))
+	for _, d := range deliveries {
+		endpointIDs = append(endpointIDs, d.EndpointID)
+	}
+
+	var endpoints []types.WebhookEndpoint
+	result = ws.db.
+		WithContext(ctx).
+		Model(&types.WebhookEndpoint{}).
+		Where("id IN ?", endpointIDs).
+		Find(&endpoints)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	byID := make(map[uuid.UUID]*types.WebhookEndpoint, len(endpoints))
+	for i := range endpoints {
+		byID[endpoints[i].ID] = &endpoints[i]
+	}
+	for i := range deliveries {
+		deliveries[i].Endpoint = byID[deliveries[i].EndpointID]
+	}
+
+	return deliveries, nil
+}
+
+func (ws *WebhookService) MarkSucceeded(ctx context.Context, id string, responseStatus int) error {
+	now := time.Now()
+	return ws.db.
+		WithContext(ctx).
+		Model(&types.WebhookDelivery{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":          types.WEBHOOK_SUCCEEDED,
+			"attempts":        gorm.Expr("attempts + 1"),
+			"response_status": responseStatus,
+			"last_error":      "",
+			"locked_until":    nil,
+			"delivered_at":    now,
+			"updated_at":      now,
+		}).
+		Error
+}
+
+func (ws *WebhookService) MarkFailed(ctx context.Context, id string, attempts, responseStatus int, lastErr string, nextAttemptAt time.Time) error {
+	return ws.db.
+		WithContext(ctx).
+		Model(&types.WebhookDelivery{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":          types.WEBHOOK_PENDING,
+			"attempts":        attempts,
+			"response_status": responseStatus,
+			"last_error":      lastErr,
+			"next_attempt_at": nextAttemptAt,
+			"locked_until":    nil,
+			"updated_at":      time.Now(),
+		}).
+		Error
+}
+
+func (ws *WebhookService) MarkDead(ctx context.Context, id string, attempts, responseStatus int, lastErr string) error {
+	return ws.db.
+		WithContext(ctx).
+		Model(&types.WebhookDelivery{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":          types.WEBHOOK_FAILED,
+			"attempts":        attempts,
+			"response_status": responseStatus,
+			"last_error":      lastErr,
+			"locked_until":    nil,
+			"updated_at":      time.Now(),
+		}).
+		Error
+}
+
+// NOTE: PLAIN HTTP IS ONLY ACCEPTED FOR LOCAL DEVELOPMENT
+// validateWebhook rejects what can be seen from the URL alone. Hostnames are
+// resolved by the sender on every delivery, where internal addresses are
+// refused as well.
+func validateWebhook(data *types.UpsertWebhookEndpointDTO, allowLoopback bool) error {
+	u, err := url.Parse(data.URL)
+	if err != nil || u.Host == "" {
+		return fmt.Errorf("%w: url must be an absolute URL", ErrInvalidWebhook)
+	}
+
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+	loopback := host == "localhost" || strings.HasSuffix(host, ".localhost")
+	if ip := net.ParseIP(host); ip != nil {
+		loopback = ip.IsLoopback()
+		if !helper.WebhookIPAllowed(ip, allowLoopback) {
+			return fmt.Errorf("%w: url must not point at an internal address", ErrInvalidWebhook)
+		}
+	}
+	if loopback && !allowLoopback {
+		return fmt.Errorf("%w: url must not point at an internal address", ErrInvalidWebhook)
+	}
+
+	if u.Scheme != "https" && !(u.Scheme == "http" && loopback) {
+		return fmt.Errorf("%w: url must use https", ErrInvalidWebhook)
+	}
+
+	for _, e := range data.Events {
+		if !e.Valid() {
+			return fmt.Errorf("%w: event [%s] is not supported", ErrInvalidWebhook, e)
+		}
+	}
+
+	return nil
+}
+
+func uniqueEvents(events []types.WebhookEventName) []types.WebhookEventName {
+	unique := []types.WebhookEventName{}
+	for _, name := range types.WebhookEventNames {
+		for _, e := range events {
+			if e == name {
+				unique = append(unique, name)
+				break
+			}
+		}
+	}
+
+	return unique
+}
+
+func generateWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return "whsec_" + hex.EncodeToString(b), nil
+}
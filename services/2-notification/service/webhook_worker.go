@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"log"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/Akihira77/gojobber/services/2-notification/helper"
+	"github.com/Akihira77/gojobber/services/2-notification/types"
+)
+
+type WebhookWorkerConfig struct {
+	Workers      int
+	BatchSize    int
+	MaxAttempts  int
+	PollInterval time.Duration
+	BaseBackoff  time.Duration
+	MaxBackoff   time.Duration
+	LockDuration time.Duration
+}
+
+type WebhookWorker struct {
+	webhookSvc WebhookServiceImpl
+	sender     helper.WebhookSender
+	cfg        WebhookWorkerConfig
+}
+
+func NewWebhookWorker(webhookSvc WebhookServiceImpl, sender helper.WebhookSender, cfg WebhookWorkerConfig) *WebhookWorker {
+	return &WebhookWorker{
+		webhookSvc: webhookSvc,
+		sender:     sender,
+		cfg:        cfg,
+	}
+}
+
+// Run polls due webhook deliveries and fans them out to a fixed pool of
+// workers until ctx is canceled.
+func (w *WebhookWorker) Run(ctx context.Context) {
+	jobs := make(chan types.WebhookDelivery, w.cfg.BatchSize)
+
+	var wg sync.WaitGroup
+	for i := 0; i < w.cfg.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for d := range jobs {
+				w.process(ctx, d)
+			}
+		}()
+	}
+
+	ticker := time.NewTicker(w.cfg.PollInterval)
+	defer func() {
+		ticker.Stop()
+		close(jobs)
+		wg.Wait()
+	}()
+
+	log.Printf("webhook worker started with [%d] workers", w.cfg.Workers)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			deliveries, err := w.webhookSvc.ClaimDue(ctx, w.cfg.BatchSize, w.cfg.LockDuration)
+			if err != nil {
+				log.Printf("webhook worker claiming error:\n%+v", err)
+				continue
+			}
+
+			for _, d := range deliveries {
+				jobs <- d
+			}
+		}
+	}
+}
+
+func (w *WebhookWorker) process(ctx context.Context, d types.WebhookDelivery) {
+	//NOTE: THE ENDPOINT WAS DISABLED AFTER THE EVENT WAS DISPATCHED
+	if d.Endpoint == nil || !d.Endpoint.Active {
+		if err := w.webhookSvc.MarkDead(ctx, d.ID.String(), d.Attempts, 0, "endpoint is disabled"); err != nil {
+			log.Printf("webhook delivery [%s] marking as failed error:\n%+v", d.ID, err)
+		}
+		return
+	}
+
+	statusCode, err := w.sender.Send(helper.WebhookRequest{
+		URL:        d.Endpoint.URL,
+		Secret:     d.Endpoint.Secret,
+		Event:      string(d.Event),
+		DeliveryID: d.ID.String(),
+		Payload:    []byte(d.Payload),
+	})
+	if err == nil {
+		if err = w.webhookSvc.MarkSucceeded(ctx, d.ID.String(), statusCode); err != nil {
+			log.Printf("webhook delivery [%s] marking as succeeded error:\n%+v", d.ID, err)
+		}
+		return
+	}
+
+	attempts := d.Attempts + 1
+	log.Printf("webhook delivery [%s] %s to [%s] attempt %d failed:\n%+v", d.ID, d.Event, d.Endpoint.URL, attempts, err)
+	if attempts >= w.cfg.MaxAttempts {
+		if err := w.webhookSvc.MarkDead(ctx, d.ID.String(), attempts, statusCode, err.Error()); err != nil {
+			log.Printf("webhook delivery [%s] marking as failed error:\n%+v", d.ID, err)
+		}
+		return
+	}
+
+	nextAttemptAt := time.Now().Add(w.backoff(attempts))
+	if err := w.webhookSvc.MarkFailed(ctx, d.ID.String(), attempts, statusCode, err.Error(), nextAttemptAt); err != nil {
+		log.Printf("webhook delivery [%s] marking for retry error:\n%+v", d.ID, err)
+	}
+}
+
+// backoff doubles the delay on every attempt: base, 2*base, 4*base, ...
+func (w *WebhookWorker) backoff(attempts int) time.Duration {
+	d := time.Duration(float64(w.cfg.BaseBackoff) * math.Pow(2, float64(attempts-1)))
+	if d <= 0 || d > w.cfg.MaxBackoff {
+		return w.cfg.MaxBackoff
+	}
+
+	return d
+}
@@ -0,0 +1,22 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ChatDigestItem is a chat email waiting to be coalesced with the other
+// messages the receiver gets inside the same digest window.
+type ChatDigestItem struct {
+	ID             uuid.UUID  `json:"id" gorm:"primaryKey;type:uuid;default:uuid_generate_v4();"`
+	OutboxID       uuid.UUID  `json:"-" gorm:"type:uuid;not null;uniqueIndex;"`
+	ReceiverEmail  string     `json:"receiverEmail" gorm:"not null;index;"`
+	SenderEmail    string     `json:"senderEmail" gorm:"not null;"`
+	MessageID      string     `json:"messageId"`
+	ConversationID string     `json:"conversationId"`
+	Message        string     `json:"message"`
+	Locale         string     `json:"locale"`
+	DigestedAt     *time.Time `json:"digestedAt,omitempty" gorm:"index;"`
+	CreatedAt      time.Time  `json:"createdAt" gorm:"not null;"`
+}
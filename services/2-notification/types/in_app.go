@@ -0,0 +1,26 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type InAppNotification struct {
+	ID            uuid.UUID       `json:"id" gorm:"primaryKey;type:uuid;default:uuid_generate_v4();"`
+	OutboxID      uuid.UUID       `json:"-" gorm:"type:uuid;not null;uniqueIndex;"`
+	ReceiverEmail string          `json:"-" gorm:"not null;index:idx_in_app_receiver_read;"`
+	EventType     OutboxEventType `json:"eventType" gorm:"type:varchar(64);not null;"`
+	Title         string          `json:"title" gorm:"not null;"`
+	Body          string          `json:"body" gorm:"not null;"`
+	Link          string          `json:"link,omitempty"`
+	Read          bool            `json:"read" gorm:"not null;default:false;index:idx_in_app_receiver_read;"`
+	ReadAt        *time.Time      `json:"readAt,omitempty"`
+	CreatedAt     time.Time       `json:"createdAt" gorm:"not null;"`
+}
+
+type InAppNotificationPush struct {
+	Topic        string            `json:"topic"`
+	Notification InAppNotification `json:"notification"`
+	UnreadCount  int64             `json:"unreadCount"`
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type OutboxStatus string
+
+const (
+	OUTBOX_PENDING    OutboxStatus = "PENDING"    // WAITING FOR (RE)DELIVERY
+	OUTBOX_PROCESSING OutboxStatus = "PROCESSING" // CLAIMED BY A WORKER
+	OUTBOX_SENT       OutboxStatus = "SENT"       // DELIVERED SUCCESSFULLY
+	OUTBOX_DEAD       OutboxStatus = "DEAD"       // GAVE UP AFTER MAX ATTEMPTS, NEEDS MANUAL REPLAY
+)
+
+type OutboxEventType string
+
+// NOTE: EVENT TYPES ARE NAMED AFTER THE gRPC METHODS THAT PRODUCE THEM
+const (
+	EVENT_USER_VERIFYING_EMAIL              OutboxEventType = "UserVerifyingEmail"
+	EVENT_USER_FORGOT_PASSWORD              OutboxEventType = "UserForgotPassword"
+	EVENT_USER_SUCCESS_RESET_PASSWORD       OutboxEventType = "UserSucessResetPassword"
+	EVENT_CHAT_NOTIFICATION                 OutboxEventType = "SendEmailChatNotification"
+	EVENT_SELLER_COMPLETED_ORDER            OutboxEventType = "SellerHasCompletedAnOrder"
+	EVENT_SELLER_REQUEST_DEADLINE_EXTENSION OutboxEventType = "SellerRequestDeadlineExtension"
+	EVENT_SELLER_CANCELED_ORDER             OutboxEventType = "SellerCanceledAnOrder"
+	EVENT_BUYER_DEADLINE_EXTENSION_RESPONSE OutboxEventType = "BuyerDeadlineExtensionResponse"
+	EVENT_BUYER_REFUNDS_ORDER               OutboxEventType = "BuyerRefundsAnOrder"
+	EVENT_SELLER_GOT_AN_ORDER               OutboxEventType = "NotifySellerOrderHasBeenMade"
+	EVENT_SELLER_GOT_A_REVIEW               OutboxEventType = "NotifySellerGotAReview"
+	EVENT_BUYER_ORDER_DELIVERED             OutboxEventType = "NotifyBuyerSellerDeliveredOrder"
+	EVENT_BUYER_ORDER_ACKNOWLEDGED          OutboxEventType = "NotifyBuyerOrderHasAcknowledged"
+	EVENT_SELLER_BUYER_RESPONDED_DELIVERY   OutboxEventType = "NotifySellerBuyerResponseDeliveredOrder"
+
+	// NOTE: PRODUCED BY service.ChatDigestWorker, NOT BY AN RPC
+	EVENT_CHAT_DIGEST OutboxEventType = "ChatDigest"
+
+	// NOTE: ONLY PRODUCED THROUGH Publish
+	EVENT_SELLER_PAYOUT_PAID     OutboxEventType = "SellerPayoutPaid"
+	EVENT_SELLER_PAYOUT_FAILED   OutboxEventType = "SellerPayoutFailed"
+	EVENT_USER_DATA_EXPORT_READY OutboxEventType = "UserDataExportReady"
+)
+
+type OutboxPayloadVersion int
+
+const (
+	// NOTE: THE REQUEST MESSAGE OF THE PER-CASE RPC, ONLY WRITTEN BEFORE
+	// Publish EXISTED AND BY service.ChatDigestWorker
+	OUTBOX_PAYLOAD_V1 OutboxPayloadVersion = 1
+	// NOTE: A notification.NotificationEvent WITH EXACTLY ONE RECIPIENT
+	OUTBOX_PAYLOAD_V2 OutboxPayloadVersion = 2
+)
+
+type NotificationOutbox struct {
+	ID             uuid.UUID            `json:"id" gorm:"primaryKey;type:uuid;default:uuid_generate_v4();"`
+	EventType      OutboxEventType      `json:"eventType" gorm:"type:varchar(64);not null;"`
+	Receiver       string               `json:"receiver" gorm:"not null;"`
+	Payload        string               `json:"payload" gorm:"type:jsonb;not null;"`
+	PayloadVersion OutboxPayloadVersion `json:"payloadVersion" gorm:"not null;default:1;"`
+	Status         OutboxStatus         `json:"status" gorm:"type:varchar(16);not null;default:'PENDING';index;"`
+	Attempts       int                  `json:"attempts" gorm:"not null;default:0;"`
+	LastError      string               `json:"lastError,omitempty"`
+	NextAttemptAt  time.Time            `json:"nextAttemptAt" gorm:"not null;index;"`
+	LockedUntil    *time.Time           `json:"lockedUntil,omitempty"`
+	SentAt         *time.Time           `json:"sentAt,omitempty"`
+	CreatedAt      time.Time            `json:"createdAt" gorm:"not null;"`
+	UpdatedAt      time.Time            `json:"updatedAt" gorm:"not null;"`
+}
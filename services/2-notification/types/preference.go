@@ -0,0 +1,101 @@
+package types
+
+import "time"
+
+type NotificationChannel string
+
+const (
+	CHANNEL_ALL    NotificationChannel = "ALL"    // EMAIL AND IN-APP
+	CHANNEL_EMAIL  NotificationChannel = "EMAIL"  // EMAIL ONLY
+	CHANNEL_IN_APP NotificationChannel = "IN_APP" // IN-APP ONLY
+	CHANNEL_OFF    NotificationChannel = "OFF"    // NOT DELIVERED AT ALL
+)
+
+func (nc NotificationChannel) Valid() bool {
+	switch nc {
+	case CHANNEL_ALL, CHANNEL_EMAIL, CHANNEL_IN_APP, CHANNEL_OFF:
+		return true
+	default:
+		return false
+	}
+}
+
+func (nc NotificationChannel) Email() bool {
+	return nc == CHANNEL_ALL || nc == CHANNEL_EMAIL
+}
+
+func (nc NotificationChannel) InApp() bool {
+	return nc == CHANNEL_ALL || nc == CHANNEL_IN_APP
+}
+
+// INFO: SECURITY EMAILS CARRY ONE-TIME LINKS, THEY ARE ALWAYS SENT BY EMAIL
+// AND CAN NOT BE CHANGED BY THE USER
+var MandatoryEmailEvents = map[OutboxEventType]bool{
+	EVENT_USER_VERIFYING_EMAIL:        true,
+	EVENT_USER_FORGOT_PASSWORD:        true,
+	EVENT_USER_SUCCESS_RESET_PASSWORD: true,
+	EVENT_USER_DATA_EXPORT_READY:      true,
+}
+
+// NOTE: ORDERED, THIS IS ALSO THE ORDER PREFERENCES ARE LISTED IN
+var ConfigurableEvents = []OutboxEventType{
+	EVENT_CHAT_NOTIFICATION,
+	EVENT_SELLER_GOT_AN_ORDER,
+	EVENT_BUYER_ORDER_ACKNOWLEDGED,
+	EVENT_BUYER_ORDER_DELIVERED,
+	EVENT_SELLER_BUYER_RESPONDED_DELIVERY,
+	EVENT_SELLER_COMPLETED_ORDER,
+	EVENT_SELLER_REQUEST_DEADLINE_EXTENSION,
+	EVENT_BUYER_DEADLINE_EXTENSION_RESPONSE,
+	EVENT_SELLER_CANCELED_ORDER,
+	EVENT_BUYER_REFUNDS_ORDER,
+	EVENT_SELLER_GOT_A_REVIEW,
+	EVENT_SELLER_PAYOUT_PAID,
+	EVENT_SELLER_PAYOUT_FAILED,
+}
+
+// DefaultChannel is used until the user saves a preference for the event.
+// Chat messages are frequent so they stay in-app unless the user opts in.
+func DefaultChannel(eventType OutboxEventType) NotificationChannel {
+	if MandatoryEmailEvents[eventType] {
+		return CHANNEL_EMAIL
+	}
+
+	if eventType == EVENT_CHAT_NOTIFICATION {
+		return CHANNEL_IN_APP
+	}
+
+	return CHANNEL_ALL
+}
+
+type NotificationPreference struct {
+	ReceiverEmail string              `json:"-" gorm:"primaryKey;"`
+	EventType     OutboxEventType     `json:"eventType" gorm:"primaryKey;type:varchar(64);"`
+	Channel       NotificationChannel `json:"channel" gorm:"type:varchar(16);not null;"`
+	UpdatedAt     time.Time           `json:"updatedAt" gorm:"not null;"`
+}
+
+type NotificationPreferenceDTO struct {
+	EventType OutboxEventType     `json:"eventType"`
+	Channel   NotificationChannel `json:"channel"`
+	IsDefault bool                `json:"isDefault"`
+}
+
+type UpdateNotificationPreferencesDTO struct {
+	Preferences []struct {
+		EventType OutboxEventType     `json:"eventType"`
+		Channel   NotificationChannel `json:"channel"`
+	} `json:"preferences"`
+}
+
+// LocalePreference overrides the locale the sending service guessed from the
+// user's country.
+type LocalePreference struct {
+	ReceiverEmail string    `json:"-" gorm:"primaryKey;"`
+	Locale        string    `json:"locale" gorm:"type:varchar(16);not null;"`
+	UpdatedAt     time.Time `json:"updatedAt" gorm:"not null;"`
+}
+
+type UpdateLocalePreferenceDTO struct {
+	Locale string `json:"locale"`
+}
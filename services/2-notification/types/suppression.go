@@ -0,0 +1,63 @@
+package types
+
+import "time"
+
+type SuppressionReason string
+
+const (
+	SUPPRESSION_UNSUBSCRIBED SuppressionReason = "UNSUBSCRIBED" // USER CLICKED AN UNSUBSCRIBE LINK
+	SUPPRESSION_BOUNCED      SuppressionReason = "BOUNCED"      // MAIL PROVIDER REPORTED A HARD BOUNCE
+	SUPPRESSION_COMPLAINED   SuppressionReason = "COMPLAINED"   // RECEIVER MARKED THE EMAIL AS SPAM
+)
+
+// NOTE: A SUPPRESSION WITH THIS SCOPE STOPS EVERY EMAIL BUT THE MANDATORY ONES
+const SUPPRESSION_SCOPE_ALL = "ALL"
+
+// INFO: NON-TRANSACTIONAL EMAILS GET A List-Unsubscribe HEADER AND AN
+// UNSUBSCRIBE LINK, EVERYTHING ELSE IS PART OF AN ORDER OR AN ACCOUNT
+var UnsubscribableEvents = map[OutboxEventType]bool{
+	EVENT_CHAT_DIGEST:         true,
+	EVENT_SELLER_GOT_A_REVIEW: true,
+}
+
+// EmailEvent returns the event an outbox row is emailed as. Chat messages are
+// only ever emailed inside a digest.
+func EmailEvent(eventType OutboxEventType) OutboxEventType {
+	if eventType == EVENT_CHAT_NOTIFICATION {
+		return EVENT_CHAT_DIGEST
+	}
+
+	return eventType
+}
+
+// EmailSuppression stops emails to an address, either for one unsubscribable
+// event or, with SUPPRESSION_SCOPE_ALL, for every non-mandatory email.
+type EmailSuppression struct {
+	Email     string            `json:"email" gorm:"primaryKey;"`
+	Scope     string            `json:"scope" gorm:"primaryKey;type:varchar(64);"`
+	Reason    SuppressionReason `json:"reason" gorm:"type:varchar(16);not null;"`
+	Detail    string            `json:"detail,omitempty"`
+	CreatedAt time.Time         `json:"createdAt" gorm:"not null;"`
+}
+
+type MailEventType string
+
+const (
+	MAIL_EVENT_BOUNCE    MailEventType = "bounce"
+	MAIL_EVENT_COMPLAINT MailEventType = "complaint"
+)
+
+// NOTE: ONLY HARD BOUNCES SUPPRESS AN ADDRESS, SOFT ONES ARE RETRIED BY THE PROVIDER
+const MAIL_BOUNCE_HARD = "hard"
+
+// MailEvent is a bounce or complaint reported by the mail provider.
+type MailEvent struct {
+	Type       MailEventType `json:"type"`
+	Email      string        `json:"email"`
+	BounceType string        `json:"bounceType,omitempty"`
+	Detail     string        `json:"detail,omitempty"`
+}
+
+type MailEventsDTO struct {
+	Events []MailEvent `json:"events"`
+}
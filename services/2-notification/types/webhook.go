@@ -0,0 +1,100 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type WebhookEventName string
+
+const (
+	WEBHOOK_ORDER_CREATED            WebhookEventName = "order.created"
+	WEBHOOK_ORDER_DELIVERY_RESPONDED WebhookEventName = "order.delivery_responded"
+	WEBHOOK_ORDER_CANCELED           WebhookEventName = "order.canceled"
+	WEBHOOK_REVIEW_CREATED           WebhookEventName = "review.created"
+)
+
+// NOTE: ORDERED, THIS IS ALSO THE ORDER EVENTS ARE LISTED IN
+var WebhookEventNames = []WebhookEventName{
+	WEBHOOK_ORDER_CREATED,
+	WEBHOOK_ORDER_DELIVERY_RESPONDED,
+	WEBHOOK_ORDER_CANCELED,
+	WEBHOOK_REVIEW_CREATED,
+}
+
+func (e WebhookEventName) Valid() bool {
+	for _, name := range WebhookEventNames {
+		if e == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WEBHOOK_PENDING    WebhookDeliveryStatus = "PENDING"    // WAITING FOR (RE)DELIVERY
+	WEBHOOK_PROCESSING WebhookDeliveryStatus = "PROCESSING" // CLAIMED BY A WORKER
+	WEBHOOK_SUCCEEDED  WebhookDeliveryStatus = "SUCCEEDED"  // ENDPOINT RESPONDED WITH 2XX
+	WEBHOOK_FAILED     WebhookDeliveryStatus = "FAILED"     // GAVE UP AFTER MAX ATTEMPTS, CAN BE REDELIVERED
+)
+
+// WebhookEndpoint is a URL a seller wants order lifecycle events pushed to.
+// An endpoint without events is subscribed to every event.
+type WebhookEndpoint struct {
+	ID          uuid.UUID          `json:"id" gorm:"primaryKey;type:uuid;default:uuid_generate_v4();"`
+	OwnerEmail  string             `json:"-" gorm:"not null;index;"`
+	URL         string             `json:"url" gorm:"not null;"`
+	Description string             `json:"description"`
+	Events      []WebhookEventName `json:"events" gorm:"type:jsonb;serializer:json;not null;"`
+	Secret      string             `json:"-" gorm:"not null;"`
+	Active      bool               `json:"active" gorm:"not null;default:true;"`
+	CreatedAt   time.Time          `json:"createdAt" gorm:"not null;"`
+	UpdatedAt   time.Time          `json:"updatedAt" gorm:"not null;"`
+}
+
+func (we *WebhookEndpoint) Subscribed(event WebhookEventName) bool {
+	if len(we.Events) == 0 {
+		return true
+	}
+
+	for _, e := range we.Events {
+		if e == event {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WebhookDelivery is one attempt series of sending an event to an endpoint.
+// Redelivering creates a new row so the log keeps every original outcome.
+type WebhookDelivery struct {
+	ID             uuid.UUID             `json:"id" gorm:"primaryKey;type:uuid;default:uuid_generate_v4();"`
+	EndpointID     uuid.UUID             `json:"endpointId" gorm:"type:uuid;not null;index;"`
+	EventID        uuid.UUID             `json:"eventId" gorm:"type:uuid;not null;"`
+	Event          WebhookEventName      `json:"event" gorm:"type:varchar(64);not null;"`
+	Payload        string                `json:"payload" gorm:"type:jsonb;not null;"`
+	Status         WebhookDeliveryStatus `json:"status" gorm:"type:varchar(16);not null;default:'PENDING';index;"`
+	Attempts       int                   `json:"attempts" gorm:"not null;default:0;"`
+	ResponseStatus int                   `json:"responseStatus"`
+	LastError      string                `json:"lastError,omitempty"`
+	RedeliveryOf   *uuid.UUID            `json:"redeliveryOf,omitempty" gorm:"type:uuid;"`
+	NextAttemptAt  time.Time             `json:"nextAttemptAt" gorm:"not null;index;"`
+	LockedUntil    *time.Time            `json:"lockedUntil,omitempty"`
+	DeliveredAt    *time.Time            `json:"deliveredAt,omitempty"`
+	CreatedAt      time.Time             `json:"createdAt" gorm:"not null;"`
+	UpdatedAt      time.Time             `json:"updatedAt" gorm:"not null;"`
+
+	Endpoint *WebhookEndpoint `json:"-" gorm:"-"`
+}
+
+type UpsertWebhookEndpointDTO struct {
+	URL         string             `json:"url"`
+	Description string             `json:"description"`
+	Events      []WebhookEventName `json:"events"`
+	Active      *bool              `json:"active"`
+}
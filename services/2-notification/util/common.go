@@ -0,0 +1,34 @@
+package util
+
+import (
+	"os"
+	"strconv"
+	"time"
+)
+
+func GetEnvInt(key string, defaultValue int) int {
+	v, err := strconv.Atoi(os.Getenv(key))
+	if err != nil {
+		return defaultValue
+	}
+
+	return v
+}
+
+func GetEnvDuration(key string, defaultValue time.Duration) time.Duration {
+	v, err := time.ParseDuration(os.Getenv(key))
+	if err != nil {
+		return defaultValue
+	}
+
+	return v
+}
+
+func GetEnvBool(key string, defaultValue bool) bool {
+	v, err := strconv.ParseBool(os.Getenv(key))
+	if err != nil {
+		return defaultValue
+	}
+
+	return v
+}
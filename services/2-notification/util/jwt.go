@@ -0,0 +1,48 @@
+package util
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Akihira77/gojobber/services/2-notification/types"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func VerifyingJWT(secret string, tokenString string) (*jwt.Token, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &types.JWTClaims{}, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("invalid signature")
+		}
+
+		return []byte(secret), nil
+	})
+
+	if err != nil {
+		log.Println("verifyingjwt", err)
+		return nil, fmt.Errorf("error parsing token")
+	}
+
+	if !token.Valid {
+		return nil, fmt.Errorf("token is invalid")
+	}
+
+	return token, nil
+}
+
+// GenerateServiceJWT signs a short lived token that lets this service call
+// the internal endpoints of the gateway.
+func GenerateServiceJWT(secret string) (string, error) {
+	claims := jwt.MapClaims{
+		"iss": "Notification Service",
+		"exp": time.Now().Add(1 * time.Minute).Unix(),
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	signedToken, err := token.SignedString([]byte(secret))
+	if err != nil {
+		log.Println("signinjwt", err)
+		return "", fmt.Errorf("error signing jwt")
+	}
+
+	return signedToken, nil
+}
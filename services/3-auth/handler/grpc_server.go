@@ -2,6 +2,7 @@ package handler
 
 import (
 	"context"
+	"encoding/json"
 	"log"
 
 	"github.com/Akihira77/gojobber/services/3-auth/service"
@@ -46,3 +47,21 @@ func (h *AuthGrpcHandler) FindUserByUserID(ctx context.Context, req *auth.FindUs
 		CreatedAt:              timestamppb.New(u.CreatedAt),
 	}, nil
 }
+
+func (h *AuthGrpcHandler) ExportAuthData(ctx context.Context, req *auth.ExportAuthDataRequest) (*auth.ExportAuthDataResponse, error) {
+	log.Println("ExportAuthData receive data", req)
+
+	u, err := h.authSvc.ExportUserData(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+
+	return &auth.ExportAuthDataResponse{
+		Data: data,
+	}, nil
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/Akihira77/gojobber/services/3-auth/util"
 	"github.com/Akihira77/gojobber/services/common/genproto/notification"
 	"github.com/Akihira77/gojobber/services/common/genproto/user"
+	"github.com/Akihira77/gojobber/services/common/locale"
 	"github.com/cloudinary/cloudinary-go/v2/api/uploader"
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
@@ -294,7 +295,7 @@ func (ah *AuthHttpHandler) SendVerifyEmailURL(c *fiber.Ctx) error {
 	}
 
 	randStr := util.RandomStr(64)
-	_, err = ah.authSvc.UpdateEmailVerification(ctx, userInfo.UserID, false, randStr)
+	u, err := ah.authSvc.UpdateEmailVerification(ctx, userInfo.UserID, false, randStr)
 	if err != nil {
 		log.Printf("sendverifyemail error:\n%+v", err)
 		return fiber.NewError(http.StatusInternalServerError, "Error sending email")
@@ -307,6 +308,7 @@ func (ah *AuthHttpHandler) SendVerifyEmailURL(c *fiber.Ctx) error {
 			ReceiverEmail:    userInfo.Email,
 			HtmlTemplateName: "verifyEmail",
 			VerifyLink:       verifURL,
+			Locale:           locale.FromCountry(u.Country),
 		})
 		if err != nil {
 			log.Printf("Error sending notification email:\n%+v", err)
@@ -353,6 +355,7 @@ func (ah *AuthHttpHandler) SendForgotPasswordURL(c *fiber.Ctx) error {
 			HtmlTemplateName: "resetPassword",
 			Username:         user.Username,
 			ResetLink:        resetPassURL,
+			Locale:           locale.FromCountry(user.Country),
 		})
 		if err != nil {
 			fmt.Printf("sendforgotpasswordurl error:\n%+v", err)
@@ -425,6 +428,7 @@ func (ah *AuthHttpHandler) ResetPassword(c *fiber.Ctx) error {
 			ReceiverEmail:    user.Email,
 			HtmlTemplateName: "resetPasswordSuccess",
 			Username:         user.Username,
+			Locale:           locale.FromCountry(user.Country),
 		})
 		if err != nil {
 			fmt.Printf("resetpasswordsuccess error:\n%+v", err)
@@ -23,6 +23,7 @@ type AuthServiceImpl interface {
 	UpdateEmailVerification(ctx context.Context, userId string, emailStatus bool, emailVerifToken ...string) (*types.AuthExcludePassword, error)
 	UpdatePasswordToken(ctx context.Context, userId string, token string, tokenExpiration time.Time) error
 	UpdatePassword(ctx context.Context, userId string, password string) error
+	ExportUserData(ctx context.Context, id string) (*types.AuthExport, error)
 }
 
 type AuthService struct {
@@ -124,6 +125,17 @@ func (as *AuthService) FindUserByID(ctx context.Context, id string) (*types.Auth
 	return &user, result.Error
 }
 
+func (as *AuthService) ExportUserData(ctx context.Context, id string) (*types.AuthExport, error) {
+	var user types.AuthExport
+	result := as.db.WithContext(ctx).
+		Model(&types.Auth{}).
+		Select("id, username, email, country, profile_picture, email_verified, created_at").
+		Where("id = ?", id).
+		First(&user)
+
+	return &user, result.Error
+}
+
 func (as *AuthService) FindUserByIDIncPassword(ctx context.Context, id string) (*types.Auth, error) {
 	var user types.Auth
 	result := as.db.WithContext(ctx).
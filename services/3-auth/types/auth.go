@@ -35,6 +35,18 @@ type AuthExcludePassword struct {
 	PasswordResetToken     string     `json:"passwordResetToken,omitempty"`
 }
 
+// AuthExport is the auth record handed out in a personal data export, the
+// password and the verification and reset tokens are left out.
+type AuthExport struct {
+	ID             string    `json:"id"`
+	Username       string    `json:"username"`
+	Email          string    `json:"email"`
+	Country        string    `json:"country"`
+	ProfilePicture string    `json:"profilePicture"`
+	EmailVerified  bool      `json:"emailVerified"`
+	CreatedAt      time.Time `json:"createdAt"`
+}
+
 type SignIn struct {
 	Username string `json:"username" validate:"required"`
 	Password string `json:"password"`
@@ -3,6 +3,7 @@ package main
 import (
 	"log"
 	"net"
+	"time"
 
 	"github.com/Akihira77/gojobber/services/4-user/handler"
 	"github.com/Akihira77/gojobber/services/4-user/service"
@@ -20,7 +21,7 @@ func NewGRPCServer(addr string) *gRPCServer {
 	}
 }
 
-func (s *gRPCServer) Run(db *gorm.DB) error {
+func (s *gRPCServer) Run(db *gorm.DB, clearancePeriod time.Duration) error {
 	lis, err := net.Listen("tcp", s.addr)
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
@@ -31,7 +32,10 @@ func (s *gRPCServer) Run(db *gorm.DB) error {
 	// register our grpc services
 	buyerSvc := service.NewBuyerService(db)
 	sellerSvc := service.NewSellerService(db)
-	handler.NewUserGRPCHandler(grpcServer, buyerSvc, sellerSvc)
+	ledgerSvc := service.NewLedgerService(db)
+	collectionSvc := service.NewCollectionService(db)
+	blockSvc := service.NewBlockService(db)
+	handler.NewUserGRPCHandler(grpcServer, buyerSvc, sellerSvc, ledgerSvc, collectionSvc, blockSvc, clearancePeriod)
 
 	log.Println("Starting gRPC server on", s.addr)
 
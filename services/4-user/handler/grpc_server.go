@@ -2,24 +2,42 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"log"
+	"time"
 
 	"github.com/Akihira77/gojobber/services/4-user/service"
 	"github.com/Akihira77/gojobber/services/4-user/types"
 	"github.com/Akihira77/gojobber/services/common/genproto/user"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
 )
 
+// FIND_SELLERS_MAX_IDS caps a FindSellers call, it covers the largest gig page.
+const FIND_SELLERS_MAX_IDS = 100
+
 type UserGRPCHandler struct {
-	buyerSvc  service.BuyerServiceImpl
-	sellerSvc service.SellerServiceImpl
+	buyerSvc      service.BuyerServiceImpl
+	sellerSvc     service.SellerServiceImpl
+	ledgerSvc     service.LedgerServiceImpl
+	collectionSvc service.CollectionServiceImpl
+	blockSvc      service.BlockServiceImpl
+	// NOTE: HOW LONG ORDER EARNINGS STAY PENDING BEFORE THEY CAN BE WITHDRAWN
+	clearancePeriod time.Duration
 	user.UnimplementedUserServiceServer
 }
 
-func NewUserGRPCHandler(grpc *grpc.Server, buyerSvc service.BuyerServiceImpl, sellerSvc service.SellerServiceImpl) {
+func NewUserGRPCHandler(grpc *grpc.Server, buyerSvc service.BuyerServiceImpl, sellerSvc service.SellerServiceImpl, ledgerSvc service.LedgerServiceImpl, collectionSvc service.CollectionServiceImpl, blockSvc service.BlockServiceImpl, clearancePeriod time.Duration) {
 	gRPCHandler := &UserGRPCHandler{
-		buyerSvc:  buyerSvc,
-		sellerSvc: sellerSvc,
+		buyerSvc:        buyerSvc,
+		sellerSvc:       sellerSvc,
+		ledgerSvc:       ledgerSvc,
+		collectionSvc:   collectionSvc,
+		blockSvc:        blockSvc,
+		clearancePeriod: clearancePeriod,
 	}
 
 	// register the BuyerServiceServer
@@ -77,6 +95,42 @@ func (h *UserGRPCHandler) FindSeller(ctx context.Context, req *user.FindSellerRe
 		return nil, err
 	}
 
+	return sellerOverviewToProto(s), nil
+}
+
+// FindSellers resolves a page of sellers in one call, callers listing gigs
+// use it instead of a FindSeller per gig.
+func (h *UserGRPCHandler) FindSellers(ctx context.Context, req *user.FindSellersRequest) (*user.FindSellersResponse, error) {
+	if len(req.SellerIds) > FIND_SELLERS_MAX_IDS {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d sellers can be requested at once", FIND_SELLERS_MAX_IDS)
+	}
+
+	res := &user.FindSellersResponse{
+		Sellers: make(map[string]*user.FindSellerResponse, len(req.SellerIds)),
+	}
+	if len(req.SellerIds) == 0 {
+		return res, nil
+	}
+
+	sellers, err := h.sellerSvc.FindSellerOverviewsByIDs(ctx, req.SellerIds)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range sellers {
+		res.Sellers[sellers[i].ID] = sellerOverviewToProto(&sellers[i])
+	}
+
+	return res, nil
+}
+
+func sellerOverviewToProto(s *types.SellerOverview) *user.FindSellerResponse {
+	var vacationStart, vacationEnd *timestamppb.Timestamp
+	if s.VacationStart != nil && s.VacationEnd != nil {
+		vacationStart = timestamppb.New(*s.VacationStart)
+		vacationEnd = timestamppb.New(*s.VacationEnd)
+	}
+
 	return &user.FindSellerResponse{
 		Id:           s.ID,
 		FullName:     s.FullName,
@@ -91,12 +145,30 @@ func (h *UserGRPCHandler) FindSeller(ctx context.Context, req *user.FindSellerRe
 			Five:  int32(s.RatingCategories.Five),
 		},
 		StripeAccountId: s.StripeAccountID,
-	}, nil
+		Country:         s.Country,
+		Level:           string(s.Level),
+		OnVacation:      s.OnVacation,
+		Verified:        s.Verified,
+		VacationMessage: s.VacationMessage,
+		VacationStart:   vacationStart,
+		VacationEnd:     vacationEnd,
+	}
 }
 
 func (h *UserGRPCHandler) UpdateSellerBalance(ctx context.Context, req *user.UpdateSellerBalanceRequest) (*user.UpdateSellerBalanceResponse, error) {
 	log.Println("UpdateSellerBalance receive data", req)
-	seller, err := h.sellerSvc.UpdateBalance(ctx, req.SellerId, req.Amount)
+	//INFO: ORDER EARNINGS ARE POSTED ONCE PER ORDER, RETRIES DO NOT CREDIT TWICE.
+	// WITHOUT AN ORDER THERE IS NO SOURCE EVENT TO KEY THE POSTING ON
+	if req.OrderId == "" {
+		return nil, status.Error(codes.InvalidArgument, "orderId is required")
+	}
+
+	err := h.ledgerSvc.RecordOrderEarning(ctx, req.SellerId, req.OrderId, req.Amount, req.PlatformFee, time.Now().Add(h.clearancePeriod))
+	if err != nil {
+		return nil, err
+	}
+
+	seller, err := h.sellerSvc.FindSellerBalanceByID(ctx, req.SellerId)
 	if err != nil {
 		return nil, err
 	}
@@ -117,6 +189,50 @@ func (h *UserGRPCHandler) UpdateSellerBalance(ctx context.Context, req *user.Upd
 		},
 		StripeAccountID: seller.StripeAccountID,
 		AccountBalance:  seller.AccountBalance,
+		Country:         seller.Country,
+	}, nil
+
+}
+
+func (h *UserGRPCHandler) FindSavedGigs(ctx context.Context, req *user.FindSavedGigsRequest) (*user.FindSavedGigsResponse, error) {
+	saved, err := h.collectionSvc.FindSavedItemIDs(ctx, req.BuyerId, types.COLLECTION_GIG, req.GigIds)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := h.collectionSvc.CountSaves(ctx, types.COLLECTION_GIG, req.GigIds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &user.FindSavedGigsResponse{
+		SavedGigIds: saved,
+		SaveCounts:  counts,
 	}, nil
+}
+
+func (h *UserGRPCHandler) IsBlocked(ctx context.Context, req *user.IsBlockedRequest) (*user.IsBlockedResponse, error) {
+	otherUserID := req.OtherUserId
+	if otherUserID == "" && req.OtherSellerId != "" {
+		var err error
+		otherUserID, err = h.blockSvc.ResolveSellerUserID(ctx, req.OtherSellerId)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, status.Error(codes.NotFound, "seller is not found")
+			}
+			return nil, err
+		}
+	}
+	if req.UserId == "" || otherUserID == "" {
+		return nil, status.Error(codes.InvalidArgument, "both users are required")
+	}
+
+	blocked, err := h.blockSvc.IsBlocked(ctx, req.UserId, otherUserID)
+	if err != nil {
+		return nil, err
+	}
 
+	return &user.IsBlockedResponse{
+		Blocked: blocked,
+	}, nil
 }
@@ -0,0 +1,100 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	svc "github.com/Akihira77/gojobber/services/4-user/service"
+	"github.com/Akihira77/gojobber/services/4-user/types"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type BlockHandler struct {
+	blockSvc svc.BlockServiceImpl
+	buyerSvc svc.BuyerServiceImpl
+}
+
+func NewBlockHandler(blockSvc svc.BlockServiceImpl, buyerSvc svc.BuyerServiceImpl) *BlockHandler {
+	return &BlockHandler{
+		blockSvc: blockSvc,
+		buyerSvc: buyerSvc,
+	}
+}
+
+func (bh *BlockHandler) FindMyBlocks(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	userInfo, ok := c.UserContext().Value("current_user").(*types.JWTClaims)
+	if !ok {
+		log.Println("invalid userInfo", userInfo)
+		return fiber.NewError(http.StatusUnauthorized, "sign-in first")
+	}
+
+	blocks, err := bh.blockSvc.FindMine(ctx, userInfo.UserID)
+	if err != nil {
+		log.Printf("find my blocks error:\n%+v", err)
+		return fiber.NewError(http.StatusInternalServerError, "Error while finding blocked users")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"blocks": blocks,
+	})
+}
+
+func (bh *BlockHandler) Block(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	userInfo, ok := c.UserContext().Value("current_user").(*types.JWTClaims)
+	if !ok {
+		log.Println("invalid userInfo", userInfo)
+		return fiber.NewError(http.StatusUnauthorized, "sign-in first")
+	}
+
+	blocked, err := bh.buyerSvc.FindBuyerByID(ctx, c.Params("userId"))
+	if err != nil {
+		log.Printf("block user error:\n%+v", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(http.StatusNotFound, "user is not found")
+		}
+		return fiber.NewError(http.StatusInternalServerError, "Error while finding user")
+	}
+
+	err = bh.blockSvc.Block(ctx, userInfo.UserID, blocked.ID)
+	if err != nil {
+		log.Printf("block user error:\n%+v", err)
+		if errors.Is(err, svc.ErrBlockSelf) {
+			return fiber.NewError(http.StatusBadRequest, err.Error())
+		}
+		return fiber.NewError(http.StatusInternalServerError, "Error while blocking user")
+	}
+
+	return c.Status(http.StatusCreated).SendString("user blocked")
+}
+
+func (bh *BlockHandler) Unblock(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	userInfo, ok := c.UserContext().Value("current_user").(*types.JWTClaims)
+	if !ok {
+		log.Println("invalid userInfo", userInfo)
+		return fiber.NewError(http.StatusUnauthorized, "sign-in first")
+	}
+
+	err := bh.blockSvc.Unblock(ctx, userInfo.UserID, c.Params("userId"))
+	if err != nil {
+		log.Printf("unblock user error:\n%+v", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(http.StatusNotFound, "user is not blocked")
+		}
+		return fiber.NewError(http.StatusInternalServerError, "Error while unblocking user")
+	}
+
+	return c.Status(http.StatusOK).SendString("user unblocked")
+}
@@ -0,0 +1,281 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	svc "github.com/Akihira77/gojobber/services/4-user/service"
+	"github.com/Akihira77/gojobber/services/4-user/types"
+	"github.com/Akihira77/gojobber/services/4-user/util"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type CollectionHandler struct {
+	collectionSvc svc.CollectionServiceImpl
+	sellerSvc     svc.SellerServiceImpl
+	validate      *validator.Validate
+}
+
+func NewCollectionHandler(collectionSvc svc.CollectionServiceImpl, sellerSvc svc.SellerServiceImpl) *CollectionHandler {
+	return &CollectionHandler{
+		collectionSvc: collectionSvc,
+		sellerSvc:     sellerSvc,
+		validate:      validator.New(validator.WithRequiredStructEnabled()),
+	}
+}
+
+// findMyCollection loads the collection from the :id param, other buyers'
+// collections are reported as not found.
+func (ch *CollectionHandler) findMyCollection(c *fiber.Ctx, ctx context.Context) (*types.Collection, error) {
+	userInfo, ok := c.UserContext().Value("current_user").(*types.JWTClaims)
+	if !ok {
+		log.Println("invalid userInfo", userInfo)
+		return nil, fiber.NewError(http.StatusUnauthorized, "sign-in first")
+	}
+
+	id := c.Params("id")
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, fiber.NewError(http.StatusNotFound, "collection is not found")
+	}
+
+	collection, err := ch.collectionSvc.FindByID(ctx, userInfo.UserID, id)
+	if err != nil {
+		log.Printf("find my collection error:\n%+v", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fiber.NewError(http.StatusNotFound, "collection is not found")
+		}
+		return nil, fiber.NewError(http.StatusInternalServerError, "Error while finding collection")
+	}
+
+	return collection, nil
+}
+
+func (ch *CollectionHandler) FindMyCollections(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	userInfo, ok := c.UserContext().Value("current_user").(*types.JWTClaims)
+	if !ok {
+		log.Println("invalid userInfo", userInfo)
+		return fiber.NewError(http.StatusUnauthorized, "sign-in first")
+	}
+
+	kind := types.CollectionKind(strings.ToUpper(c.Params("kind")))
+	if kind != types.COLLECTION_GIG && kind != types.COLLECTION_SELLER {
+		return fiber.NewError(http.StatusBadRequest, "invalid collection kind")
+	}
+
+	collections, err := ch.collectionSvc.FindByBuyerID(ctx, userInfo.UserID, kind)
+	if err != nil {
+		log.Printf("find my collections error:\n%+v", err)
+		return fiber.NewError(http.StatusInternalServerError, "Error while finding collections")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"collections": collections,
+	})
+}
+
+func (ch *CollectionHandler) Create(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	userInfo, ok := c.UserContext().Value("current_user").(*types.JWTClaims)
+	if !ok {
+		log.Println("invalid userInfo", userInfo)
+		return fiber.NewError(http.StatusUnauthorized, "sign-in first")
+	}
+
+	data := new(types.CreateCollectionDTO)
+	if err := c.BodyParser(data); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid data")
+	}
+
+	data.Name = strings.TrimSpace(data.Name)
+	err := ch.validate.Struct(data)
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{
+			"errors": util.CustomValidationErrors(err),
+		})
+	}
+
+	collection, err := ch.collectionSvc.Create(ctx, userInfo.UserID, data)
+	if err != nil {
+		log.Printf("create collection error:\n%+v", err)
+		if errors.Is(err, svc.ErrDuplicateCollection) {
+			return fiber.NewError(http.StatusConflict, "collection with this name already exists")
+		}
+		return fiber.NewError(http.StatusInternalServerError, "Error while creating collection")
+	}
+
+	return c.Status(http.StatusCreated).JSON(fiber.Map{
+		"collection": collection,
+	})
+}
+
+func (ch *CollectionHandler) Rename(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	collection, err := ch.findMyCollection(c, ctx)
+	if err != nil {
+		return err
+	}
+
+	data := new(types.RenameCollectionDTO)
+	if err := c.BodyParser(data); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid data")
+	}
+
+	data.Name = strings.TrimSpace(data.Name)
+	err = ch.validate.Struct(data)
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{
+			"errors": util.CustomValidationErrors(err),
+		})
+	}
+
+	err = ch.collectionSvc.Rename(ctx, collection, data.Name)
+	if err != nil {
+		log.Printf("rename collection error:\n%+v", err)
+		if errors.Is(err, svc.ErrDuplicateCollection) {
+			return fiber.NewError(http.StatusConflict, "collection with this name already exists")
+		}
+		return fiber.NewError(http.StatusInternalServerError, "Error while renaming collection")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"collection": collection,
+	})
+}
+
+func (ch *CollectionHandler) Delete(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	collection, err := ch.findMyCollection(c, ctx)
+	if err != nil {
+		return err
+	}
+
+	err = ch.collectionSvc.Delete(ctx, collection)
+	if err != nil {
+		log.Printf("delete collection error:\n%+v", err)
+		return fiber.NewError(http.StatusInternalServerError, "Error while deleting collection")
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+func (ch *CollectionHandler) FindItems(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	collection, err := ch.findMyCollection(c, ctx)
+	if err != nil {
+		return err
+	}
+
+	page, err := strconv.Atoi(c.Params("page", "1"))
+	if err != nil || page < 1 {
+		return fiber.NewError(http.StatusBadRequest, "invalid page number")
+	}
+
+	size, err := strconv.Atoi(c.Params("size", "10"))
+	if err != nil || size < 1 {
+		return fiber.NewError(http.StatusBadRequest, "invalid page size")
+	}
+
+	items, total, err := ch.collectionSvc.FindItems(ctx, collection, page, size)
+	if err != nil {
+		log.Printf("find collection items error:\n%+v", err)
+		return fiber.NewError(http.StatusInternalServerError, "Error while finding collection items")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"collection": collection,
+		"total":      total,
+		"items":      items,
+	})
+}
+
+func (ch *CollectionHandler) AddItem(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	collection, err := ch.findMyCollection(c, ctx)
+	if err != nil {
+		return err
+	}
+
+	itemID := c.Params("itemId")
+	switch collection.Kind {
+	case types.COLLECTION_GIG:
+		//NOTE: GIGS LIVE IN THE GIG SERVICE, ONLY THE ID FORMAT IS CHECKED HERE
+		if _, err := uuid.Parse(itemID); err != nil {
+			return fiber.NewError(http.StatusNotFound, "gig is not found")
+		}
+	case types.COLLECTION_SELLER:
+		if _, err := ch.sellerSvc.FindSellerByID(ctx, itemID); err != nil {
+			log.Printf("add collection item error:\n%+v", err)
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return fiber.NewError(http.StatusNotFound, "seller is not found")
+			}
+			return fiber.NewError(http.StatusInternalServerError, "Error while finding seller")
+		}
+	}
+
+	err = ch.collectionSvc.AddItem(ctx, collection, itemID)
+	if err != nil {
+		log.Printf("add collection item error:\n%+v", err)
+		return fiber.NewError(http.StatusInternalServerError, "Error while saving item")
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+func (ch *CollectionHandler) RemoveItem(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	collection, err := ch.findMyCollection(c, ctx)
+	if err != nil {
+		return err
+	}
+
+	err = ch.collectionSvc.RemoveItem(ctx, collection, c.Params("itemId"))
+	if err != nil {
+		log.Printf("remove collection item error:\n%+v", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(http.StatusNotFound, "item is not in this collection")
+		}
+		return fiber.NewError(http.StatusInternalServerError, "Error while removing item")
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+func (ch *CollectionHandler) CountGigSaves(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	gigID := c.Params("gigId")
+	counts, err := ch.collectionSvc.CountSaves(ctx, types.COLLECTION_GIG, []string{gigID})
+	if err != nil {
+		log.Printf("count gig saves error:\n%+v", err)
+		return fiber.NewError(http.StatusInternalServerError, "Error while counting saves")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"gigId": gigID,
+		"saves": counts[gigID],
+	})
+}
@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	svc "github.com/Akihira77/gojobber/services/4-user/service"
+	"github.com/Akihira77/gojobber/services/4-user/types"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type DataExportHandler struct {
+	exportSvc svc.DataExportServiceImpl
+}
+
+func NewDataExportHandler(exportSvc svc.DataExportServiceImpl) *DataExportHandler {
+	return &DataExportHandler{
+		exportSvc: exportSvc,
+	}
+}
+
+func (deh *DataExportHandler) Request(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	userInfo, ok := c.UserContext().Value("current_user").(*types.JWTClaims)
+	if !ok {
+		log.Println("invalid userInfo", userInfo)
+		return fiber.NewError(http.StatusUnauthorized, "sign-in first")
+	}
+
+	export, err := deh.exportSvc.Request(ctx, userInfo.UserID)
+	if err != nil {
+		log.Printf("request data export error:\n%+v", err)
+		if errors.Is(err, svc.ErrDataExportInProgress) {
+			return fiber.NewError(http.StatusConflict, err.Error())
+		}
+		return fiber.NewError(http.StatusInternalServerError, "Error while requesting data export")
+	}
+
+	return c.Status(http.StatusAccepted).JSON(fiber.Map{
+		"export": export,
+	})
+}
+
+func (deh *DataExportHandler) FindMine(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	userInfo, ok := c.UserContext().Value("current_user").(*types.JWTClaims)
+	if !ok {
+		log.Println("invalid userInfo", userInfo)
+		return fiber.NewError(http.StatusUnauthorized, "sign-in first")
+	}
+
+	exports, err := deh.exportSvc.FindMine(ctx, userInfo.UserID)
+	if err != nil {
+		log.Printf("find my data exports error:\n%+v", err)
+		return fiber.NewError(http.StatusInternalServerError, "Error while finding data exports")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"exports": exports,
+	})
+}
+
+func (deh *DataExportHandler) Download(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	userInfo, ok := c.UserContext().Value("current_user").(*types.JWTClaims)
+	if !ok {
+		log.Println("invalid userInfo", userInfo)
+		return fiber.NewError(http.StatusUnauthorized, "sign-in first")
+	}
+
+	id := c.Params("id")
+	if _, err := uuid.Parse(id); err != nil {
+		return fiber.NewError(http.StatusNotFound, "data export is not found")
+	}
+
+	export, err := deh.exportSvc.FindArchive(ctx, userInfo.UserID, id)
+	if err != nil {
+		log.Printf("download data export error:\n%+v", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(http.StatusNotFound, "data export is not found")
+		}
+		return fiber.NewError(http.StatusInternalServerError, "Error while finding data export")
+	}
+
+	//INFO: THE WORKER ONLY SWEEPS EXPIRED ARCHIVES ON ITS TICK, SO THE DEADLINE IS CHECKED HERE TOO
+	if export.Status == types.DATA_EXPORT_EXPIRED || (export.ExpiresAt != nil && time.Now().After(*export.ExpiresAt)) {
+		return fiber.NewError(http.StatusGone, "download link has expired, request a new export")
+	}
+	if export.Status != types.DATA_EXPORT_READY {
+		return fiber.NewError(http.StatusConflict, "data export is not ready yet")
+	}
+
+	c.Set(fiber.HeaderContentType, "application/zip")
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="jobber-data-%s.zip"`, export.ID))
+	return c.Status(http.StatusOK).Send(export.Archive)
+}
@@ -0,0 +1,169 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	svc "github.com/Akihira77/gojobber/services/4-user/service"
+	"github.com/Akihira77/gojobber/services/4-user/types"
+	"github.com/Akihira77/gojobber/services/4-user/util"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+type LedgerHandler struct {
+	ledgerSvc svc.LedgerServiceImpl
+	sellerSvc svc.SellerServiceImpl
+	validate  *validator.Validate
+}
+
+func NewLedgerHandler(ledgerSvc svc.LedgerServiceImpl, sellerSvc svc.SellerServiceImpl) *LedgerHandler {
+	return &LedgerHandler{
+		ledgerSvc: ledgerSvc,
+		sellerSvc: sellerSvc,
+		validate:  validator.New(validator.WithRequiredStructEnabled()),
+	}
+}
+
+func (lh *LedgerHandler) FindMyTransactions(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	seller, err := findMySeller(c, ctx, lh.sellerSvc)
+	if err != nil {
+		return err
+	}
+
+	page, err := strconv.Atoi(c.Params("page", "1"))
+	if err != nil || page < 1 {
+		return fiber.NewError(http.StatusBadRequest, "invalid page number")
+	}
+
+	size, err := strconv.Atoi(c.Params("size", "10"))
+	if err != nil || size < 1 {
+		return fiber.NewError(http.StatusBadRequest, "invalid page size")
+	}
+
+	transactions, total, err := lh.ledgerSvc.FindSellerTransactions(ctx, seller.ID, page, size)
+	if err != nil {
+		log.Printf("find my transactions error:\n%+v", err)
+		return fiber.NewError(http.StatusInternalServerError, "Error while finding transactions")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"total":        total,
+		"transactions": transactions,
+	})
+}
+
+// GetMyBalance splits the balance into what can be withdrawn now and what is
+// still in clearance, with the next earnings to clear.
+func (lh *LedgerHandler) GetMyBalance(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	seller, err := findMySeller(c, ctx, lh.sellerSvc)
+	if err != nil {
+		return err
+	}
+
+	upcoming, err := lh.ledgerSvc.FindUpcomingClearances(ctx, seller.ID, 10)
+	if err != nil {
+		log.Printf("get my balance error:\n%+v", err)
+		return fiber.NewError(http.StatusInternalServerError, "Error while finding pending earnings")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"balance": types.SellerBalanceDTO{
+			Available: seller.AccountBalance,
+			Pending:   seller.PendingBalance,
+			Upcoming:  upcoming,
+		},
+	})
+}
+
+func (lh *LedgerHandler) ReconcileMyBalance(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	seller, err := findMySeller(c, ctx, lh.sellerSvc)
+	if err != nil {
+		return err
+	}
+
+	balance, err := lh.ledgerSvc.ReconcileSeller(ctx, seller.ID)
+	if err != nil {
+		log.Printf("reconcile my balance error:\n%+v", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(http.StatusNotFound, "seller data is not found")
+		}
+		return fiber.NewError(http.StatusInternalServerError, "Error while calculating balance")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"balance": balance,
+	})
+}
+
+func (lh *LedgerHandler) Reconcile(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	mismatches, err := lh.ledgerSvc.Reconcile(ctx)
+	if err != nil {
+		log.Printf("reconcile ledger error:\n%+v", err)
+		return fiber.NewError(http.StatusInternalServerError, "Error while reconciling balances")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"consistent": len(mismatches) == 0,
+		"mismatches": mismatches,
+	})
+}
+
+func (lh *LedgerHandler) Adjust(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	data := new(types.LedgerAdjustmentDTO)
+	if err := c.BodyParser(data); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid data")
+	}
+
+	err := lh.validate.Struct(data)
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{
+			"errors": util.CustomValidationErrors(err),
+		})
+	}
+
+	if data.Kind == types.LEDGER_REFUND && data.Amount > 0 {
+		return fiber.NewError(http.StatusBadRequest, "refund amount must be negative")
+	}
+
+	if _, err := lh.sellerSvc.FindSellerBalanceByID(ctx, data.SellerID); err != nil {
+		log.Printf("adjust ledger error:\n%+v", err)
+		return fiber.NewError(http.StatusNotFound, "seller data is not found")
+	}
+
+	t, err := lh.ledgerSvc.Adjust(ctx, data)
+	if err != nil {
+		log.Printf("adjust ledger error:\n%+v", err)
+		if errors.Is(err, svc.ErrInsufficientBalance) {
+			return fiber.NewError(http.StatusBadRequest, "adjustment exceeds the seller balance")
+		}
+		if errors.Is(err, svc.ErrDuplicateLedgerTransaction) {
+			return fiber.NewError(http.StatusConflict, "adjustment with this reference is already posted")
+		}
+		return fiber.NewError(http.StatusInternalServerError, "Error while posting adjustment")
+	}
+
+	return c.Status(http.StatusCreated).JSON(fiber.Map{
+		"transaction": t,
+	})
+}
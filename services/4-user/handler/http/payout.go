@@ -0,0 +1,160 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	svc "github.com/Akihira77/gojobber/services/4-user/service"
+	"github.com/Akihira77/gojobber/services/4-user/types"
+	"github.com/Akihira77/gojobber/services/4-user/util"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type PayoutHandler struct {
+	payoutSvc svc.PayoutServiceImpl
+	sellerSvc svc.SellerServiceImpl
+	provider  string
+	validate  *validator.Validate
+}
+
+func NewPayoutHandler(payoutSvc svc.PayoutServiceImpl, sellerSvc svc.SellerServiceImpl, provider string) *PayoutHandler {
+	return &PayoutHandler{
+		payoutSvc: payoutSvc,
+		sellerSvc: sellerSvc,
+		provider:  provider,
+		validate:  validator.New(validator.WithRequiredStructEnabled()),
+	}
+}
+
+// findMySeller resolves the seller of the signed-in user and maps failures to
+// fiber errors.
+func findMySeller(c *fiber.Ctx, ctx context.Context, sellerSvc svc.SellerServiceImpl) (*types.Seller, error) {
+	userInfo, ok := c.UserContext().Value("current_user").(*types.JWTClaims)
+	if !ok {
+		log.Println("invalid userInfo", userInfo)
+		return nil, fiber.NewError(http.StatusUnauthorized, "sign-in first")
+	}
+
+	seller, err := sellerSvc.FindSellerByBuyerID(ctx, userInfo.UserID)
+	if err != nil {
+		log.Printf("find my seller error:\n%+v", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fiber.NewError(http.StatusNotFound, "seller data is not found")
+		}
+		return nil, fiber.NewError(http.StatusInternalServerError, "Error while finding your data")
+	}
+
+	return seller, nil
+}
+
+// Withdraw only queues the payout, PayoutWorker sends it to the provider and
+// the seller is notified once it is paid or has failed.
+func (ph *PayoutHandler) Withdraw(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	seller, err := findMySeller(c, ctx, ph.sellerSvc)
+	if err != nil {
+		return err
+	}
+
+	data := new(types.WithdrawDTO)
+	if err := c.BodyParser(data); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid data")
+	}
+
+	err = ph.validate.Struct(data)
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{
+			"errors": util.CustomValidationErrors(err),
+		})
+	}
+
+	//INFO: A PAYOUT WITHOUT A CONNECTED ACCOUNT CAN ONLY FAIL IN THE WORKER
+	if seller.StripeAccountID == "" {
+		return fiber.NewError(http.StatusBadRequest, "connect a stripe account before withdrawing")
+	}
+
+	if data.Amount > seller.AccountBalance {
+		return fiber.NewError(http.StatusBadRequest, "withdrawal amount exceeds your available balance")
+	}
+
+	payout, err := ph.payoutSvc.Request(ctx, seller.ID, data.Amount, ph.provider)
+	if err != nil {
+		log.Printf("withdraw error:\n%+v", err)
+		if errors.Is(err, svc.ErrInsufficientBalance) {
+			return fiber.NewError(http.StatusBadRequest, "withdrawal amount exceeds your available balance")
+		}
+		return fiber.NewError(http.StatusInternalServerError, "Error while requesting withdrawal")
+	}
+
+	return c.Status(http.StatusCreated).JSON(fiber.Map{
+		"payout": payout,
+	})
+}
+
+func (ph *PayoutHandler) FindMyPayouts(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	seller, err := findMySeller(c, ctx, ph.sellerSvc)
+	if err != nil {
+		return err
+	}
+
+	page, err := strconv.Atoi(c.Params("page", "1"))
+	if err != nil || page < 1 {
+		return fiber.NewError(http.StatusBadRequest, "invalid page number")
+	}
+
+	size, err := strconv.Atoi(c.Params("size", "10"))
+	if err != nil || size < 1 {
+		return fiber.NewError(http.StatusBadRequest, "invalid page size")
+	}
+
+	payouts, total, err := ph.payoutSvc.FindBySellerID(ctx, seller.ID, page, size)
+	if err != nil {
+		log.Printf("find my payouts error:\n%+v", err)
+		return fiber.NewError(http.StatusInternalServerError, "Error while finding payouts")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"total":   total,
+		"payouts": payouts,
+	})
+}
+
+func (ph *PayoutHandler) FindMyPayoutByID(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	seller, err := findMySeller(c, ctx, ph.sellerSvc)
+	if err != nil {
+		return err
+	}
+
+	id := c.Params("id")
+	if _, err := uuid.Parse(id); err != nil {
+		return fiber.NewError(http.StatusNotFound, "payout is not found")
+	}
+
+	payout, err := ph.payoutSvc.FindByID(ctx, seller.ID, id)
+	if err != nil {
+		log.Printf("find my payout error:\n%+v", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(http.StatusNotFound, "payout is not found")
+		}
+		return fiber.NewError(http.StatusInternalServerError, "Error while finding payout")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"payout": payout,
+	})
+}
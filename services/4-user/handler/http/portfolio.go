@@ -0,0 +1,408 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	svc "github.com/Akihira77/gojobber/services/4-user/service"
+	"github.com/Akihira77/gojobber/services/4-user/types"
+	"github.com/Akihira77/gojobber/services/4-user/util"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type PortfolioHandler struct {
+	portfolioSvc svc.PortfolioServiceImpl
+	sellerSvc    svc.SellerServiceImpl
+	cld          *util.Cloudinary
+	validate     *validator.Validate
+}
+
+func NewPortfolioHandler(portfolioSvc svc.PortfolioServiceImpl, sellerSvc svc.SellerServiceImpl, cld *util.Cloudinary) *PortfolioHandler {
+	return &PortfolioHandler{
+		portfolioSvc: portfolioSvc,
+		sellerSvc:    sellerSvc,
+		cld:          cld,
+		validate:     validator.New(validator.WithRequiredStructEnabled()),
+	}
+}
+
+func (ph *PortfolioHandler) findMyItem(c *fiber.Ctx, ctx context.Context) (*types.PortfolioItem, error) {
+	seller, err := findMySeller(c, ctx, ph.sellerSvc)
+	if err != nil {
+		return nil, err
+	}
+
+	id := c.Params("id")
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, fiber.NewError(http.StatusNotFound, "portfolio item is not found")
+	}
+
+	item, err := ph.portfolioSvc.FindByID(ctx, seller.ID, id)
+	if err != nil {
+		log.Printf("find my portfolio item error:\n%+v", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fiber.NewError(http.StatusNotFound, "portfolio item is not found")
+		}
+		return nil, fiber.NewError(http.StatusInternalServerError, "Error while finding portfolio item")
+	}
+
+	return item, nil
+}
+
+// uploadImages uploads the "images" files of the multipart form. When one of
+// them fails the ones already uploaded are removed again.
+func (ph *PortfolioHandler) uploadImages(c *fiber.Ctx, ctx context.Context, sellerID string) ([]types.PortfolioMedia, error) {
+	form, err := c.MultipartForm()
+	if err != nil {
+		return nil, nil
+	}
+
+	files := form.File["images"]
+	if len(files) > types.PORTFOLIO_MAX_MEDIA {
+		return nil, fiber.NewError(http.StatusBadRequest, fmt.Sprintf("portfolio item can have at most %d media", types.PORTFOLIO_MAX_MEDIA))
+	}
+
+	for _, fh := range files {
+		if fh.Size > types.PORTFOLIO_MAX_IMAGE_SIZE {
+			return nil, fiber.NewError(http.StatusBadRequest, "file is larger than 2MB")
+		}
+		if !util.ValidateImgExtension(fh) {
+			return nil, fiber.NewError(http.StatusBadRequest, "file type is unsupported")
+		}
+	}
+
+	var media []types.PortfolioMedia
+	for _, fh := range files {
+		file, err := fh.Open()
+		if err != nil {
+			ph.destroyMedia(media)
+			log.Printf("upload portfolio image error:\n%+v", err)
+			return nil, fiber.NewError(http.StatusBadRequest, "failed reading image file")
+		}
+
+		uploadResult, err := ph.cld.UploadImg(ctx, file, fmt.Sprintf("%s/%s", sellerID, util.RandomStr(32)))
+		file.Close()
+		if err != nil {
+			ph.destroyMedia(media)
+			log.Printf("upload portfolio image error:\n%+v", err)
+			return nil, fiber.NewError(http.StatusBadRequest, "failed upload file")
+		}
+
+		media = append(media, types.PortfolioMedia{
+			Kind:     types.PORTFOLIO_IMAGE,
+			URL:      uploadResult.SecureURL,
+			PublicID: uploadResult.PublicID,
+		})
+	}
+
+	return media, nil
+}
+
+// destroyMedia removes uploaded images in the background, a leftover file
+// on Cloudinary does not fail the request.
+func (ph *PortfolioHandler) destroyMedia(media []types.PortfolioMedia) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		for _, m := range media {
+			if m.PublicID == "" {
+				continue
+			}
+			if _, err := ph.cld.Destroy(ctx, m.PublicID); err != nil {
+				log.Printf("destroy portfolio image [%s] error:\n%+v", m.PublicID, err)
+			}
+		}
+	}()
+}
+
+func videoMedia(urls []string) []types.PortfolioMedia {
+	var media []types.PortfolioMedia
+	for _, url := range urls {
+		media = append(media, types.PortfolioMedia{
+			Kind: types.PORTFOLIO_VIDEO,
+			URL:  url,
+		})
+	}
+	return media
+}
+
+func (ph *PortfolioHandler) FindSellerPortfolio(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	items, err := ph.portfolioSvc.FindBySellerID(ctx, c.Params("sellerId"))
+	if err != nil {
+		log.Printf("find seller portfolio error:\n%+v", err)
+		return fiber.NewError(http.StatusInternalServerError, "Error while finding portfolio")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"portfolio": items,
+	})
+}
+
+func (ph *PortfolioHandler) FindMyPortfolio(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	seller, err := findMySeller(c, ctx, ph.sellerSvc)
+	if err != nil {
+		return err
+	}
+
+	items, err := ph.portfolioSvc.FindBySellerID(ctx, seller.ID)
+	if err != nil {
+		log.Printf("find my portfolio error:\n%+v", err)
+		return fiber.NewError(http.StatusInternalServerError, "Error while finding portfolio")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"portfolio": items,
+	})
+}
+
+func (ph *PortfolioHandler) Create(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 30*time.Second)
+	defer cancel()
+
+	seller, err := findMySeller(c, ctx, ph.sellerSvc)
+	if err != nil {
+		return err
+	}
+
+	data := new(types.CreatePortfolioItemDTO)
+	if err := c.BodyParser(data); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid data")
+	}
+
+	err = ph.validate.Struct(data)
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{
+			"errors": util.CustomValidationErrors(err),
+		})
+	}
+
+	images, err := ph.uploadImages(c, ctx, seller.ID)
+	if err != nil {
+		return err
+	}
+
+	item := &types.PortfolioItem{
+		SellerID:    seller.ID,
+		Title:       data.Title,
+		Description: data.Description,
+		GigID:       data.GigID,
+		Media:       append(images, videoMedia(data.VideoURLs)...),
+	}
+	err = ph.portfolioSvc.Create(ctx, item)
+	if err != nil {
+		ph.destroyMedia(images)
+		log.Printf("create portfolio item error:\n%+v", err)
+		if errors.Is(err, svc.ErrTooManyMediaFiles) {
+			return fiber.NewError(http.StatusBadRequest, fmt.Sprintf("portfolio item can have at most %d media", types.PORTFOLIO_MAX_MEDIA))
+		}
+		return fiber.NewError(http.StatusInternalServerError, "Error while creating portfolio item")
+	}
+
+	return c.Status(http.StatusCreated).JSON(fiber.Map{
+		"item": item,
+	})
+}
+
+func (ph *PortfolioHandler) Update(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	item, err := ph.findMyItem(c, ctx)
+	if err != nil {
+		return err
+	}
+
+	data := new(types.UpdatePortfolioItemDTO)
+	if err := c.BodyParser(data); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid data")
+	}
+
+	err = ph.validate.Struct(data)
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{
+			"errors": util.CustomValidationErrors(err),
+		})
+	}
+
+	err = ph.portfolioSvc.Update(ctx, item, data)
+	if err != nil {
+		log.Printf("update portfolio item error:\n%+v", err)
+		return fiber.NewError(http.StatusInternalServerError, "Error while updating portfolio item")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"item": item,
+	})
+}
+
+func (ph *PortfolioHandler) Delete(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	item, err := ph.findMyItem(c, ctx)
+	if err != nil {
+		return err
+	}
+
+	err = ph.portfolioSvc.Delete(ctx, item)
+	if err != nil {
+		log.Printf("delete portfolio item error:\n%+v", err)
+		return fiber.NewError(http.StatusInternalServerError, "Error while deleting portfolio item")
+	}
+
+	ph.destroyMedia(item.Media)
+	return c.SendStatus(http.StatusNoContent)
+}
+
+func (ph *PortfolioHandler) AddMedia(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 30*time.Second)
+	defer cancel()
+
+	item, err := ph.findMyItem(c, ctx)
+	if err != nil {
+		return err
+	}
+
+	data := new(types.AddPortfolioMediaDTO)
+	if err := c.BodyParser(data); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid data")
+	}
+
+	err = ph.validate.Struct(data)
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{
+			"errors": util.CustomValidationErrors(err),
+		})
+	}
+
+	images, err := ph.uploadImages(c, ctx, item.SellerID)
+	if err != nil {
+		return err
+	}
+
+	media := append(images, videoMedia(data.VideoURLs)...)
+	if len(media) == 0 {
+		return fiber.NewError(http.StatusBadRequest, "no images or video links are given")
+	}
+
+	err = ph.portfolioSvc.AddMedia(ctx, item, media)
+	if err != nil {
+		ph.destroyMedia(images)
+		log.Printf("add portfolio media error:\n%+v", err)
+		if errors.Is(err, svc.ErrTooManyMediaFiles) {
+			return fiber.NewError(http.StatusBadRequest, fmt.Sprintf("portfolio item can have at most %d media", types.PORTFOLIO_MAX_MEDIA))
+		}
+		return fiber.NewError(http.StatusInternalServerError, "Error while adding portfolio media")
+	}
+
+	return c.Status(http.StatusCreated).JSON(fiber.Map{
+		"item": item,
+	})
+}
+
+func (ph *PortfolioHandler) RemoveMedia(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	item, err := ph.findMyItem(c, ctx)
+	if err != nil {
+		return err
+	}
+
+	mediaID := c.Params("mediaId")
+	if _, err := uuid.Parse(mediaID); err != nil {
+		return fiber.NewError(http.StatusNotFound, "portfolio media is not found")
+	}
+
+	m, err := ph.portfolioSvc.RemoveMedia(ctx, item, mediaID)
+	if err != nil {
+		log.Printf("remove portfolio media error:\n%+v", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(http.StatusNotFound, "portfolio media is not found")
+		}
+		return fiber.NewError(http.StatusInternalServerError, "Error while removing portfolio media")
+	}
+
+	ph.destroyMedia([]types.PortfolioMedia{*m})
+	return c.SendStatus(http.StatusNoContent)
+}
+
+func (ph *PortfolioHandler) ReorderItems(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	seller, err := findMySeller(c, ctx, ph.sellerSvc)
+	if err != nil {
+		return err
+	}
+
+	data := new(types.ReorderDTO)
+	if err := c.BodyParser(data); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid data")
+	}
+
+	err = ph.validate.Struct(data)
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{
+			"errors": util.CustomValidationErrors(err),
+		})
+	}
+
+	err = ph.portfolioSvc.ReorderItems(ctx, seller.ID, data.IDs)
+	if err != nil {
+		log.Printf("reorder portfolio error:\n%+v", err)
+		if errors.Is(err, svc.ErrInvalidOrder) {
+			return fiber.NewError(http.StatusBadRequest, "order must list every portfolio item exactly once")
+		}
+		return fiber.NewError(http.StatusInternalServerError, "Error while reordering portfolio")
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+func (ph *PortfolioHandler) ReorderMedia(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	item, err := ph.findMyItem(c, ctx)
+	if err != nil {
+		return err
+	}
+
+	data := new(types.ReorderDTO)
+	if err := c.BodyParser(data); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid data")
+	}
+
+	err = ph.validate.Struct(data)
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{
+			"errors": util.CustomValidationErrors(err),
+		})
+	}
+
+	err = ph.portfolioSvc.ReorderMedia(ctx, item, data.IDs)
+	if err != nil {
+		log.Printf("reorder portfolio media error:\n%+v", err)
+		if errors.Is(err, svc.ErrInvalidOrder) {
+			return fiber.NewError(http.StatusBadRequest, "order must list every media of the item exactly once")
+		}
+		return fiber.NewError(http.StatusInternalServerError, "Error while reordering portfolio media")
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
@@ -0,0 +1,179 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	svc "github.com/Akihira77/gojobber/services/4-user/service"
+	"github.com/Akihira77/gojobber/services/4-user/types"
+	"github.com/Akihira77/gojobber/services/4-user/util"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ReportHandler struct {
+	reportSvc svc.ReportServiceImpl
+	buyerSvc  svc.BuyerServiceImpl
+	validate  *validator.Validate
+}
+
+func NewReportHandler(reportSvc svc.ReportServiceImpl, buyerSvc svc.BuyerServiceImpl) *ReportHandler {
+	return &ReportHandler{
+		reportSvc: reportSvc,
+		buyerSvc:  buyerSvc,
+		validate:  validator.New(validator.WithRequiredStructEnabled()),
+	}
+}
+
+func (rh *ReportHandler) Create(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	userInfo, ok := c.UserContext().Value("current_user").(*types.JWTClaims)
+	if !ok {
+		log.Println("invalid userInfo", userInfo)
+		return fiber.NewError(http.StatusUnauthorized, "sign-in first")
+	}
+
+	data := new(types.CreateUserReportDTO)
+	if err := c.BodyParser(data); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid data")
+	}
+
+	err := rh.validate.Struct(data)
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{
+			"errors": util.CustomValidationErrors(err),
+		})
+	}
+
+	_, err = rh.buyerSvc.FindBuyerByID(ctx, data.ReportedID)
+	if err != nil {
+		log.Printf("create report error:\n%+v", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(http.StatusNotFound, "reported user is not found")
+		}
+		return fiber.NewError(http.StatusInternalServerError, "Error while finding reported user")
+	}
+
+	report, err := rh.reportSvc.Create(ctx, userInfo.UserID, data)
+	if err != nil {
+		log.Printf("create report error:\n%+v", err)
+		if errors.Is(err, svc.ErrReportSelf) {
+			return fiber.NewError(http.StatusBadRequest, err.Error())
+		}
+		if errors.Is(err, svc.ErrDuplicateReport) {
+			return fiber.NewError(http.StatusConflict, err.Error())
+		}
+		return fiber.NewError(http.StatusInternalServerError, "Error while reporting user")
+	}
+
+	return c.Status(http.StatusCreated).JSON(fiber.Map{
+		"report": report,
+	})
+}
+
+func (rh *ReportHandler) FindQueue(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	status := types.ReportStatus(strings.ToUpper(c.Params("status")))
+	if status != types.REPORT_OPEN && status != types.REPORT_ACTIONED && status != types.REPORT_DISMISSED {
+		return fiber.NewError(http.StatusBadRequest, "invalid report status")
+	}
+
+	page, err := strconv.Atoi(c.Params("page", "1"))
+	if err != nil || page < 1 {
+		return fiber.NewError(http.StatusBadRequest, "invalid page number")
+	}
+
+	size, err := strconv.Atoi(c.Params("size", "10"))
+	if err != nil || size < 1 {
+		return fiber.NewError(http.StatusBadRequest, "invalid page size")
+	}
+
+	reports, total, err := rh.reportSvc.FindQueue(ctx, status, page, size)
+	if err != nil {
+		log.Printf("find report queue error:\n%+v", err)
+		return fiber.NewError(http.StatusInternalServerError, "Error while finding reports")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"total":   total,
+		"reports": reports,
+	})
+}
+
+func (rh *ReportHandler) FindByID(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	id := c.Params("id")
+	if _, err := uuid.Parse(id); err != nil {
+		return fiber.NewError(http.StatusNotFound, "report is not found")
+	}
+
+	report, err := rh.reportSvc.FindByID(ctx, id)
+	if err != nil {
+		log.Printf("find report error:\n%+v", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(http.StatusNotFound, "report is not found")
+		}
+		return fiber.NewError(http.StatusInternalServerError, "Error while finding report")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"report": report,
+	})
+}
+
+func (rh *ReportHandler) Review(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	userInfo, ok := c.UserContext().Value("current_user").(*types.JWTClaims)
+	if !ok {
+		log.Println("invalid userInfo", userInfo)
+		return fiber.NewError(http.StatusUnauthorized, "sign-in first")
+	}
+
+	id := c.Params("id")
+	if _, err := uuid.Parse(id); err != nil {
+		return fiber.NewError(http.StatusNotFound, "report is not found")
+	}
+
+	data := new(types.ReviewUserReportDTO)
+	if err := c.BodyParser(data); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid data")
+	}
+
+	err := rh.validate.Struct(data)
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{
+			"errors": util.CustomValidationErrors(err),
+		})
+	}
+
+	report, err := rh.reportSvc.Review(ctx, id, userInfo.UserID, data)
+	if err != nil {
+		log.Printf("review report error:\n%+v", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(http.StatusNotFound, "report is not found")
+		}
+		if errors.Is(err, svc.ErrReportAlreadyClosed) {
+			return fiber.NewError(http.StatusConflict, err.Error())
+		}
+		return fiber.NewError(http.StatusInternalServerError, "Error while reviewing report")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"report": report,
+	})
+}
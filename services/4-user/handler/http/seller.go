@@ -114,6 +114,47 @@ func (sh *SellerHandler) GetRandomSellers(c *fiber.Ctx) error {
 	})
 }
 
+func (sh *SellerHandler) SearchSellers(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 2*time.Second)
+	defer cancel()
+
+	var p types.SellerSearchParams
+	err := c.ParamsParser(&p)
+	if err != nil {
+		log.Println("search-seller params:", err)
+		return fiber.NewError(http.StatusBadRequest, "searching error")
+	}
+
+	if p.Page < 1 {
+		return fiber.NewError(http.StatusBadRequest, "invalid page number")
+	}
+	if p.Size < 1 {
+		return fiber.NewError(http.StatusBadRequest, "invalid page size")
+	}
+
+	var q types.SellerSearchQuery
+	err = c.QueryParser(&q)
+	if err != nil {
+		log.Println("search-seller query:", err)
+		return fiber.NewError(http.StatusBadRequest, "searching error")
+	}
+
+	err = sh.validate.Struct(q)
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{
+			"errors": util.CustomValidationErrors(err),
+		})
+	}
+
+	result, err := sh.sellerSvc.SearchSellers(ctx, &p, &q)
+	if err != nil {
+		log.Println("seller query search:", err)
+		return fiber.NewError(http.StatusInternalServerError, "Error while searching sellers")
+	}
+
+	return c.Status(http.StatusOK).JSON(result)
+}
+
 func (sh *SellerHandler) Create(c *fiber.Ctx) error {
 	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
 	defer cancel()
@@ -226,6 +267,9 @@ func (sh *SellerHandler) Update(c *fiber.Ctx) error {
 	}
 
 	err = sh.sellerSvc.Update(ctx, seller, data)
+	if errors.Is(err, svc.ErrInvalidLanguage) || errors.Is(err, svc.ErrInvalidTaxonomyName) {
+		return fiber.NewError(http.StatusBadRequest, err.Error())
+	}
 	if err != nil {
 		fmt.Printf("%+v", err)
 		return fiber.NewError(http.StatusInternalServerError, "update data error. Try again")
@@ -233,3 +277,89 @@ func (sh *SellerHandler) Update(c *fiber.Ctx) error {
 
 	return c.Status(http.StatusOK).SendString("update success")
 }
+
+func (sh *SellerHandler) GetMyVacation(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	seller, err := findMySeller(c, ctx, sh.sellerSvc)
+	if err != nil {
+		return err
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"vacation": sellerVacation(seller),
+	})
+}
+
+// SetVacation schedules the vacation, a start date in the past starts it
+// right away. Setting it again replaces the previous one.
+func (sh *SellerHandler) SetVacation(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	seller, err := findMySeller(c, ctx, sh.sellerSvc)
+	if err != nil {
+		return err
+	}
+
+	data := new(types.SellerVacationDTO)
+	if err := c.BodyParser(data); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid data")
+	}
+
+	err = sh.validate.Struct(data)
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{
+			"errors": util.CustomValidationErrors(err),
+		})
+	}
+
+	now := time.Now()
+	if !data.EndDate.After(now) {
+		return fiber.NewError(http.StatusBadRequest, "vacation end date must be in the future")
+	}
+	if data.StartDate.Before(now) {
+		data.StartDate = now
+	}
+	if data.EndDate.Sub(data.StartDate) > types.SELLER_VACATION_MAX_DURATION {
+		return fiber.NewError(http.StatusBadRequest, fmt.Sprintf("vacation can last at most %d days", int(types.SELLER_VACATION_MAX_DURATION.Hours()/24)))
+	}
+
+	err = sh.sellerSvc.SetVacation(ctx, seller, data)
+	if err != nil {
+		log.Printf("set vacation error:\n%+v", err)
+		return fiber.NewError(http.StatusInternalServerError, "Error while saving vacation")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"vacation": sellerVacation(seller),
+	})
+}
+
+func (sh *SellerHandler) EndVacation(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	seller, err := findMySeller(c, ctx, sh.sellerSvc)
+	if err != nil {
+		return err
+	}
+
+	err = sh.sellerSvc.EndVacation(ctx, seller)
+	if err != nil {
+		log.Printf("end vacation error:\n%+v", err)
+		return fiber.NewError(http.StatusInternalServerError, "Error while ending vacation")
+	}
+
+	return c.SendStatus(http.StatusNoContent)
+}
+
+func sellerVacation(seller *types.Seller) types.SellerVacation {
+	return types.SellerVacation{
+		OnVacation: seller.OnVacation(time.Now()),
+		StartDate:  seller.VacationStart,
+		EndDate:    seller.VacationEnd,
+		Message:    seller.VacationMessage,
+	}
+}
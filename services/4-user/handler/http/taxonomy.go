@@ -0,0 +1,257 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	svc "github.com/Akihira77/gojobber/services/4-user/service"
+	"github.com/Akihira77/gojobber/services/4-user/types"
+	"github.com/Akihira77/gojobber/services/4-user/util"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+)
+
+const (
+	TAXONOMY_AUTOCOMPLETE_DEFAULT_LIMIT = 10
+	TAXONOMY_AUTOCOMPLETE_MAX_LIMIT     = 25
+)
+
+type TaxonomyHandler struct {
+	taxonomySvc svc.TaxonomyServiceImpl
+	validate    *validator.Validate
+}
+
+func NewTaxonomyHandler(taxonomySvc svc.TaxonomyServiceImpl) *TaxonomyHandler {
+	return &TaxonomyHandler{
+		taxonomySvc: taxonomySvc,
+		validate:    validator.New(validator.WithRequiredStructEnabled()),
+	}
+}
+
+func taxonomyKind(c *fiber.Ctx) (types.TaxonomyKind, error) {
+	kind, ok := types.ParseTaxonomyKind(c.Params("kind"))
+	if !ok {
+		return "", fiber.NewError(http.StatusBadRequest, "invalid taxonomy kind")
+	}
+
+	return kind, nil
+}
+
+func taxonomyID(c *fiber.Ctx) (uint, error) {
+	id, err := strconv.ParseUint(c.Params("id"), 10, 64)
+	if err != nil || id == 0 {
+		return 0, fiber.NewError(http.StatusNotFound, "term is not found")
+	}
+
+	return uint(id), nil
+}
+
+func (th *TaxonomyHandler) Autocomplete(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	kind, err := taxonomyKind(c)
+	if err != nil {
+		return err
+	}
+
+	limit := c.QueryInt("limit", TAXONOMY_AUTOCOMPLETE_DEFAULT_LIMIT)
+	if limit <= 0 || limit > TAXONOMY_AUTOCOMPLETE_MAX_LIMIT {
+		limit = TAXONOMY_AUTOCOMPLETE_DEFAULT_LIMIT
+	}
+
+	suggestions, err := th.taxonomySvc.Autocomplete(ctx, kind, c.Query("q"), limit)
+	if err != nil {
+		log.Printf("taxonomy autocomplete error:\n%+v", err)
+		return fiber.NewError(http.StatusInternalServerError, "Error while finding suggestions")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"suggestions": suggestions,
+	})
+}
+
+func (th *TaxonomyHandler) FindTerm(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	kind, err := taxonomyKind(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := taxonomyID(c)
+	if err != nil {
+		return err
+	}
+
+	term, err := th.taxonomySvc.FindTerm(ctx, kind, id)
+	if err != nil {
+		log.Printf("find taxonomy term error:\n%+v", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(http.StatusNotFound, "term is not found")
+		}
+		return fiber.NewError(http.StatusInternalServerError, "Error while finding term")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"term": term,
+	})
+}
+
+func (th *TaxonomyHandler) CreateTerm(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 2*time.Second)
+	defer cancel()
+
+	kind, err := taxonomyKind(c)
+	if err != nil {
+		return err
+	}
+
+	data := new(types.CreateTaxonomyTermDTO)
+	if err := c.BodyParser(data); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid data")
+	}
+
+	err = th.validate.Struct(data)
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{
+			"errors": util.CustomValidationErrors(err),
+		})
+	}
+
+	term, err := th.taxonomySvc.CreateTerm(ctx, kind, data)
+	if err != nil {
+		log.Printf("create taxonomy term error:\n%+v", err)
+		if errors.Is(err, svc.ErrInvalidTaxonomyName) {
+			return fiber.NewError(http.StatusBadRequest, err.Error())
+		}
+		if errors.Is(err, svc.ErrDuplicateTaxonomyName) {
+			return fiber.NewError(http.StatusConflict, err.Error())
+		}
+		return fiber.NewError(http.StatusInternalServerError, "Error while creating term")
+	}
+
+	return c.Status(http.StatusCreated).JSON(fiber.Map{
+		"term": term,
+	})
+}
+
+func (th *TaxonomyHandler) AddAlias(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	kind, err := taxonomyKind(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := taxonomyID(c)
+	if err != nil {
+		return err
+	}
+
+	data := new(types.TaxonomyAliasDTO)
+	if err := c.BodyParser(data); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid data")
+	}
+
+	err = th.validate.Struct(data)
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{
+			"errors": util.CustomValidationErrors(err),
+		})
+	}
+
+	err = th.taxonomySvc.AddAlias(ctx, kind, id, data.Alias)
+	if err != nil {
+		log.Printf("add taxonomy alias error:\n%+v", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(http.StatusNotFound, "term is not found")
+		}
+		if errors.Is(err, svc.ErrInvalidTaxonomyName) {
+			return fiber.NewError(http.StatusBadRequest, err.Error())
+		}
+		if errors.Is(err, svc.ErrDuplicateTaxonomyName) {
+			return fiber.NewError(http.StatusConflict, err.Error())
+		}
+		return fiber.NewError(http.StatusInternalServerError, "Error while adding alias")
+	}
+
+	return c.Status(http.StatusCreated).SendString("alias added")
+}
+
+func (th *TaxonomyHandler) RemoveAlias(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	kind, err := taxonomyKind(c)
+	if err != nil {
+		return err
+	}
+
+	id, err := taxonomyID(c)
+	if err != nil {
+		return err
+	}
+
+	alias, err := url.PathUnescape(c.Params("alias"))
+	if err != nil {
+		return fiber.NewError(http.StatusNotFound, "alias is not found")
+	}
+
+	err = th.taxonomySvc.RemoveAlias(ctx, kind, id, alias)
+	if err != nil {
+		log.Printf("remove taxonomy alias error:\n%+v", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(http.StatusNotFound, "alias is not found")
+		}
+		return fiber.NewError(http.StatusInternalServerError, "Error while removing alias")
+	}
+
+	return c.Status(http.StatusOK).SendString("alias removed")
+}
+
+func (th *TaxonomyHandler) Merge(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 5*time.Second)
+	defer cancel()
+
+	kind, err := taxonomyKind(c)
+	if err != nil {
+		return err
+	}
+
+	data := new(types.MergeTaxonomyDTO)
+	if err := c.BodyParser(data); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid data")
+	}
+
+	err = th.validate.Struct(data)
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{
+			"errors": util.CustomValidationErrors(err),
+		})
+	}
+
+	moved, err := th.taxonomySvc.Merge(ctx, kind, data.SourceIDs, data.TargetID)
+	if err != nil {
+		log.Printf("merge taxonomy error:\n%+v", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(http.StatusNotFound, "one of the terms is not found")
+		}
+		if errors.Is(err, svc.ErrInvalidMerge) {
+			return fiber.NewError(http.StatusBadRequest, err.Error())
+		}
+		return fiber.NewError(http.StatusInternalServerError, "Error while merging terms")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"movedSellers": moved,
+	})
+}
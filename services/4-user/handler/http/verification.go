@@ -0,0 +1,326 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Akihira77/gojobber/services/4-user/helper"
+	svc "github.com/Akihira77/gojobber/services/4-user/service"
+	"github.com/Akihira77/gojobber/services/4-user/types"
+	"github.com/Akihira77/gojobber/services/4-user/util"
+	"github.com/go-playground/validator/v10"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// verificationContentTypes are sniffed from the file, the extension and the
+// multipart header are not trusted.
+var verificationContentTypes = map[string]bool{
+	"image/jpeg":      true,
+	"image/png":       true,
+	"application/pdf": true,
+}
+
+type VerificationHandler struct {
+	verificationSvc svc.VerificationServiceImpl
+	sellerSvc       svc.SellerServiceImpl
+	storage         helper.DocumentStorage
+	validate        *validator.Validate
+}
+
+func NewVerificationHandler(verificationSvc svc.VerificationServiceImpl, sellerSvc svc.SellerServiceImpl, storage helper.DocumentStorage) *VerificationHandler {
+	return &VerificationHandler{
+		verificationSvc: verificationSvc,
+		sellerSvc:       sellerSvc,
+		storage:         storage,
+		validate:        validator.New(validator.WithRequiredStructEnabled()),
+	}
+}
+
+func (vh *VerificationHandler) GetMyVerification(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	seller, err := findMySeller(c, ctx, vh.sellerSvc)
+	if err != nil {
+		return err
+	}
+
+	verification, err := vh.verificationSvc.FindMine(ctx, seller)
+	if err != nil {
+		log.Printf("get my verification error:\n%+v", err)
+		return fiber.NewError(http.StatusInternalServerError, "Error while finding verification")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"verification": verification,
+	})
+}
+
+// Submit takes the "front" and "selfie" files of the multipart form, plus
+// "back" for two-sided documents.
+func (vh *VerificationHandler) Submit(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 30*time.Second)
+	defer cancel()
+
+	seller, err := findMySeller(c, ctx, vh.sellerSvc)
+	if err != nil {
+		return err
+	}
+
+	if seller.VerificationStatus != types.VERIFICATION_UNVERIFIED && seller.VerificationStatus != types.VERIFICATION_REJECTED {
+		return fiber.NewError(http.StatusConflict, svc.ErrVerificationNotAllowed.Error())
+	}
+
+	data := new(types.SubmitVerificationDTO)
+	if err := c.BodyParser(data); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid data")
+	}
+
+	err = vh.validate.Struct(data)
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{
+			"errors": util.CustomValidationErrors(err),
+		})
+	}
+
+	form, err := c.MultipartForm()
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, "documents are required")
+	}
+
+	sides := []types.VerificationDocumentSide{types.VERIFICATION_DOCUMENT_FRONT, types.VERIFICATION_DOCUMENT_SELFIE}
+	if data.DocumentType != types.IDENTITY_DOCUMENT_PASSPORT {
+		sides = append(sides, types.VERIFICATION_DOCUMENT_BACK)
+	}
+
+	files := make(map[types.VerificationDocumentSide]*multipart.FileHeader, len(sides))
+	for _, side := range sides {
+		name := strings.ToLower(string(side))
+		fhs := form.File[name]
+		if len(fhs) != 1 {
+			return fiber.NewError(http.StatusBadRequest, fmt.Sprintf("exactly one %q file is required", name))
+		}
+		if fhs[0].Size > types.MAX_VERIFICATION_DOCUMENT_SIZE {
+			return fiber.NewError(http.StatusBadRequest, fmt.Sprintf("%q file is larger than 2MB", name))
+		}
+		files[side] = fhs[0]
+	}
+
+	documents, err := vh.saveDocuments(ctx, seller.ID, sides, files)
+	if err != nil {
+		return err
+	}
+
+	verification, err := vh.verificationSvc.Submit(ctx, seller.ID, data.DocumentType, documents)
+	if err != nil {
+		vh.deleteDocuments(documents)
+		log.Printf("submit verification error:\n%+v", err)
+		if errors.Is(err, svc.ErrVerificationNotAllowed) {
+			return fiber.NewError(http.StatusConflict, err.Error())
+		}
+		return fiber.NewError(http.StatusInternalServerError, "Error while submitting verification")
+	}
+
+	return c.Status(http.StatusCreated).JSON(fiber.Map{
+		"verification": verification,
+	})
+}
+
+// saveDocuments stores the files in order. When one of them fails the ones
+// already stored are removed again.
+func (vh *VerificationHandler) saveDocuments(ctx context.Context, sellerID string, sides []types.VerificationDocumentSide, files map[types.VerificationDocumentSide]*multipart.FileHeader) ([]types.VerificationDocument, error) {
+	var documents []types.VerificationDocument
+	for _, side := range sides {
+		fh := files[side]
+		file, err := fh.Open()
+		if err != nil {
+			vh.deleteDocuments(documents)
+			log.Printf("save verification document error:\n%+v", err)
+			return nil, fiber.NewError(http.StatusBadRequest, "failed reading document file")
+		}
+
+		head := make([]byte, 512)
+		n, _ := io.ReadFull(file, head)
+		contentType := http.DetectContentType(head[:n])
+		if !verificationContentTypes[contentType] {
+			file.Close()
+			vh.deleteDocuments(documents)
+			return nil, fiber.NewError(http.StatusBadRequest, "documents must be JPEG, PNG or PDF files")
+		}
+
+		key := fmt.Sprintf("%s/%s", sellerID, util.RandomStr(32))
+		key, err = vh.storage.Save(ctx, io.MultiReader(bytes.NewReader(head[:n]), file), key)
+		file.Close()
+		if err != nil {
+			vh.deleteDocuments(documents)
+			log.Printf("save verification document error:\n%+v", err)
+			return nil, fiber.NewError(http.StatusInternalServerError, "failed storing document")
+		}
+
+		documents = append(documents, types.VerificationDocument{
+			Side:        side,
+			StorageKey:  key,
+			ContentType: contentType,
+			Size:        fh.Size,
+		})
+	}
+
+	return documents, nil
+}
+
+// deleteDocuments removes stored documents in the background, a leftover
+// file does not fail the request.
+func (vh *VerificationHandler) deleteDocuments(documents []types.VerificationDocument) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		for _, d := range documents {
+			if err := vh.storage.Delete(ctx, d.StorageKey); err != nil {
+				log.Printf("delete verification document [%s] error:\n%+v", d.StorageKey, err)
+			}
+		}
+	}()
+}
+
+func (vh *VerificationHandler) FindQueue(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	status := types.VerificationStatus(strings.ToUpper(c.Params("status")))
+	if status != types.VERIFICATION_PENDING && status != types.VERIFICATION_VERIFIED && status != types.VERIFICATION_REJECTED {
+		return fiber.NewError(http.StatusBadRequest, "invalid verification status")
+	}
+
+	page, err := strconv.Atoi(c.Params("page", "1"))
+	if err != nil || page < 1 {
+		return fiber.NewError(http.StatusBadRequest, "invalid page number")
+	}
+
+	size, err := strconv.Atoi(c.Params("size", "10"))
+	if err != nil || size < 1 {
+		return fiber.NewError(http.StatusBadRequest, "invalid page size")
+	}
+
+	verifications, total, err := vh.verificationSvc.FindQueue(ctx, status, page, size)
+	if err != nil {
+		log.Printf("find verification queue error:\n%+v", err)
+		return fiber.NewError(http.StatusInternalServerError, "Error while finding verifications")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"total":         total,
+		"verifications": verifications,
+	})
+}
+
+func (vh *VerificationHandler) FindByID(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	id := c.Params("id")
+	if _, err := uuid.Parse(id); err != nil {
+		return fiber.NewError(http.StatusNotFound, "verification is not found")
+	}
+
+	verification, err := vh.verificationSvc.FindByID(ctx, id)
+	if err != nil {
+		log.Printf("find verification error:\n%+v", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(http.StatusNotFound, "verification is not found")
+		}
+		return fiber.NewError(http.StatusInternalServerError, "Error while finding verification")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"verification": verification,
+	})
+}
+
+func (vh *VerificationHandler) FindDocument(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 10*time.Second)
+	defer cancel()
+
+	id := c.Params("id")
+	documentID := c.Params("documentId")
+	if _, err := uuid.Parse(id); err != nil {
+		return fiber.NewError(http.StatusNotFound, "document is not found")
+	}
+	if _, err := uuid.Parse(documentID); err != nil {
+		return fiber.NewError(http.StatusNotFound, "document is not found")
+	}
+
+	document, err := vh.verificationSvc.FindDocument(ctx, id, documentID)
+	if err != nil {
+		log.Printf("find verification document error:\n%+v", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(http.StatusNotFound, "document is not found")
+		}
+		return fiber.NewError(http.StatusInternalServerError, "Error while finding document")
+	}
+
+	content, err := vh.storage.Open(ctx, document.StorageKey)
+	if err != nil {
+		log.Printf("open verification document error:\n%+v", err)
+		return fiber.NewError(http.StatusInternalServerError, "Error while reading document")
+	}
+
+	c.Set(fiber.HeaderContentType, document.ContentType)
+	c.Set(fiber.HeaderCacheControl, "no-store")
+	return c.Status(http.StatusOK).Send(content)
+}
+
+func (vh *VerificationHandler) Review(c *fiber.Ctx) error {
+	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
+	defer cancel()
+
+	userInfo, ok := c.UserContext().Value("current_user").(*types.JWTClaims)
+	if !ok {
+		log.Println("invalid userInfo", userInfo)
+		return fiber.NewError(http.StatusUnauthorized, "sign-in first")
+	}
+
+	id := c.Params("id")
+	if _, err := uuid.Parse(id); err != nil {
+		return fiber.NewError(http.StatusNotFound, "verification is not found")
+	}
+
+	data := new(types.ReviewVerificationDTO)
+	if err := c.BodyParser(data); err != nil {
+		return fiber.NewError(http.StatusBadRequest, "invalid data")
+	}
+
+	err := vh.validate.Struct(data)
+	if err != nil {
+		return c.Status(http.StatusBadRequest).JSON(fiber.Map{
+			"errors": util.CustomValidationErrors(err),
+		})
+	}
+
+	verification, err := vh.verificationSvc.Review(ctx, id, userInfo.UserID, data)
+	if err != nil {
+		log.Printf("review verification error:\n%+v", err)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return fiber.NewError(http.StatusNotFound, "verification is not found")
+		}
+		if errors.Is(err, svc.ErrVerificationAlreadyReviewed) {
+			return fiber.NewError(http.StatusConflict, err.Error())
+		}
+		return fiber.NewError(http.StatusInternalServerError, "Error while reviewing verification")
+	}
+
+	return c.Status(http.StatusOK).JSON(fiber.Map{
+		"verification": verification,
+	})
+}
@@ -0,0 +1,166 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Akihira77/gojobber/services/4-user/util"
+)
+
+const (
+	DOCUMENT_STORAGE_CLOUDINARY = "cloudinary"
+	DOCUMENT_STORAGE_LOCAL      = "local"
+)
+
+// DocumentStorage keeps private files such as identity documents. Save
+// returns the key Open and Delete take.
+type DocumentStorage interface {
+	Name() string
+	Save(ctx context.Context, file io.Reader, key string) (string, error)
+	Open(ctx context.Context, key string) ([]byte, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// NewDocumentStorageFromEnv picks the storage from DOCUMENT_STORAGE.
+// Anything other than "local" falls back to Cloudinary.
+func NewDocumentStorageFromEnv(cld *util.Cloudinary) DocumentStorage {
+	if os.Getenv("DOCUMENT_STORAGE") == DOCUMENT_STORAGE_LOCAL {
+		dir := os.Getenv("DOCUMENT_STORAGE_DIR")
+		if dir == "" {
+			dir = "storage/documents"
+		}
+		return NewLocalDocumentStorage(dir)
+	}
+
+	return NewCloudinaryDocumentStorage(cld)
+}
+
+type CloudinaryDocumentStorage struct {
+	cld    *util.Cloudinary
+	client *http.Client
+}
+
+func NewCloudinaryDocumentStorage(cld *util.Cloudinary) *CloudinaryDocumentStorage {
+	return &CloudinaryDocumentStorage{
+		cld:    cld,
+		client: &http.Client{},
+	}
+}
+
+func (cs *CloudinaryDocumentStorage) Name() string {
+	return DOCUMENT_STORAGE_CLOUDINARY
+}
+
+func (cs *CloudinaryDocumentStorage) Save(ctx context.Context, file io.Reader, key string) (string, error) {
+	result, err := cs.cld.UploadPrivateDocument(ctx, file, key)
+	if err != nil {
+		return "", err
+	}
+
+	return result.PublicID, nil
+}
+
+// Open downloads the document through a signed URL, the URL itself is never
+// handed out.
+func (cs *CloudinaryDocumentStorage) Open(ctx context.Context, key string) ([]byte, error) {
+	url, err := cs.cld.SignedDocumentURL(key)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := cs.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("cloudinary responded %d for document %s", res.StatusCode, key)
+	}
+
+	return io.ReadAll(res.Body)
+}
+
+func (cs *CloudinaryDocumentStorage) Delete(ctx context.Context, key string) error {
+	return cs.cld.DestroyPrivateDocument(ctx, key)
+}
+
+// INFO: DISK STORAGE FOR LOCAL SETUPS, KEYS ARE PATHS RELATIVE TO dir
+type LocalDocumentStorage struct {
+	dir string
+}
+
+func NewLocalDocumentStorage(dir string) *LocalDocumentStorage {
+	return &LocalDocumentStorage{
+		dir: dir,
+	}
+}
+
+func (ls *LocalDocumentStorage) Name() string {
+	return DOCUMENT_STORAGE_LOCAL
+}
+
+func (ls *LocalDocumentStorage) path(key string) (string, error) {
+	p := filepath.Join(ls.dir, filepath.FromSlash(key))
+	if !strings.HasPrefix(p, filepath.Clean(ls.dir)+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid document key %q", key)
+	}
+
+	return p, nil
+}
+
+func (ls *LocalDocumentStorage) Save(ctx context.Context, file io.Reader, key string) (string, error) {
+	p, err := ls.path(key)
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(p), 0o700); err != nil {
+		return "", err
+	}
+
+	f, err := os.OpenFile(p, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := io.Copy(f, file); err != nil {
+		f.Close()
+		os.Remove(p)
+		return "", err
+	}
+
+	return key, f.Close()
+}
+
+func (ls *LocalDocumentStorage) Open(ctx context.Context, key string) ([]byte, error) {
+	p, err := ls.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return os.ReadFile(p)
+}
+
+func (ls *LocalDocumentStorage) Delete(ctx context.Context, key string) error {
+	p, err := ls.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(p)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
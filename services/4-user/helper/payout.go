@@ -0,0 +1,149 @@
+package helper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/stripe/stripe-go/v80"
+	"github.com/stripe/stripe-go/v80/transfer"
+)
+
+const (
+	PAYOUT_PROVIDER_STRIPE = "stripe"
+	PAYOUT_PROVIDER_FAKE   = "fake"
+)
+
+// ErrPayoutRejected means retrying will not help, e.g. the destination
+// account can not receive transfers. Any other error is retried.
+var ErrPayoutRejected = errors.New("payout rejected")
+
+type PayoutRequest struct {
+	PayoutID    string
+	Destination string
+	// NOTE: IN WHOLE CURRENCY UNITS, THE SAME AS Seller.AccountBalance
+	Amount   uint64
+	Currency string
+}
+
+// PayoutProvider moves money to the seller. Transfer must be idempotent on
+// PayoutID, a payout whose worker died mid-way is sent again.
+type PayoutProvider interface {
+	Name() string
+	Transfer(ctx context.Context, req PayoutRequest) (string, error)
+}
+
+// NewPayoutProviderFromEnv picks the provider from PAYOUT_PROVIDER, any value
+// other than "stripe" or "fake" is an error. Deployments from before
+// PAYOUT_PROVIDER only set STRIPE_SECRET_KEY, so an unset PAYOUT_PROVIDER
+// still means Stripe, which can not run without STRIPE_SECRET_KEY.
+func NewPayoutProviderFromEnv() (PayoutProvider, error) {
+	provider := os.Getenv("PAYOUT_PROVIDER")
+	if provider == "" {
+		provider = PAYOUT_PROVIDER_STRIPE
+	}
+	log.Printf("Using [%s] payout provider", provider)
+
+	switch provider {
+	case PAYOUT_PROVIDER_FAKE:
+		return NewFakePayoutProvider(), nil
+	case PAYOUT_PROVIDER_STRIPE:
+		key := os.Getenv("STRIPE_SECRET_KEY")
+		if key == "" {
+			return nil, fmt.Errorf("STRIPE_SECRET_KEY is required by the [%s] payout provider", PAYOUT_PROVIDER_STRIPE)
+		}
+		return NewStripePayoutProvider(key), nil
+	default:
+		return nil, fmt.Errorf("invalid PAYOUT_PROVIDER [%s], must be %s or %s", provider, PAYOUT_PROVIDER_STRIPE, PAYOUT_PROVIDER_FAKE)
+	}
+}
+
+// INFO: STRIPE CONNECT TRANSFER FROM THE PLATFORM TO THE SELLER'S CONNECTED ACCOUNT
+type StripePayoutProvider struct {
+	client transfer.Client
+}
+
+func NewStripePayoutProvider(key string) *StripePayoutProvider {
+	return &StripePayoutProvider{
+		client: transfer.Client{
+			B:   stripe.GetBackend(stripe.APIBackend),
+			Key: key,
+		},
+	}
+}
+
+func (sp *StripePayoutProvider) Name() string {
+	return PAYOUT_PROVIDER_STRIPE
+}
+
+func (sp *StripePayoutProvider) Transfer(ctx context.Context, req PayoutRequest) (string, error) {
+	if req.Destination == "" {
+		return "", fmt.Errorf("%w: seller has no connected stripe account", ErrPayoutRejected)
+	}
+
+	params := &stripe.TransferParams{
+		Amount:        stripe.Int64(int64(req.Amount * 100)),
+		Currency:      stripe.String(req.Currency),
+		Destination:   stripe.String(req.Destination),
+		TransferGroup: stripe.String(req.PayoutID),
+	}
+	params.Context = ctx
+	params.SetIdempotencyKey("payout-" + req.PayoutID)
+	params.AddMetadata("payout_id", req.PayoutID)
+
+	tr, err := sp.client.New(params)
+	if err != nil {
+		var se *stripe.Error
+		if errors.As(err, &se) && se.HTTPStatusCode >= http.StatusBadRequest && se.HTTPStatusCode < http.StatusInternalServerError && se.HTTPStatusCode != http.StatusTooManyRequests {
+			return "", fmt.Errorf("%w: %s", ErrPayoutRejected, se.Msg)
+		}
+		return "", err
+	}
+
+	return tr.ID, nil
+}
+
+// INFO: IN-MEMORY PROVIDER FOR LOCAL SETUPS AND TESTS, SET FailWith TO
+// SIMULATE A PROVIDER ERROR
+type FakePayoutProvider struct {
+	mutex     sync.RWMutex
+	transfers map[string]PayoutRequest
+	FailWith  error
+}
+
+func NewFakePayoutProvider() *FakePayoutProvider {
+	return &FakePayoutProvider{
+		transfers: make(map[string]PayoutRequest),
+	}
+}
+
+func (fp *FakePayoutProvider) Name() string {
+	return PAYOUT_PROVIDER_FAKE
+}
+
+func (fp *FakePayoutProvider) Transfer(ctx context.Context, req PayoutRequest) (string, error) {
+	fp.mutex.Lock()
+	defer fp.mutex.Unlock()
+
+	if fp.FailWith != nil {
+		return "", fp.FailWith
+	}
+
+	fp.transfers[req.PayoutID] = req
+	return "fake_tr_" + req.PayoutID, nil
+}
+
+func (fp *FakePayoutProvider) Transfers() []PayoutRequest {
+	fp.mutex.RLock()
+	defer fp.mutex.RUnlock()
+
+	transfers := make([]PayoutRequest, 0, len(fp.transfers))
+	for _, t := range fp.transfers {
+		transfers = append(transfers, t)
+	}
+	return transfers
+}
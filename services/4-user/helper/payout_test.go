@@ -0,0 +1,43 @@
+package helper
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestNewPayoutProviderFromEnv(t *testing.T) {
+	tests := []struct {
+		name      string
+		provider  string
+		stripeKey string
+		want      string
+	}{
+		{name: "unset", provider: "", stripeKey: "sk_test", want: "*helper.StripePayoutProvider"},
+		{name: "unset without key", provider: ""},
+		{name: "stripe", provider: PAYOUT_PROVIDER_STRIPE, stripeKey: "sk_test", want: "*helper.StripePayoutProvider"},
+		{name: "stripe without key", provider: PAYOUT_PROVIDER_STRIPE},
+		{name: "fake", provider: PAYOUT_PROVIDER_FAKE, want: "*helper.FakePayoutProvider"},
+		{name: "unknown", provider: "fkae", stripeKey: "sk_test"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("PAYOUT_PROVIDER", tt.provider)
+			t.Setenv("STRIPE_SECRET_KEY", tt.stripeKey)
+
+			provider, err := NewPayoutProviderFromEnv()
+			if tt.want == "" {
+				if err == nil {
+					t.Fatalf("got %T, want an error", provider)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := fmt.Sprintf("%T", provider); got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,9 +1,23 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"log"
 	"os"
+	"time"
 
+	"github.com/Akihira77/gojobber/services/4-user/handler"
+	"github.com/Akihira77/gojobber/services/4-user/helper"
+	"github.com/Akihira77/gojobber/services/4-user/service"
+	"github.com/Akihira77/gojobber/services/4-user/types"
+	"github.com/Akihira77/gojobber/services/4-user/util"
+	"github.com/Akihira77/gojobber/services/common/genproto/auth"
+	"github.com/Akihira77/gojobber/services/common/genproto/chat"
+	"github.com/Akihira77/gojobber/services/common/genproto/gig"
+	"github.com/Akihira77/gojobber/services/common/genproto/notification"
+	"github.com/Akihira77/gojobber/services/common/genproto/order"
+	"github.com/Akihira77/gojobber/services/common/genproto/review"
 	"github.com/joho/godotenv"
 )
 
@@ -17,6 +31,7 @@ func main() {
 
 	db.Debug().Exec(`CREATE EXTENSION IF NOT EXISTS tsm_system_rows;`)
 	db.Debug().Exec(`CREATE EXTENSION IF NOT EXISTS "uuid-ossp";`)
+	db.Debug().Exec(`CREATE EXTENSION IF NOT EXISTS "pg_trgm";`)
 	// err = db.
 	// 	Debug().
 	// 	Migrator().
@@ -51,10 +66,184 @@ func main() {
 	// 	log.Fatal(err)
 	// }
 
-	go NewHttpServer(db)
+	err = db.
+		Debug().
+		AutoMigrate(
+			&types.Payout{},
+			&types.LedgerTransaction{},
+			&types.LedgerEntry{},
+			&types.EarningClearance{},
+			&types.Collection{},
+			&types.CollectionItem{},
+			&types.PortfolioItem{},
+			&types.PortfolioMedia{},
+			&types.TaxonomyAlias{},
+			&types.DataExport{},
+			&types.UserBlock{},
+			&types.UserReport{},
+			&types.SellerVerification{},
+			&types.VerificationDocument{},
+		)
+	if err != nil {
+		log.Fatal("Error migrating tables", err)
+	}
+
+	for _, column := range []string{"PendingBalance", "CreatedAt", "Level", "VacationStart", "VacationEnd", "VacationMessage", "VerificationStatus"} {
+		if db.Migrator().HasColumn(&types.Seller{}, column) {
+			continue
+		}
+
+		err = db.
+			Debug().
+			Migrator().
+			AddColumn(&types.Seller{}, column)
+		if err != nil {
+			log.Fatalf("Error adding sellers %s column %v", column, err)
+		}
+	}
+
+	for _, index := range []string{"Level", "VerificationStatus"} {
+		if db.Migrator().HasIndex(&types.Seller{}, index) {
+			continue
+		}
+
+		err = db.
+			Debug().
+			Migrator().
+			CreateIndex(&types.Seller{}, index)
+		if err != nil {
+			log.Fatalf("Error creating sellers %s index %v", index, err)
+		}
+	}
+
+	for _, model := range []interface{}{&types.Skill{}, &types.Language{}} {
+		if db.Migrator().HasColumn(model, "Slug") {
+			continue
+		}
+
+		err = db.
+			Debug().
+			Migrator().
+			AddColumn(model, "Slug")
+		if err != nil {
+			log.Fatal("Error adding taxonomy slug column", err)
+		}
+	}
+
+	//INFO: TERMS FROM BEFORE THE TAXONOMY ARE NORMALIZED, DUPLICATES ARE MERGED
+	normalized, err := service.NewTaxonomyService(db).BackfillSlugs(context.Background())
+	if err != nil {
+		log.Fatal("Error backfilling taxonomy slugs", err)
+	}
+	log.Printf("taxonomy slugs backfilled for [%d] terms", normalized)
+
+	for _, model := range []interface{}{&types.Skill{}, &types.Language{}} {
+		if db.Migrator().HasIndex(model, "Slug") {
+			continue
+		}
+
+		err = db.
+			Debug().
+			Migrator().
+			CreateIndex(model, "Slug")
+		if err != nil {
+			log.Fatal("Error creating taxonomy slug index", err)
+		}
+	}
+	db.Debug().Exec(`CREATE INDEX IF NOT EXISTS idx_skills_slug_trgm ON skills USING GIN (slug gin_trgm_ops);`)
+	db.Debug().Exec(`CREATE INDEX IF NOT EXISTS idx_languages_slug_trgm ON languages USING GIN (slug gin_trgm_ops);`)
+	db.Debug().Exec(`CREATE INDEX IF NOT EXISTS idx_taxonomy_aliases_alias_trgm ON taxonomy_aliases USING GIN (alias gin_trgm_ops);`)
+	db.Debug().Exec(`CREATE UNIQUE INDEX IF NOT EXISTS idx_data_exports_in_progress ON data_exports (buyer_id) WHERE status IN ('PENDING', 'PROCESSING');`)
+	db.Debug().Exec(`CREATE UNIQUE INDEX IF NOT EXISTS idx_user_reports_open ON user_reports (reporter_id, reported_id) WHERE status = 'OPEN';`)
+
+	//INFO: BALANCES FROM BEFORE THE LEDGER BECOME OPENING ADJUSTMENTS
+	opened, err := service.NewLedgerService(db).BackfillOpeningBalances(context.Background())
+	if err != nil {
+		log.Fatal("Error backfilling ledger opening balances", err)
+	}
+	log.Printf("ledger opening balances backfilled for [%d] sellers", opened)
+
+	ccs := handler.NewGRPCClients()
+	defer ccs.CloseAll()
+	var notificationClient notification.NotificationServiceClient
+	if err = ccs.AddClient(types.NOTIFICATION_SERVICE, os.Getenv("NOTIFICATION_GRPC_PORT")); err != nil {
+		log.Println("Error connecting to notification grpc server, payouts are not notified", err)
+	} else {
+		cc, _ := ccs.GetClient(types.NOTIFICATION_SERVICE)
+		notificationClient = notification.NewNotificationServiceClient(cc)
+	}
+
+	payoutProvider, err := helper.NewPayoutProviderFromEnv()
+	if err != nil {
+		log.Fatal("Error configuring payout provider", err)
+	}
+	payoutWorker := service.NewPayoutWorker(service.NewPayoutService(db), service.NewSellerService(db), payoutProvider, notificationClient, service.PayoutWorkerConfig{
+		Workers:      util.GetEnvInt("PAYOUT_WORKERS", 2),
+		BatchSize:    util.GetEnvInt("PAYOUT_BATCH_SIZE", 10),
+		MaxAttempts:  util.GetEnvInt("PAYOUT_MAX_ATTEMPTS", 5),
+		PollInterval: util.GetEnvDuration("PAYOUT_POLL_INTERVAL", 5*time.Second),
+		BaseBackoff:  util.GetEnvDuration("PAYOUT_BASE_BACKOFF", 1*time.Minute),
+		MaxBackoff:   util.GetEnvDuration("PAYOUT_MAX_BACKOFF", 1*time.Hour),
+		LockDuration: util.GetEnvDuration("PAYOUT_LOCK_DURATION", 5*time.Minute),
+	})
+	go payoutWorker.Run(context.Background())
+
+	clearanceWorker := service.NewClearanceWorker(service.NewLedgerService(db), service.ClearanceWorkerConfig{
+		BatchSize:    util.GetEnvInt("EARNINGS_CLEARANCE_BATCH_SIZE", 100),
+		PollInterval: util.GetEnvDuration("EARNINGS_CLEARANCE_POLL_INTERVAL", 1*time.Hour),
+	})
+	go clearanceWorker.Run(context.Background())
+
+	//INFO: LEVELS NEED BOTH THE ORDER AND REVIEW SERVICES, WITHOUT THEM THE LEVELS STAY AS THEY ARE
+	orderErr := ccs.AddClient(types.ORDER_SERVICE, os.Getenv("ORDER_GRPC_PORT"))
+	reviewErr := ccs.AddClient(types.REVIEW_SERVICE, os.Getenv("REVIEW_GRPC_PORT"))
+	if orderErr != nil || reviewErr != nil {
+		log.Println("Error connecting to order or review grpc server, seller levels are not recomputed", orderErr, reviewErr)
+	} else {
+		orderCC, _ := ccs.GetClient(types.ORDER_SERVICE)
+		reviewCC, _ := ccs.GetClient(types.REVIEW_SERVICE)
+		sellerLevelSvc := service.NewSellerLevelService(db, order.NewOrderServiceClient(orderCC), review.NewReviewServiceClient(reviewCC))
+		sellerLevelWorker := service.NewSellerLevelWorker(sellerLevelSvc, service.SellerLevelWorkerConfig{
+			BatchSize:    util.GetEnvInt("SELLER_LEVEL_BATCH_SIZE", 100),
+			PollInterval: util.GetEnvDuration("SELLER_LEVEL_POLL_INTERVAL", 24*time.Hour),
+		})
+		go sellerLevelWorker.Run(context.Background())
+	}
+
+	//INFO: EXPORTS NEED EVERY SERVICE THAT HOLDS PERSONAL DATA, A PARTIAL ARCHIVE IS NEVER HANDED OUT
+	authErr := ccs.AddClient(types.AUTH_SERVICE, os.Getenv("AUTH_GRPC_PORT"))
+	gigErr := ccs.AddClient(types.GIG_SERVICE, os.Getenv("GIG_GRPC_PORT"))
+	chatErr := ccs.AddClient(types.CHAT_SERVICE, os.Getenv("CHAT_GRPC_PORT"))
+	if err = errors.Join(authErr, gigErr, chatErr, orderErr, reviewErr); err != nil {
+		log.Println("Error connecting to auth, gig, chat, order or review grpc server, data exports are not processed", err)
+	} else {
+		authCC, _ := ccs.GetClient(types.AUTH_SERVICE)
+		gigCC, _ := ccs.GetClient(types.GIG_SERVICE)
+		chatCC, _ := ccs.GetClient(types.CHAT_SERVICE)
+		orderCC, _ := ccs.GetClient(types.ORDER_SERVICE)
+		reviewCC, _ := ccs.GetClient(types.REVIEW_SERVICE)
+		dataExportWorker := service.NewDataExportWorker(service.NewDataExportService(db), service.NewBuyerService(db), service.NewSellerService(db), service.DataExportSources{
+			Auth:   auth.NewAuthServiceClient(authCC),
+			Gig:    gig.NewGigServiceClient(gigCC),
+			Chat:   chat.NewChatServiceClient(chatCC),
+			Order:  order.NewOrderServiceClient(orderCC),
+			Review: review.NewReviewServiceClient(reviewCC),
+		}, notificationClient, service.DataExportWorkerConfig{
+			BatchSize:    util.GetEnvInt("DATA_EXPORT_BATCH_SIZE", 5),
+			MaxAttempts:  util.GetEnvInt("DATA_EXPORT_MAX_ATTEMPTS", 3),
+			PollInterval: util.GetEnvDuration("DATA_EXPORT_POLL_INTERVAL", 30*time.Second),
+			LockDuration: util.GetEnvDuration("DATA_EXPORT_LOCK_DURATION", 10*time.Minute),
+			LinkTTL:      util.GetEnvDuration("DATA_EXPORT_LINK_TTL", 72*time.Hour),
+		})
+		go dataExportWorker.Run(context.Background())
+	}
+
+	cld := util.NewCloudinary()
+	go NewHttpServer(db, cld, payoutProvider.Name())
 
 	grpcServer := NewGRPCServer(os.Getenv("USER_GRPC_PORT"))
-	err = grpcServer.Run(db)
+	clearancePeriod := time.Duration(util.GetEnvInt("EARNINGS_CLEARANCE_DAYS", 14)) * 24 * time.Hour
+	err = grpcServer.Run(db, clearancePeriod)
 	if err != nil {
 		log.Fatal("Error listen GRPC")
 	}
@@ -6,9 +6,11 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"slices"
 	"strings"
 
 	"github.com/Akihira77/gojobber/services/4-user/handler/http"
+	"github.com/Akihira77/gojobber/services/4-user/helper"
 	"github.com/Akihira77/gojobber/services/4-user/service"
 	"github.com/Akihira77/gojobber/services/4-user/types"
 	"github.com/Akihira77/gojobber/services/4-user/util"
@@ -21,7 +23,7 @@ const (
 	BASE_PATH = "/api/v1/users"
 )
 
-func MainRouter(db *gorm.DB, app *fiber.App) {
+func MainRouter(db *gorm.DB, cld *util.Cloudinary, app *fiber.App, payoutProvider string) {
 	app.Get("/health-check", func(c *fiber.Ctx) error {
 		return c.Status(http.StatusOK).SendString("User Service is healthy and OK.")
 	})
@@ -44,12 +46,99 @@ func MainRouter(db *gorm.DB, app *fiber.App) {
 	api.Get("/sellers/id/:id", sh.FindSellerByID)
 	api.Get("/sellers/username/:username", sh.FindSellerByUsername)
 	api.Get("/sellers/random/:count", sh.GetRandomSellers)
+	api.Get("/sellers/search/:page/:size", sh.SearchSellers)
 	api.Post("/sellers", sh.Create)
 	api.Put("/sellers", sh.Update)
+	api.Get("/sellers/vacation", sh.GetMyVacation)
+	api.Put("/sellers/vacation", sh.SetVacation)
+	api.Delete("/sellers/vacation", sh.EndVacation)
+
+	vs := service.NewVerificationService(db)
+	vh := handler.NewVerificationHandler(vs, ss, helper.NewDocumentStorageFromEnv(cld))
+
+	api.Get("/sellers/verification", vh.GetMyVerification)
+	api.Post("/sellers/verification", vh.Submit)
 	// api.Delete("/sellers/connect/:id", sh.DeleteStripeConnectAccount)
 
-	//TODO: IMPLEMENT BALANCE RELATED STUFF
-	api.Post("/sellers/balance/withdraw", nil)
+	pfs := service.NewPortfolioService(db)
+	pfh := handler.NewPortfolioHandler(pfs, ss, cld)
+
+	api.Get("/sellers/id/:sellerId/portfolio", pfh.FindSellerPortfolio)
+	api.Get("/sellers/portfolio", pfh.FindMyPortfolio)
+	api.Post("/sellers/portfolio", pfh.Create)
+	api.Put("/sellers/portfolio/order", pfh.ReorderItems)
+	api.Put("/sellers/portfolio/id/:id", pfh.Update)
+	api.Delete("/sellers/portfolio/id/:id", pfh.Delete)
+	api.Post("/sellers/portfolio/id/:id/media", pfh.AddMedia)
+	api.Put("/sellers/portfolio/id/:id/media/order", pfh.ReorderMedia)
+	api.Delete("/sellers/portfolio/id/:id/media/:mediaId", pfh.RemoveMedia)
+
+	ps := service.NewPayoutService(db)
+	ph := handler.NewPayoutHandler(ps, ss, payoutProvider)
+
+	api.Post("/sellers/balance/withdraw", ph.Withdraw)
+	api.Get("/sellers/balance/payouts/id/:id", ph.FindMyPayoutByID)
+	api.Get("/sellers/balance/payouts/:page/:size", ph.FindMyPayouts)
+
+	ls := service.NewLedgerService(db)
+	lh := handler.NewLedgerHandler(ls, ss)
+
+	api.Get("/sellers/balance", lh.GetMyBalance)
+	api.Get("/sellers/balance/reconcile", lh.ReconcileMyBalance)
+	api.Get("/sellers/balance/transactions/:page/:size", lh.FindMyTransactions)
+
+	cs := service.NewCollectionService(db)
+	ch := handler.NewCollectionHandler(cs, ss)
+
+	api.Get("/collections/saves/gigs/:gigId", ch.CountGigSaves)
+	api.Get("/collections/:kind", ch.FindMyCollections)
+	api.Post("/collections", ch.Create)
+	api.Patch("/collections/id/:id", ch.Rename)
+	api.Delete("/collections/id/:id", ch.Delete)
+	api.Get("/collections/id/:id/items/:page/:size", ch.FindItems)
+	api.Post("/collections/id/:id/items/:itemId", ch.AddItem)
+	api.Delete("/collections/id/:id/items/:itemId", ch.RemoveItem)
+
+	ts := service.NewTaxonomyService(db)
+	th := handler.NewTaxonomyHandler(ts)
+
+	api.Get("/taxonomy/:kind/autocomplete", th.Autocomplete)
+
+	bls := service.NewBlockService(db)
+	blh := handler.NewBlockHandler(bls, bs)
+
+	api.Get("/blocks", blh.FindMyBlocks)
+	api.Post("/blocks/:userId", blh.Block)
+	api.Delete("/blocks/:userId", blh.Unblock)
+
+	rs := service.NewReportService(db)
+	rh := handler.NewReportHandler(rs, bs)
+
+	api.Post("/reports", rh.Create)
+
+	des := service.NewDataExportService(db)
+	deh := handler.NewDataExportHandler(des)
+
+	api.Get("/exports", deh.FindMine)
+	api.Post("/exports", deh.Request)
+	api.Get("/exports/id/:id/download", deh.Download)
+
+	admin := api.Group("/admin")
+	admin.Use(adminOnly)
+	admin.Get("/ledger/reconcile", lh.Reconcile)
+	admin.Post("/ledger/adjustments", lh.Adjust)
+	admin.Post("/taxonomy/:kind", th.CreateTerm)
+	admin.Post("/taxonomy/:kind/merge", th.Merge)
+	admin.Get("/taxonomy/:kind/id/:id", th.FindTerm)
+	admin.Post("/taxonomy/:kind/id/:id/aliases", th.AddAlias)
+	admin.Delete("/taxonomy/:kind/id/:id/aliases/:alias", th.RemoveAlias)
+	admin.Get("/reports/id/:id", rh.FindByID)
+	admin.Put("/reports/id/:id", rh.Review)
+	admin.Get("/reports/:status/:page/:size", rh.FindQueue)
+	admin.Get("/verifications/id/:id", vh.FindByID)
+	admin.Put("/verifications/id/:id", vh.Review)
+	admin.Get("/verifications/id/:id/documents/:documentId", vh.FindDocument)
+	admin.Get("/verifications/:status/:page/:size", vh.FindQueue)
 }
 
 func verifyGatewayReq(c *fiber.Ctx) error {
@@ -105,3 +194,18 @@ func authOnly(c *fiber.Ctx) error {
 	c.SetUserContext(context.WithValue(c.UserContext(), "current_user", claims))
 	return c.Next()
 }
+
+// NOTE: ADMINS ARE CONFIGURED THROUGH A COMMA SEPARATED ADMIN_EMAILS ENV
+func adminOnly(c *fiber.Ctx) error {
+	claims, ok := c.UserContext().Value("current_user").(*types.JWTClaims)
+	if !ok {
+		return fiber.NewError(http.StatusUnauthorized, "sign in first")
+	}
+
+	admins := strings.Split(os.Getenv("ADMIN_EMAILS"), ",")
+	if !slices.Contains(admins, claims.Email) {
+		return fiber.NewError(http.StatusForbidden, "admin only")
+	}
+
+	return c.Next()
+}
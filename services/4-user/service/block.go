@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Akihira77/gojobber/services/4-user/types"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var ErrBlockSelf = errors.New("you can not block yourself")
+
+type BlockService struct {
+	db *gorm.DB
+}
+
+type BlockServiceImpl interface {
+	FindMine(ctx context.Context, blockerID string) ([]types.UserBlockDTO, error)
+	Block(ctx context.Context, blockerID, blockedID string) error
+	Unblock(ctx context.Context, blockerID, blockedID string) error
+	IsBlocked(ctx context.Context, userID, otherUserID string) (bool, error)
+	ResolveSellerUserID(ctx context.Context, sellerID string) (string, error)
+}
+
+func NewBlockService(db *gorm.DB) BlockServiceImpl {
+	return &BlockService{
+		db: db,
+	}
+}
+
+func (bs *BlockService) FindMine(ctx context.Context, blockerID string) ([]types.UserBlockDTO, error) {
+	blocks := []types.UserBlockDTO{}
+	result := bs.db.
+		WithContext(ctx).
+		Model(&types.UserBlock{}).
+		Select("user_blocks.blocked_id, b.username, b.profile_picture, user_blocks.created_at").
+		Joins("JOIN buyers b ON b.id = user_blocks.blocked_id").
+		Where("user_blocks.blocker_id = ?", blockerID).
+		Order("user_blocks.created_at DESC").
+		Scan(&blocks)
+
+	return blocks, result.Error
+}
+
+// Block does nothing when the user is already blocked.
+func (bs *BlockService) Block(ctx context.Context, blockerID, blockedID string) error {
+	if blockerID == blockedID {
+		return ErrBlockSelf
+	}
+
+	return bs.db.
+		WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&types.UserBlock{
+			BlockerID: blockerID,
+			BlockedID: blockedID,
+			CreatedAt: time.Now(),
+		}).
+		Error
+}
+
+func (bs *BlockService) Unblock(ctx context.Context, blockerID, blockedID string) error {
+	result := bs.db.
+		WithContext(ctx).
+		Where("blocker_id = ? AND blocked_id = ?", blockerID, blockedID).
+		Delete(&types.UserBlock{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// IsBlocked reports whether either user has blocked the other.
+func (bs *BlockService) IsBlocked(ctx context.Context, userID, otherUserID string) (bool, error) {
+	var count int64
+	result := bs.db.
+		WithContext(ctx).
+		Model(&types.UserBlock{}).
+		Where("(blocker_id = ? AND blocked_id = ?) OR (blocker_id = ? AND blocked_id = ?)", userID, otherUserID, otherUserID, userID).
+		Count(&count)
+
+	return count > 0, result.Error
+}
+
+func (bs *BlockService) ResolveSellerUserID(ctx context.Context, sellerID string) (string, error) {
+	var buyerID string
+	result := bs.db.
+		WithContext(ctx).
+		Model(&types.Seller{}).
+		Select("buyer_id").
+		Where("id = ?", sellerID).
+		Take(&buyerID)
+
+	return buyerID, result.Error
+}
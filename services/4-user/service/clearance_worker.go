@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+type ClearanceWorkerConfig struct {
+	BatchSize    int
+	PollInterval time.Duration
+}
+
+// ClearanceWorker moves order earnings from pending to available once their
+// clearance period is over.
+type ClearanceWorker struct {
+	ledgerSvc LedgerServiceImpl
+	cfg       ClearanceWorkerConfig
+}
+
+func NewClearanceWorker(ledgerSvc LedgerServiceImpl, cfg ClearanceWorkerConfig) *ClearanceWorker {
+	return &ClearanceWorker{
+		ledgerSvc: ledgerSvc,
+		cfg:       cfg,
+	}
+}
+
+// Run clears due earnings on every tick until ctx is canceled.
+func (w *ClearanceWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.cfg.PollInterval)
+	defer ticker.Stop()
+
+	log.Printf("clearance worker started, polling every [%s]", w.cfg.PollInterval)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.clearAll(ctx)
+		}
+	}
+}
+
+func (w *ClearanceWorker) clearAll(ctx context.Context) {
+	for {
+		cleared, err := w.ledgerSvc.ClearDue(ctx, w.cfg.BatchSize)
+		if err != nil {
+			log.Printf("clearance worker error:\n%+v", err)
+			return
+		}
+		if cleared > 0 {
+			log.Printf("clearance worker cleared [%d] earnings", cleared)
+		}
+		if cleared < w.cfg.BatchSize {
+			return
+		}
+	}
+}
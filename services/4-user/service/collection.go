@@ -0,0 +1,248 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Akihira77/gojobber/services/4-user/types"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var ErrDuplicateCollection = errors.New("collection with this name already exists")
+
+type CollectionService struct {
+	db *gorm.DB
+}
+
+type CollectionServiceImpl interface {
+	FindByBuyerID(ctx context.Context, buyerID string, kind types.CollectionKind) ([]types.CollectionDTO, error)
+	FindByID(ctx context.Context, buyerID, id string) (*types.Collection, error)
+	Create(ctx context.Context, buyerID string, data *types.CreateCollectionDTO) (*types.Collection, error)
+	Rename(ctx context.Context, c *types.Collection, name string) error
+	Delete(ctx context.Context, c *types.Collection) error
+	FindItems(ctx context.Context, c *types.Collection, page, size int) ([]types.CollectionItem, int64, error)
+	AddItem(ctx context.Context, c *types.Collection, itemID string) error
+	RemoveItem(ctx context.Context, c *types.Collection, itemID string) error
+	FindSavedItemIDs(ctx context.Context, buyerID string, kind types.CollectionKind, itemIDs []string) ([]string, error)
+	CountSaves(ctx context.Context, kind types.CollectionKind, itemIDs []string) (map[string]int64, error)
+}
+
+func NewCollectionService(db *gorm.DB) CollectionServiceImpl {
+	return &CollectionService{
+		db: db,
+	}
+}
+
+func (cs *CollectionService) FindByBuyerID(ctx context.Context, buyerID string, kind types.CollectionKind) ([]types.CollectionDTO, error) {
+	var collections []types.CollectionDTO
+	result := cs.db.
+		WithContext(ctx).
+		Model(&types.Collection{}).
+		Select(`
+			collections.id,
+			collections.kind,
+			collections.name,
+			collections.created_at,
+			collections.updated_at,
+			COUNT(collection_items.item_id) AS items_count
+		`).
+		Joins("LEFT JOIN collection_items ON collection_items.collection_id = collections.id").
+		Where("collections.buyer_id = ? AND collections.kind = ?", buyerID, kind).
+		Group("collections.id").
+		Order("collections.name").
+		Scan(&collections)
+
+	return collections, result.Error
+}
+
+func (cs *CollectionService) FindByID(ctx context.Context, buyerID, id string) (*types.Collection, error) {
+	var c types.Collection
+	result := cs.db.
+		WithContext(ctx).
+		Model(&types.Collection{}).
+		Where("id = ? AND buyer_id = ?", id, buyerID).
+		First(&c)
+
+	return &c, result.Error
+}
+
+func (cs *CollectionService) Create(ctx context.Context, buyerID string, data *types.CreateCollectionDTO) (*types.Collection, error) {
+	now := time.Now()
+	c := &types.Collection{
+		BuyerID:   buyerID,
+		Kind:      data.Kind,
+		Name:      data.Name,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	result := cs.db.
+		WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(c)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, ErrDuplicateCollection
+	}
+
+	return c, nil
+}
+
+func (cs *CollectionService) Rename(ctx context.Context, c *types.Collection, name string) error {
+	var exists int64
+	result := cs.db.
+		WithContext(ctx).
+		Model(&types.Collection{}).
+		Where("buyer_id = ? AND kind = ? AND name = ? AND id <> ?", c.BuyerID, c.Kind, name, c.ID).
+		Count(&exists)
+	if result.Error != nil {
+		return result.Error
+	}
+	if exists > 0 {
+		return ErrDuplicateCollection
+	}
+
+	c.Name = name
+	c.UpdatedAt = time.Now()
+	return cs.db.
+		WithContext(ctx).
+		Model(c).
+		Select("name", "updated_at").
+		Updates(c).
+		Error
+}
+
+func (cs *CollectionService) Delete(ctx context.Context, c *types.Collection) error {
+	return cs.db.
+		WithContext(ctx).
+		Transaction(func(tx *gorm.DB) error {
+			err := tx.
+				Where("collection_id = ?", c.ID).
+				Delete(&types.CollectionItem{}).
+				Error
+			if err != nil {
+				return err
+			}
+
+			return tx.
+				Delete(c).
+				Error
+		})
+}
+
+func (cs *CollectionService) FindItems(ctx context.Context, c *types.Collection, page, size int) ([]types.CollectionItem, int64, error) {
+	var total int64
+	result := cs.db.
+		WithContext(ctx).
+		Model(&types.CollectionItem{}).
+		Where("collection_id = ?", c.ID).
+		Count(&total)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+
+	var items []types.CollectionItem
+	result = cs.db.
+		WithContext(ctx).
+		Model(&types.CollectionItem{}).
+		Where("collection_id = ?", c.ID).
+		Order("created_at DESC").
+		Offset((page - 1) * size).
+		Limit(size).
+		Find(&items)
+
+	return items, total, result.Error
+}
+
+// AddItem does nothing when the item is already in the collection.
+func (cs *CollectionService) AddItem(ctx context.Context, c *types.Collection, itemID string) error {
+	now := time.Now()
+	return cs.db.
+		WithContext(ctx).
+		Transaction(func(tx *gorm.DB) error {
+			result := tx.
+				Clauses(clause.OnConflict{DoNothing: true}).
+				Create(&types.CollectionItem{
+					CollectionID: c.ID,
+					ItemID:       itemID,
+					BuyerID:      c.BuyerID,
+					Kind:         c.Kind,
+					CreatedAt:    now,
+				})
+			if result.Error != nil {
+				return result.Error
+			}
+
+			return tx.
+				Model(c).
+				Update("updated_at", now).
+				Error
+		})
+}
+
+func (cs *CollectionService) RemoveItem(ctx context.Context, c *types.Collection, itemID string) error {
+	result := cs.db.
+		WithContext(ctx).
+		Where("collection_id = ? AND item_id = ?", c.ID, itemID).
+		Delete(&types.CollectionItem{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// FindSavedItemIDs returns the subset of itemIDs the buyer saved in any of
+// their collections.
+func (cs *CollectionService) FindSavedItemIDs(ctx context.Context, buyerID string, kind types.CollectionKind, itemIDs []string) ([]string, error) {
+	var saved []string
+	if buyerID == "" || len(itemIDs) == 0 {
+		return saved, nil
+	}
+
+	result := cs.db.
+		WithContext(ctx).
+		Model(&types.CollectionItem{}).
+		Distinct("item_id").
+		Where("buyer_id = ? AND kind = ? AND item_id IN ?", buyerID, kind, itemIDs).
+		Pluck("item_id", &saved)
+
+	return saved, result.Error
+}
+
+// CountSaves counts distinct buyers per item, saving one gig in two
+// collections counts once.
+func (cs *CollectionService) CountSaves(ctx context.Context, kind types.CollectionKind, itemIDs []string) (map[string]int64, error) {
+	counts := make(map[string]int64, len(itemIDs))
+	if len(itemIDs) == 0 {
+		return counts, nil
+	}
+
+	type Row struct {
+		ItemID string
+		Saves  int64
+	}
+	var rows []Row
+	result := cs.db.
+		WithContext(ctx).
+		Model(&types.CollectionItem{}).
+		Select("item_id, COUNT(DISTINCT buyer_id) AS saves").
+		Where("kind = ? AND item_id IN ?", kind, itemIDs).
+		Group("item_id").
+		Scan(&rows)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	for _, r := range rows {
+		counts[r.ItemID] = r.Saves
+	}
+	return counts, nil
+}
@@ -0,0 +1,184 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Akihira77/gojobber/services/4-user/types"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var ErrDataExportInProgress = errors.New("a data export is already in progress")
+
+// dataExportColumns leaves the archive out of the listings.
+const dataExportColumns = "id, buyer_id, status, attempts, failure_reason, size, locked_until, completed_at, expires_at, created_at, updated_at"
+
+type DataExportService struct {
+	db *gorm.DB
+}
+
+type DataExportServiceImpl interface {
+	Request(ctx context.Context, buyerID string) (*types.DataExport, error)
+	FindMine(ctx context.Context, buyerID string) ([]types.DataExport, error)
+	FindArchive(ctx context.Context, buyerID, id string) (*types.DataExport, error)
+	ClaimDue(ctx context.Context, limit int, lockFor time.Duration) ([]types.DataExport, error)
+	MarkReady(ctx context.Context, id string, archive []byte, expiresAt time.Time) error
+	Retry(ctx context.Context, id, reason string) error
+	MarkFailed(ctx context.Context, id, reason string) error
+	ExpireArchives(ctx context.Context) (int64, error)
+}
+
+func NewDataExportService(db *gorm.DB) DataExportServiceImpl {
+	return &DataExportService{
+		db: db,
+	}
+}
+
+// Request queues a new export. A user can only have one export in progress,
+// enforced by the idx_data_exports_in_progress partial unique index.
+func (des *DataExportService) Request(ctx context.Context, buyerID string) (*types.DataExport, error) {
+	e := &types.DataExport{
+		BuyerID: buyerID,
+		Status:  types.DATA_EXPORT_PENDING,
+	}
+
+	result := des.db.
+		WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(e)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, ErrDataExportInProgress
+	}
+
+	return e, nil
+}
+
+func (des *DataExportService) FindMine(ctx context.Context, buyerID string) ([]types.DataExport, error) {
+	exports := []types.DataExport{}
+	result := des.db.
+		WithContext(ctx).
+		Model(&types.DataExport{}).
+		Select(dataExportColumns).
+		Where("buyer_id = ?", buyerID).
+		Order("created_at DESC").
+		Find(&exports)
+
+	return exports, result.Error
+}
+
+func (des *DataExportService) FindArchive(ctx context.Context, buyerID, id string) (*types.DataExport, error) {
+	var e types.DataExport
+	result := des.db.
+		WithContext(ctx).
+		Model(&types.DataExport{}).
+		Where("id = ? AND buyer_id = ?", id, buyerID).
+		First(&e)
+
+	return &e, result.Error
+}
+
+// ClaimDue also reclaims exports whose worker died while collecting them.
+func (des *DataExportService) ClaimDue(ctx context.Context, limit int, lockFor time.Duration) ([]types.DataExport, error) {
+	now := time.Now()
+	var exports []types.DataExport
+	result := des.db.
+		WithContext(ctx).
+		Raw(`
+			UPDATE data_exports
+			SET status = ?, attempts = attempts + 1, locked_until = ?, updated_at = ?
+			WHERE id IN (
+				SELECT id FROM data_exports
+				WHERE status = ?
+				OR (status = ? AND locked_until < ?)
+				ORDER BY created_at
+				LIMIT ?
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING `+dataExportColumns,
+			types.DATA_EXPORT_PROCESSING, now.Add(lockFor), now,
+			types.DATA_EXPORT_PENDING,
+			types.DATA_EXPORT_PROCESSING, now,
+			limit,
+		).
+		Scan(&exports)
+
+	return exports, result.Error
+}
+
+func (des *DataExportService) MarkReady(ctx context.Context, id string, archive []byte, expiresAt time.Time) error {
+	now := time.Now()
+	result := des.db.
+		WithContext(ctx).
+		Model(&types.DataExport{}).
+		Where("id = ? AND status = ?", id, types.DATA_EXPORT_PROCESSING).
+		Updates(map[string]interface{}{
+			"status":         types.DATA_EXPORT_READY,
+			"archive":        archive,
+			"size":           len(archive),
+			"failure_reason": "",
+			"locked_until":   nil,
+			"completed_at":   now,
+			"expires_at":     expiresAt,
+			"updated_at":     now,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// Retry puts the export back in the queue for the next tick.
+func (des *DataExportService) Retry(ctx context.Context, id, reason string) error {
+	result := des.db.
+		WithContext(ctx).
+		Model(&types.DataExport{}).
+		Where("id = ? AND status = ?", id, types.DATA_EXPORT_PROCESSING).
+		Updates(map[string]interface{}{
+			"status":         types.DATA_EXPORT_PENDING,
+			"failure_reason": reason,
+			"locked_until":   nil,
+			"updated_at":     time.Now(),
+		})
+
+	return result.Error
+}
+
+func (des *DataExportService) MarkFailed(ctx context.Context, id, reason string) error {
+	result := des.db.
+		WithContext(ctx).
+		Model(&types.DataExport{}).
+		Where("id = ? AND status = ?", id, types.DATA_EXPORT_PROCESSING).
+		Updates(map[string]interface{}{
+			"status":         types.DATA_EXPORT_FAILED,
+			"failure_reason": reason,
+			"locked_until":   nil,
+			"updated_at":     time.Now(),
+		})
+
+	return result.Error
+}
+
+// ExpireArchives removes the archives whose download link has expired.
+func (des *DataExportService) ExpireArchives(ctx context.Context) (int64, error) {
+	now := time.Now()
+	result := des.db.
+		WithContext(ctx).
+		Model(&types.DataExport{}).
+		Where("status = ? AND expires_at <= ?", types.DATA_EXPORT_READY, now).
+		Updates(map[string]interface{}{
+			"status":     types.DATA_EXPORT_EXPIRED,
+			"archive":    nil,
+			"updated_at": now,
+		})
+
+	return result.RowsAffected, result.Error
+}
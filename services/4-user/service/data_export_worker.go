@@ -0,0 +1,287 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/Akihira77/gojobber/services/4-user/types"
+	"github.com/Akihira77/gojobber/services/common/genproto/auth"
+	"github.com/Akihira77/gojobber/services/common/genproto/chat"
+	"github.com/Akihira77/gojobber/services/common/genproto/gig"
+	"github.com/Akihira77/gojobber/services/common/genproto/notification"
+	"github.com/Akihira77/gojobber/services/common/genproto/order"
+	"github.com/Akihira77/gojobber/services/common/genproto/review"
+	"github.com/Akihira77/gojobber/services/common/locale"
+	"google.golang.org/protobuf/types/known/structpb"
+	"gorm.io/gorm"
+)
+
+type DataExportWorkerConfig struct {
+	BatchSize    int
+	MaxAttempts  int
+	PollInterval time.Duration
+	LockDuration time.Duration
+	// LinkTTL is how long the archive can be downloaded once it is ready.
+	LinkTTL time.Duration
+}
+
+// DataExportSources are the services that hand out their part of the export.
+type DataExportSources struct {
+	Auth   auth.AuthServiceClient
+	Gig    gig.GigServiceClient
+	Chat   chat.ChatServiceClient
+	Order  order.OrderServiceClient
+	Review review.ReviewServiceClient
+}
+
+// DataExportWorker collects the personal data of a user from every service,
+// packs it in a zip of JSON files and emails the user a download link.
+type DataExportWorker struct {
+	exportSvc          DataExportServiceImpl
+	buyerSvc           BuyerServiceImpl
+	sellerSvc          SellerServiceImpl
+	sources            DataExportSources
+	notificationClient notification.NotificationServiceClient
+	cfg                DataExportWorkerConfig
+}
+
+// NewDataExportWorker accepts a nil notificationClient, the user then only
+// finds the export in their export list.
+func NewDataExportWorker(exportSvc DataExportServiceImpl, buyerSvc BuyerServiceImpl, sellerSvc SellerServiceImpl, sources DataExportSources, notificationClient notification.NotificationServiceClient, cfg DataExportWorkerConfig) *DataExportWorker {
+	return &DataExportWorker{
+		exportSvc:          exportSvc,
+		buyerSvc:           buyerSvc,
+		sellerSvc:          sellerSvc,
+		sources:            sources,
+		notificationClient: notificationClient,
+		cfg:                cfg,
+	}
+}
+
+// Run builds the requested exports and removes the expired archives on every
+// tick until ctx is canceled.
+func (w *DataExportWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.cfg.PollInterval)
+	defer ticker.Stop()
+
+	log.Printf("data export worker started, polling every [%s]", w.cfg.PollInterval)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			expired, err := w.exportSvc.ExpireArchives(ctx)
+			if err != nil {
+				log.Printf("data export worker expiring error:\n%+v", err)
+			} else if expired > 0 {
+				log.Printf("data export worker removed [%d] expired archives", expired)
+			}
+
+			exports, err := w.exportSvc.ClaimDue(ctx, w.cfg.BatchSize, w.cfg.LockDuration)
+			if err != nil {
+				log.Printf("data export worker claiming error:\n%+v", err)
+				continue
+			}
+
+			for _, e := range exports {
+				w.process(ctx, e)
+			}
+		}
+	}
+}
+
+func (w *DataExportWorker) process(ctx context.Context, e types.DataExport) {
+	buyer, err := w.buyerSvc.FindBuyerByID(ctx, e.BuyerID)
+	if err != nil {
+		w.retryOrFail(ctx, e, fmt.Errorf("finding buyer: %w", err))
+		return
+	}
+
+	archive, err := w.collect(ctx, e, buyer)
+	if err != nil {
+		w.retryOrFail(ctx, e, err)
+		return
+	}
+
+	expiresAt := time.Now().Add(w.cfg.LinkTTL)
+	if err := w.exportSvc.MarkReady(ctx, e.ID.String(), archive, expiresAt); err != nil {
+		log.Printf("data export [%s] marking as ready error:\n%+v", e.ID, err)
+		return
+	}
+
+	w.notify(e, buyer, expiresAt)
+}
+
+// collect asks every service for its part, a single failing service fails
+// the whole export so the user never gets an incomplete archive.
+func (w *DataExportWorker) collect(ctx context.Context, e types.DataExport, buyer *types.Buyer) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, w.cfg.LockDuration)
+	defer cancel()
+
+	profile := types.DataExportProfile{
+		Buyer: buyer,
+	}
+	var sellerID string
+	s, err := w.sellerSvc.FindSellerByBuyerID(ctx, buyer.ID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("finding seller: %w", err)
+	}
+	if err == nil {
+		sellerID = s.ID
+		profile.Seller, err = w.sellerSvc.FindSellerByID(ctx, sellerID)
+		if err != nil {
+			return nil, fmt.Errorf("finding seller profile: %w", err)
+		}
+	}
+
+	profileData, err := json.Marshal(profile)
+	if err != nil {
+		return nil, err
+	}
+
+	authRes, err := w.sources.Auth.ExportAuthData(ctx, &auth.ExportAuthDataRequest{
+		UserId: buyer.ID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("exporting auth data: %w", err)
+	}
+
+	gigData := []byte("[]")
+	if sellerID != "" {
+		gigRes, err := w.sources.Gig.ExportGigData(ctx, &gig.ExportGigDataRequest{
+			SellerId: sellerID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("exporting gig data: %w", err)
+		}
+		gigData = gigRes.Data
+	}
+
+	chatRes, err := w.sources.Chat.ExportChatData(ctx, &chat.ExportChatDataRequest{
+		UserId: buyer.ID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("exporting chat data: %w", err)
+	}
+
+	orderRes, err := w.sources.Order.ExportOrderData(ctx, &order.ExportOrderDataRequest{
+		UserId:   buyer.ID,
+		SellerId: sellerID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("exporting order data: %w", err)
+	}
+
+	reviewRes, err := w.sources.Review.ExportReviewData(ctx, &review.ExportReviewDataRequest{
+		UserId:   buyer.ID,
+		SellerId: sellerID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("exporting review data: %w", err)
+	}
+
+	return buildDataExportArchive(e, buyer.ID, []dataExportFile{
+		{name: "account.json", data: authRes.Data},
+		{name: "profile.json", data: profileData},
+		{name: "gigs.json", data: gigData},
+		{name: "conversations.json", data: chatRes.Data},
+		{name: "orders.json", data: orderRes.Data},
+		{name: "reviews.json", data: reviewRes.Data},
+	})
+}
+
+type dataExportFile struct {
+	name string
+	data []byte
+}
+
+func buildDataExportArchive(e types.DataExport, userID string, files []dataExportFile) ([]byte, error) {
+	manifest := types.DataExportManifest{
+		ExportID:    e.ID,
+		UserID:      userID,
+		GeneratedAt: time.Now().UTC(),
+	}
+	for _, f := range files {
+		manifest.Files = append(manifest.Files, f.name)
+	}
+	manifestData, err := json.Marshal(manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, f := range append([]dataExportFile{{name: "manifest.json", data: manifestData}}, files...) {
+		var indented bytes.Buffer
+		if err := json.Indent(&indented, f.data, "", "  "); err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", f.name, err)
+		}
+
+		fw, err := zw.Create(f.name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := fw.Write(indented.Bytes()); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (w *DataExportWorker) retryOrFail(ctx context.Context, e types.DataExport, cause error) {
+	log.Printf("data export [%s] attempt %d failed:\n%+v", e.ID, e.Attempts, cause)
+	if e.Attempts < w.cfg.MaxAttempts {
+		if err := w.exportSvc.Retry(ctx, e.ID.String(), cause.Error()); err != nil {
+			log.Printf("data export [%s] marking for retry error:\n%+v", e.ID, err)
+		}
+		return
+	}
+
+	if err := w.exportSvc.MarkFailed(ctx, e.ID.String(), cause.Error()); err != nil {
+		log.Printf("data export [%s] marking as failed error:\n%+v", e.ID, err)
+	}
+}
+
+func (w *DataExportWorker) notify(e types.DataExport, buyer *types.Buyer, expiresAt time.Time) {
+	if w.notificationClient == nil {
+		return
+	}
+
+	payload, err := structpb.NewStruct(map[string]interface{}{
+		"ExportURL": fmt.Sprintf("%s/settings/data-exports/%s", os.Getenv("CLIENT_URL"), e.ID),
+		"ExpiresAt": expiresAt.UTC().Format("Mon, 02 Jan 2006 15:04 MST"),
+	})
+	if err != nil {
+		log.Printf("data export notification for [%s] error:\n%+v", buyer.Email, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+
+	_, err = w.notificationClient.Publish(ctx, &notification.NotificationEvent{
+		Type: notification.NotificationEventType_USER_DATA_EXPORT_READY,
+		Recipients: []*notification.NotificationRecipient{
+			{
+				Email:  buyer.Email,
+				Locale: locale.FromCountry(buyer.Country),
+			},
+		},
+		Payload: payload,
+	})
+	if err != nil {
+		log.Printf("data export notification for [%s] error:\n%+v", buyer.Email, err)
+	}
+}
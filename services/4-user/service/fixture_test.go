@@ -0,0 +1,95 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Akihira77/gojobber/services/4-user/types"
+	"github.com/Akihira77/gojobber/services/common/testdb"
+	"gorm.io/gorm"
+)
+
+const testSellerID = "seller-1"
+
+// newTestDB has the tables the balance, ledger and payout services use and a
+// seller with the given available and pending balances.
+func newTestDB(t *testing.T, accountBalance, pendingBalance uint64) *gorm.DB {
+	t.Helper()
+
+	db := testdb.Open(t, []interface{}{
+		&types.Buyer{},
+		&types.Seller{},
+		&types.LedgerTransaction{},
+		&types.LedgerEntry{},
+		&types.EarningClearance{},
+		&types.Payout{},
+	})
+
+	err := db.Create(&types.Buyer{
+		ID:        "buyer-1",
+		Username:  "seller",
+		Email:     "seller@example.com",
+		Country:   "Indonesia",
+		IsSeller:  true,
+		CreatedAt: time.Now(),
+	}).Error
+	if err != nil {
+		t.Fatalf("create buyer: %v", err)
+	}
+
+	err = db.Create(&types.Seller{
+		ID:              testSellerID,
+		BuyerID:         "buyer-1",
+		StripeAccountID: "acct_1",
+		FullName:        "Seller One",
+		AccountBalance:  accountBalance,
+		PendingBalance:  pendingBalance,
+		CreatedAt:       time.Now(),
+	}).Error
+	if err != nil {
+		t.Fatalf("create seller: %v", err)
+	}
+
+	return db
+}
+
+func assertBalances(t *testing.T, db *gorm.DB, wantAccount, wantPending uint64) {
+	t.Helper()
+
+	var s types.Seller
+	if err := db.First(&s, "id = ?", testSellerID).Error; err != nil {
+		t.Fatalf("find seller: %v", err)
+	}
+	if s.AccountBalance != wantAccount || s.PendingBalance != wantPending {
+		t.Errorf("balances = (%d, %d), want (%d, %d)", s.AccountBalance, s.PendingBalance, wantAccount, wantPending)
+	}
+}
+
+// accountTotal is the sum of every entry posted to the ledger account.
+func accountTotal(t *testing.T, db *gorm.DB, account string) int64 {
+	t.Helper()
+
+	var total int64
+	err := db.
+		Model(&types.LedgerEntry{}).
+		Select("COALESCE(SUM(amount), 0)").
+		Where("account = ?", account).
+		Scan(&total).
+		Error
+	if err != nil {
+		t.Fatalf("sum %s: %v", account, err)
+	}
+
+	return total
+}
+
+func countRows(t *testing.T, db *gorm.DB, model interface{}) int64 {
+	t.Helper()
+
+	var total int64
+	if err := db.Model(model).Count(&total).Error; err != nil {
+		t.Fatalf("count %T: %v", model, err)
+	}
+
+	return total
+}
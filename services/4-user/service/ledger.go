@@ -0,0 +1,429 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Akihira77/gojobber/services/4-user/types"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrUnbalancedLedgerTransaction = errors.New("ledger transaction entries do not sum to zero")
+	ErrDuplicateLedgerTransaction  = errors.New("ledger transaction is already posted")
+)
+
+type LedgerService struct {
+	db *gorm.DB
+}
+
+type LedgerServiceImpl interface {
+	RecordOrderEarning(ctx context.Context, sellerID, orderID string, price, fee uint64, clearsAt time.Time) error
+	ClearDue(ctx context.Context, limit int) (int, error)
+	FindUpcomingClearances(ctx context.Context, sellerID string, limit int) ([]types.EarningClearance, error)
+	Adjust(ctx context.Context, data *types.LedgerAdjustmentDTO) (*types.LedgerTransaction, error)
+	FindSellerTransactions(ctx context.Context, sellerID string, page, size int) ([]types.SellerTransactionDTO, int64, error)
+	ReconcileSeller(ctx context.Context, sellerID string) (*types.BalanceReconciliation, error)
+	Reconcile(ctx context.Context) ([]types.BalanceReconciliation, error)
+	BackfillOpeningBalances(ctx context.Context) (int64, error)
+}
+
+func NewLedgerService(db *gorm.DB) LedgerServiceImpl {
+	return &LedgerService{
+		db: db,
+	}
+}
+
+// RecordOrderEarning is safe to call again for the same order, the earning
+// and the fee are only posted once. The net earning stays pending until
+// clearsAt so chargebacks and disputes can still be settled from it.
+func (ls *LedgerService) RecordOrderEarning(ctx context.Context, sellerID, orderID string, price, fee uint64, clearsAt time.Time) error {
+	if fee > price {
+		fee = price
+	}
+
+	return ls.db.
+		WithContext(ctx).
+		Transaction(func(tx *gorm.DB) error {
+			pending := types.SellerPendingLedgerAccount(sellerID)
+			err := postLedgerTransaction(tx, &types.LedgerTransaction{
+				Kind:           types.LEDGER_ORDER_EARNING,
+				SellerID:       sellerID,
+				IdempotencyKey: fmt.Sprintf("order:%s:earning", orderID),
+				SourceType:     types.LEDGER_SOURCE_ORDER,
+				SourceID:       orderID,
+				Description:    "Order completed",
+				Entries: []types.LedgerEntry{
+					{Account: types.LEDGER_ACCOUNT_ESCROW, Amount: -int64(price)},
+					{Account: pending, Amount: int64(price)},
+				},
+			})
+			if errors.Is(err, ErrDuplicateLedgerTransaction) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+
+			if fee > 0 {
+				err = postLedgerTransaction(tx, &types.LedgerTransaction{
+					Kind:           types.LEDGER_PLATFORM_FEE,
+					SellerID:       sellerID,
+					IdempotencyKey: fmt.Sprintf("order:%s:fee", orderID),
+					SourceType:     types.LEDGER_SOURCE_ORDER,
+					SourceID:       orderID,
+					Description:    "Platform service fee",
+					Entries: []types.LedgerEntry{
+						{Account: pending, Amount: -int64(fee)},
+						{Account: types.LEDGER_ACCOUNT_REVENUE, Amount: int64(fee)},
+					},
+				})
+				if err != nil {
+					return err
+				}
+			}
+
+			if price == fee {
+				return nil
+			}
+
+			return tx.
+				Create(&types.EarningClearance{
+					SellerID:  sellerID,
+					OrderID:   orderID,
+					Amount:    price - fee,
+					ClearsAt:  clearsAt,
+					CreatedAt: time.Now(),
+				}).
+				Error
+		})
+}
+
+// ClearDue moves at most limit cleared earnings to the available balance and
+// reports how many were moved. Concurrent callers skip each other's rows.
+func (ls *LedgerService) ClearDue(ctx context.Context, limit int) (int, error) {
+	var cleared int
+	err := ls.db.
+		WithContext(ctx).
+		Transaction(func(tx *gorm.DB) error {
+			var clearances []types.EarningClearance
+			result := tx.
+				Model(&types.EarningClearance{}).
+				Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+				Where("cleared_at IS NULL AND clears_at <= ?", time.Now()).
+				Order("clears_at").
+				Limit(limit).
+				Find(&clearances)
+			if result.Error != nil {
+				return result.Error
+			}
+
+			for _, c := range clearances {
+				err := postLedgerTransaction(tx, &types.LedgerTransaction{
+					Kind:           types.LEDGER_CLEARANCE,
+					SellerID:       c.SellerID,
+					IdempotencyKey: fmt.Sprintf("order:%s:clearance", c.OrderID),
+					SourceType:     types.LEDGER_SOURCE_ORDER,
+					SourceID:       c.OrderID,
+					Description:    "Earning cleared",
+					Entries: []types.LedgerEntry{
+						{Account: types.SellerPendingLedgerAccount(c.SellerID), Amount: -int64(c.Amount)},
+						{Account: types.SellerLedgerAccount(c.SellerID), Amount: int64(c.Amount)},
+					},
+				})
+				if err != nil && !errors.Is(err, ErrDuplicateLedgerTransaction) {
+					return err
+				}
+
+				result = tx.
+					Model(&types.EarningClearance{}).
+					Where("id = ?", c.ID).
+					Update("cleared_at", time.Now())
+				if result.Error != nil {
+					return result.Error
+				}
+			}
+
+			cleared = len(clearances)
+			return nil
+		})
+
+	return cleared, err
+}
+
+func (ls *LedgerService) FindUpcomingClearances(ctx context.Context, sellerID string, limit int) ([]types.EarningClearance, error) {
+	var clearances []types.EarningClearance
+	result := ls.db.
+		WithContext(ctx).
+		Model(&types.EarningClearance{}).
+		Where("seller_id = ? AND cleared_at IS NULL", sellerID).
+		Order("clears_at").
+		Limit(limit).
+		Find(&clearances)
+
+	return clearances, result.Error
+}
+
+func (ls *LedgerService) Adjust(ctx context.Context, data *types.LedgerAdjustmentDTO) (*types.LedgerTransaction, error) {
+	counterAccount := types.LEDGER_ACCOUNT_EQUITY
+	if data.Kind == types.LEDGER_REFUND {
+		counterAccount = types.LEDGER_ACCOUNT_ESCROW
+	}
+
+	key := data.Reference
+	if key == "" {
+		key = uuid.NewString()
+	}
+
+	t := &types.LedgerTransaction{
+		Kind:           data.Kind,
+		SellerID:       data.SellerID,
+		IdempotencyKey: fmt.Sprintf("manual:%s", key),
+		SourceType:     types.LEDGER_SOURCE_MANUAL,
+		SourceID:       key,
+		Description:    data.Reason,
+		Entries: []types.LedgerEntry{
+			{Account: types.SellerLedgerAccount(data.SellerID), Amount: data.Amount},
+			{Account: counterAccount, Amount: -data.Amount},
+		},
+	}
+
+	err := ls.db.
+		WithContext(ctx).
+		Transaction(func(tx *gorm.DB) error {
+			return postLedgerTransaction(tx, t)
+		})
+
+	return t, err
+}
+
+func (ls *LedgerService) FindSellerTransactions(ctx context.Context, sellerID string, page, size int) ([]types.SellerTransactionDTO, int64, error) {
+	var total int64
+	result := ls.db.
+		WithContext(ctx).
+		Model(&types.LedgerTransaction{}).
+		Where("seller_id = ?", sellerID).
+		Count(&total)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+
+	var transactions []types.SellerTransactionDTO
+	result = ls.db.
+		WithContext(ctx).
+		Model(&types.LedgerTransaction{}).
+		Select(`
+			ledger_transactions.id,
+			ledger_transactions.kind,
+			ledger_transactions.source_type,
+			ledger_transactions.source_id,
+			ledger_transactions.description,
+			ledger_transactions.created_at,
+			SUM(CASE WHEN ledger_entries.account = ? THEN ledger_entries.amount ELSE 0 END) AS amount,
+			SUM(CASE WHEN ledger_entries.account = ? THEN ledger_entries.amount ELSE 0 END) AS pending_amount
+		`, types.SellerLedgerAccount(sellerID), types.SellerPendingLedgerAccount(sellerID)).
+		Joins("INNER JOIN ledger_entries ON ledger_entries.transaction_id = ledger_transactions.id").
+		Where("ledger_transactions.seller_id = ?", sellerID).
+		Group("ledger_transactions.id").
+		Order("ledger_transactions.created_at DESC").
+		Offset((page - 1) * size).
+		Limit(size).
+		Scan(&transactions)
+
+	return transactions, total, result.Error
+}
+
+func (ls *LedgerService) ReconcileSeller(ctx context.Context, sellerID string) (*types.BalanceReconciliation, error) {
+	var r types.BalanceReconciliation
+	result := ls.db.
+		WithContext(ctx).
+		Raw(`
+			SELECT
+				sellers.id AS seller_id,
+				sellers.account_balance AS cached_balance,
+				COALESCE((
+					SELECT SUM(amount) FROM ledger_entries WHERE account = 'seller:' || sellers.id
+				), 0) AS ledger_balance,
+				sellers.pending_balance AS cached_pending,
+				COALESCE((
+					SELECT SUM(amount) FROM ledger_entries WHERE account = 'seller:' || sellers.id || ':pending'
+				), 0) AS ledger_pending
+			FROM sellers
+			WHERE sellers.id = ?
+		`, sellerID).
+		Scan(&r)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	r.Consistent = r.CachedBalance == r.LedgerBalance && r.CachedPending == r.LedgerPending
+	return &r, nil
+}
+
+// Reconcile lists only the sellers whose cached balance drifted from the
+// balance derived from their ledger entries.
+func (ls *LedgerService) Reconcile(ctx context.Context) ([]types.BalanceReconciliation, error) {
+	var rs []types.BalanceReconciliation
+	result := ls.db.
+		WithContext(ctx).
+		Raw(`
+			SELECT seller_id, cached_balance, ledger_balance, cached_pending, ledger_pending, false AS consistent
+			FROM (
+				SELECT
+					sellers.id AS seller_id,
+					sellers.account_balance AS cached_balance,
+					COALESCE(a.balance, 0) AS ledger_balance,
+					sellers.pending_balance AS cached_pending,
+					COALESCE(p.balance, 0) AS ledger_pending
+				FROM sellers
+				LEFT JOIN (
+					SELECT account, SUM(amount) AS balance
+					FROM ledger_entries
+					WHERE account LIKE 'seller:%'
+					GROUP BY account
+				) a ON a.account = 'seller:' || sellers.id
+				LEFT JOIN (
+					SELECT account, SUM(amount) AS balance
+					FROM ledger_entries
+					WHERE account LIKE 'seller:%:pending'
+					GROUP BY account
+				) p ON p.account = 'seller:' || sellers.id || ':pending'
+			) b
+			WHERE cached_balance <> ledger_balance OR cached_pending <> ledger_pending
+			ORDER BY seller_id
+		`).
+		Scan(&rs)
+
+	return rs, result.Error
+}
+
+// BackfillOpeningBalances posts the balance sellers had before the ledger
+// existed as an opening adjustment, the cached balance is left as is.
+func (ls *LedgerService) BackfillOpeningBalances(ctx context.Context) (int64, error) {
+	var sellers []types.Seller
+	result := ls.db.
+		WithContext(ctx).
+		Model(&types.Seller{}).
+		Select("id, account_balance").
+		Where("account_balance > 0").
+		Where("NOT EXISTS (SELECT 1 FROM ledger_transactions WHERE ledger_transactions.seller_id = sellers.id)").
+		Find(&sellers)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	var count int64
+	for _, s := range sellers {
+		err := ls.db.
+			WithContext(ctx).
+			Transaction(func(tx *gorm.DB) error {
+				return insertLedgerTransaction(tx, &types.LedgerTransaction{
+					Kind:           types.LEDGER_ADJUSTMENT,
+					SellerID:       s.ID,
+					IdempotencyKey: fmt.Sprintf("opening:%s", s.ID),
+					SourceType:     types.LEDGER_SOURCE_MANUAL,
+					SourceID:       s.ID,
+					Description:    "Opening balance",
+					Entries: []types.LedgerEntry{
+						{Account: types.SellerLedgerAccount(s.ID), Amount: int64(s.AccountBalance)},
+						{Account: types.LEDGER_ACCOUNT_EQUITY, Amount: -int64(s.AccountBalance)},
+					},
+				})
+			})
+		if errors.Is(err, ErrDuplicateLedgerTransaction) {
+			continue
+		}
+		if err != nil {
+			return count, err
+		}
+		count++
+	}
+
+	return count, nil
+}
+
+// postLedgerTransaction inserts t and applies its net seller amounts to the
+// cached Seller.AccountBalance and Seller.PendingBalance inside tx. A debit
+// larger than either balance fails with ErrInsufficientBalance.
+func postLedgerTransaction(tx *gorm.DB, t *types.LedgerTransaction) error {
+	if err := insertLedgerTransaction(tx, t); err != nil {
+		return err
+	}
+
+	var available, pending int64
+	for _, e := range t.Entries {
+		switch e.Account {
+		case types.SellerLedgerAccount(t.SellerID):
+			available += e.Amount
+		case types.SellerPendingLedgerAccount(t.SellerID):
+			pending += e.Amount
+		}
+	}
+	if available == 0 && pending == 0 {
+		return nil
+	}
+
+	result := tx.
+		Model(&types.Seller{}).
+		Where("id = ? AND account_balance + ? >= 0 AND pending_balance + ? >= 0", t.SellerID, available, pending).
+		Updates(map[string]interface{}{
+			"account_balance": gorm.Expr("account_balance + ?", available),
+			"pending_balance": gorm.Expr("pending_balance + ?", pending),
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrInsufficientBalance
+	}
+
+	return nil
+}
+
+func insertLedgerTransaction(tx *gorm.DB, t *types.LedgerTransaction) error {
+	if len(t.Entries) < 2 {
+		return ErrUnbalancedLedgerTransaction
+	}
+
+	var sum int64
+	for _, e := range t.Entries {
+		sum += e.Amount
+	}
+	if sum != 0 {
+		return ErrUnbalancedLedgerTransaction
+	}
+
+	now := time.Now()
+	entries := t.Entries
+	t.Entries = nil
+	t.CreatedAt = now
+	result := tx.
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "idempotency_key"}},
+			DoNothing: true,
+		}).
+		Create(t)
+	t.Entries = entries
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrDuplicateLedgerTransaction
+	}
+
+	for i := range t.Entries {
+		t.Entries[i].TransactionID = t.ID
+		t.Entries[i].CreatedAt = now
+	}
+
+	return tx.
+		Create(&t.Entries).
+		Error
+}
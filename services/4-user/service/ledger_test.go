@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Akihira77/gojobber/services/4-user/types"
+	"gorm.io/gorm"
+)
+
+func clearanceTransaction(key string, amount int64) *types.LedgerTransaction {
+	return &types.LedgerTransaction{
+		Kind:           types.LEDGER_CLEARANCE,
+		SellerID:       testSellerID,
+		IdempotencyKey: key,
+		SourceType:     types.LEDGER_SOURCE_ORDER,
+		SourceID:       "JOorder1",
+		Description:    "Order JOorder1 cleared",
+		Entries: []types.LedgerEntry{
+			{Account: types.SellerPendingLedgerAccount(testSellerID), Amount: -amount},
+			{Account: types.SellerLedgerAccount(testSellerID), Amount: amount},
+		},
+	}
+}
+
+func post(db *gorm.DB, t *types.LedgerTransaction) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		return postLedgerTransaction(tx, t)
+	})
+}
+
+func TestPostLedgerTransactionIsIdempotent(t *testing.T) {
+	db := newTestDB(t, 0, 100)
+
+	if err := post(db, clearanceTransaction("clearance:JOorder1", 80)); err != nil {
+		t.Fatalf("first post: %v", err)
+	}
+	assertBalances(t, db, 80, 20)
+
+	err := post(db, clearanceTransaction("clearance:JOorder1", 80))
+	if !errors.Is(err, ErrDuplicateLedgerTransaction) {
+		t.Fatalf("second post err = %v, want ErrDuplicateLedgerTransaction", err)
+	}
+	assertBalances(t, db, 80, 20)
+
+	if got := countRows(t, db, &types.LedgerTransaction{}); got != 1 {
+		t.Errorf("ledger_transactions = %d, want 1", got)
+	}
+	var entries int64
+	err = db.Raw("SELECT COUNT(*) FROM ledger_entries e JOIN ledger_transactions lt ON lt.id = e.transaction_id").Scan(&entries).Error
+	if err != nil {
+		t.Fatalf("count entries: %v", err)
+	}
+	if entries != 2 {
+		t.Errorf("entries of the transaction = %d, want 2", entries)
+	}
+}
+
+func TestPostLedgerTransactionKeepsBalancesNonNegative(t *testing.T) {
+	db := newTestDB(t, 0, 50)
+
+	err := post(db, clearanceTransaction("clearance:JOorder1", 80))
+	if !errors.Is(err, ErrInsufficientBalance) {
+		t.Fatalf("err = %v, want ErrInsufficientBalance", err)
+	}
+	assertBalances(t, db, 0, 50)
+
+	//INFO: THE ROLLBACK ALSO DROPS THE TRANSACTION, SO THE SAME KEY CAN BE POSTED AGAIN
+	if got := countRows(t, db, &types.LedgerTransaction{}); got != 0 {
+		t.Errorf("ledger_transactions = %d, want 0", got)
+	}
+	if err := post(db, clearanceTransaction("clearance:JOorder1", 50)); err != nil {
+		t.Fatalf("post within balance: %v", err)
+	}
+	assertBalances(t, db, 50, 0)
+}
+
+func TestPostLedgerTransactionRejectsUnbalancedEntries(t *testing.T) {
+	db := newTestDB(t, 0, 100)
+
+	unbalanced := clearanceTransaction("clearance:JOorder1", 80)
+	unbalanced.Entries[1].Amount = 90
+	if err := post(db, unbalanced); !errors.Is(err, ErrUnbalancedLedgerTransaction) {
+		t.Errorf("unbalanced err = %v, want ErrUnbalancedLedgerTransaction", err)
+	}
+
+	single := clearanceTransaction("clearance:JOorder2", 80)
+	single.Entries = single.Entries[:1]
+	single.Entries[0].Amount = 0
+	if err := post(db, single); !errors.Is(err, ErrUnbalancedLedgerTransaction) {
+		t.Errorf("single entry err = %v, want ErrUnbalancedLedgerTransaction", err)
+	}
+
+	assertBalances(t, db, 0, 100)
+	if got := countRows(t, db, &types.LedgerTransaction{}); got != 0 {
+		t.Errorf("ledger_transactions = %d, want 0", got)
+	}
+}
+
+func TestRecordOrderEarningPostsTheServiceFee(t *testing.T) {
+	db := newTestDB(t, 0, 0)
+	ledgerSvc := NewLedgerService(db)
+	ctx := context.Background()
+	clearsAt := time.Now().Add(14 * 24 * time.Hour)
+
+	//INFO: A 100 ORDER PAYS A 3 FEE, 2.5% ROUNDED UP BY THE ORDER SERVICE
+	for i := 0; i < 2; i++ {
+		if err := ledgerSvc.RecordOrderEarning(ctx, testSellerID, "JOorder1", 100, 3, clearsAt); err != nil {
+			t.Fatalf("record earning #%d: %v", i+1, err)
+		}
+	}
+
+	assertBalances(t, db, 0, 97)
+	if got := accountTotal(t, db, types.LEDGER_ACCOUNT_REVENUE); got != 3 {
+		t.Errorf("platform revenue = %d, want 3", got)
+	}
+	if got := accountTotal(t, db, types.SellerPendingLedgerAccount(testSellerID)); got != 97 {
+		t.Errorf("seller pending credit = %d, want 97", got)
+	}
+	if got := accountTotal(t, db, types.LEDGER_ACCOUNT_ESCROW); got != -100 {
+		t.Errorf("escrow = %d, want -100", got)
+	}
+
+	var clearance types.EarningClearance
+	if err := db.First(&clearance, "order_id = ?", "JOorder1").Error; err != nil {
+		t.Fatalf("find clearance: %v", err)
+	}
+	if clearance.Amount != 97 {
+		t.Errorf("clearance amount = %d, want 97", clearance.Amount)
+	}
+}
+
+func TestClearDueOnlyReleasesDueEarnings(t *testing.T) {
+	db := newTestDB(t, 0, 0)
+	ledgerSvc := NewLedgerService(db)
+	ctx := context.Background()
+
+	if err := ledgerSvc.RecordOrderEarning(ctx, testSellerID, "JOpast", 100, 3, time.Now().Add(-time.Minute)); err != nil {
+		t.Fatalf("record past earning: %v", err)
+	}
+	if err := ledgerSvc.RecordOrderEarning(ctx, testSellerID, "JOfuture", 200, 5, time.Now().Add(time.Hour)); err != nil {
+		t.Fatalf("record future earning: %v", err)
+	}
+	assertBalances(t, db, 0, 292)
+
+	cleared, err := ledgerSvc.ClearDue(ctx, 10)
+	if err != nil {
+		t.Fatalf("clear due: %v", err)
+	}
+	if cleared != 1 {
+		t.Errorf("cleared = %d, want 1", cleared)
+	}
+	assertBalances(t, db, 97, 195)
+
+	var future types.EarningClearance
+	if err := db.First(&future, "order_id = ?", "JOfuture").Error; err != nil {
+		t.Fatalf("find future clearance: %v", err)
+	}
+	if future.ClearedAt != nil {
+		t.Errorf("future earning cleared at %v", future.ClearedAt)
+	}
+
+	//INFO: A SECOND RUN HAS NOTHING LEFT TO CLEAR
+	cleared, err = ledgerSvc.ClearDue(ctx, 10)
+	if err != nil {
+		t.Fatalf("clear due again: %v", err)
+	}
+	if cleared != 0 {
+		t.Errorf("cleared again = %d, want 0", cleared)
+	}
+	assertBalances(t, db, 97, 195)
+}
@@ -0,0 +1,229 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Akihira77/gojobber/services/4-user/types"
+	"gorm.io/gorm"
+)
+
+var ErrInsufficientBalance = errors.New("insufficient balance")
+
+type PayoutService struct {
+	db *gorm.DB
+}
+
+type PayoutServiceImpl interface {
+	Request(ctx context.Context, sellerID string, amount uint64, provider string) (*types.Payout, error)
+	FindBySellerID(ctx context.Context, sellerID string, page, size int) ([]types.Payout, int64, error)
+	FindByID(ctx context.Context, sellerID, id string) (*types.Payout, error)
+	ClaimDue(ctx context.Context, limit int, lockFor time.Duration) ([]types.Payout, error)
+	MarkPaid(ctx context.Context, id, providerRef string) error
+	Retry(ctx context.Context, id, reason string, nextAttemptAt time.Time) error
+	MarkFailed(ctx context.Context, id, reason string) (uint64, error)
+}
+
+func NewPayoutService(db *gorm.DB) PayoutServiceImpl {
+	return &PayoutService{
+		db: db,
+	}
+}
+
+// Request reserves the amount from the seller balance and queues the payout
+// in one transaction, two withdrawals racing for the same balance can not
+// both succeed.
+func (ps *PayoutService) Request(ctx context.Context, sellerID string, amount uint64, provider string) (*types.Payout, error) {
+	now := time.Now()
+	p := &types.Payout{
+		SellerID:      sellerID,
+		Amount:        amount,
+		Currency:      "usd",
+		Status:        types.PAYOUT_REQUESTED,
+		Provider:      provider,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	err := ps.db.
+		WithContext(ctx).
+		Transaction(func(tx *gorm.DB) error {
+			err := tx.
+				Model(&types.Payout{}).
+				Create(p).
+				Error
+			if err != nil {
+				return err
+			}
+
+			return postLedgerTransaction(tx, &types.LedgerTransaction{
+				Kind:           types.LEDGER_PAYOUT,
+				SellerID:       sellerID,
+				IdempotencyKey: fmt.Sprintf("payout:%s", p.ID),
+				SourceType:     types.LEDGER_SOURCE_PAYOUT,
+				SourceID:       p.ID.String(),
+				Description:    "Withdrawal requested",
+				Entries: []types.LedgerEntry{
+					{Account: types.SellerLedgerAccount(sellerID), Amount: -int64(amount)},
+					{Account: types.LEDGER_ACCOUNT_PAYOUTS, Amount: int64(amount)},
+				},
+			})
+		})
+
+	return p, err
+}
+
+func (ps *PayoutService) FindBySellerID(ctx context.Context, sellerID string, page, size int) ([]types.Payout, int64, error) {
+	var total int64
+	result := ps.db.
+		WithContext(ctx).
+		Model(&types.Payout{}).
+		Where("seller_id = ?", sellerID).
+		Count(&total)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+
+	var payouts []types.Payout
+	result = ps.db.
+		WithContext(ctx).
+		Model(&types.Payout{}).
+		Where("seller_id = ?", sellerID).
+		Order("created_at DESC").
+		Offset((page - 1) * size).
+		Limit(size).
+		Find(&payouts)
+
+	return payouts, total, result.Error
+}
+
+func (ps *PayoutService) FindByID(ctx context.Context, sellerID, id string) (*types.Payout, error) {
+	var p types.Payout
+	result := ps.db.
+		WithContext(ctx).
+		Model(&types.Payout{}).
+		Where("id = ? AND seller_id = ?", id, sellerID).
+		First(&p)
+
+	return &p, result.Error
+}
+
+// ClaimDue also reclaims payouts whose worker died while processing them,
+// the provider deduplicates the transfer on the payout id.
+func (ps *PayoutService) ClaimDue(ctx context.Context, limit int, lockFor time.Duration) ([]types.Payout, error) {
+	now := time.Now()
+	var payouts []types.Payout
+	result := ps.db.
+		WithContext(ctx).
+		Raw(`
+			UPDATE payouts
+			SET status = ?, attempts = attempts + 1, locked_until = ?, updated_at = ?
+			WHERE id IN (
+				SELECT id FROM payouts
+				WHERE (status = ? AND next_attempt_at <= ?)
+				OR (status = ? AND locked_until < ?)
+				ORDER BY next_attempt_at
+				LIMIT ?
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING *
+		`,
+			types.PAYOUT_PROCESSING, now.Add(lockFor), now,
+			types.PAYOUT_REQUESTED, now,
+			types.PAYOUT_PROCESSING, now,
+			limit,
+		).
+		Scan(&payouts)
+
+	return payouts, result.Error
+}
+
+func (ps *PayoutService) MarkPaid(ctx context.Context, id, providerRef string) error {
+	now := time.Now()
+	result := ps.db.
+		WithContext(ctx).
+		Model(&types.Payout{}).
+		Where("id = ? AND status = ?", id, types.PAYOUT_PROCESSING).
+		Updates(map[string]interface{}{
+			"status":         types.PAYOUT_PAID,
+			"provider_ref":   providerRef,
+			"failure_reason": "",
+			"locked_until":   nil,
+			"paid_at":        now,
+			"updated_at":     now,
+		})
+
+	return result.Error
+}
+
+// Retry puts the payout back in the queue, the reserved balance stays reserved.
+func (ps *PayoutService) Retry(ctx context.Context, id, reason string, nextAttemptAt time.Time) error {
+	result := ps.db.
+		WithContext(ctx).
+		Model(&types.Payout{}).
+		Where("id = ? AND status = ?", id, types.PAYOUT_PROCESSING).
+		Updates(map[string]interface{}{
+			"status":          types.PAYOUT_REQUESTED,
+			"failure_reason":  reason,
+			"locked_until":    nil,
+			"next_attempt_at": nextAttemptAt,
+			"updated_at":      time.Now(),
+		})
+
+	return result.Error
+}
+
+// MarkFailed returns the amount to the seller and reports the new balance.
+// A payout that is no longer processing is left untouched so the balance is
+// never returned twice.
+func (ps *PayoutService) MarkFailed(ctx context.Context, id, reason string) (uint64, error) {
+	var balance uint64
+	err := ps.db.
+		WithContext(ctx).
+		Transaction(func(tx *gorm.DB) error {
+			now := time.Now()
+			var p types.Payout
+			result := tx.
+				Raw(`
+					UPDATE payouts
+					SET status = ?, failure_reason = ?, locked_until = NULL, failed_at = ?, updated_at = ?
+					WHERE id = ? AND status = ?
+					RETURNING *
+				`, types.PAYOUT_FAILED, reason, now, now, id, types.PAYOUT_PROCESSING).
+				Scan(&p)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return gorm.ErrRecordNotFound
+			}
+
+			err := postLedgerTransaction(tx, &types.LedgerTransaction{
+				Kind:           types.LEDGER_PAYOUT_REVERSAL,
+				SellerID:       p.SellerID,
+				IdempotencyKey: fmt.Sprintf("payout:%s:reversal", p.ID),
+				SourceType:     types.LEDGER_SOURCE_PAYOUT,
+				SourceID:       p.ID.String(),
+				Description:    "Withdrawal failed",
+				Entries: []types.LedgerEntry{
+					{Account: types.LEDGER_ACCOUNT_PAYOUTS, Amount: -int64(p.Amount)},
+					{Account: types.SellerLedgerAccount(p.SellerID), Amount: int64(p.Amount)},
+				},
+			})
+			if err != nil {
+				return err
+			}
+
+			return tx.
+				Model(&types.Seller{}).
+				Select("account_balance").
+				Where("id = ?", p.SellerID).
+				Scan(&balance).
+				Error
+		})
+
+	return balance, err
+}
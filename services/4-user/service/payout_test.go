@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Akihira77/gojobber/services/4-user/types"
+	"gorm.io/gorm"
+)
+
+// claim stands in for ClaimDue, which needs Postgres row locking.
+func claim(t *testing.T, db *gorm.DB, p *types.Payout) {
+	t.Helper()
+
+	err := db.
+		Model(&types.Payout{}).
+		Where("id = ?", p.ID).
+		Update("status", types.PAYOUT_PROCESSING).
+		Error
+	if err != nil {
+		t.Fatalf("claim payout: %v", err)
+	}
+}
+
+func findPayout(t *testing.T, db *gorm.DB, p *types.Payout) types.Payout {
+	t.Helper()
+
+	var found types.Payout
+	if err := db.First(&found, "id = ?", p.ID).Error; err != nil {
+		t.Fatalf("find payout: %v", err)
+	}
+
+	return found
+}
+
+func TestPayoutRequestReservesTheBalance(t *testing.T) {
+	db := newTestDB(t, 100, 0)
+	payoutSvc := NewPayoutService(db)
+	ctx := context.Background()
+
+	p, err := payoutSvc.Request(ctx, testSellerID, 60, "fake")
+	if err != nil {
+		t.Fatalf("request: %v", err)
+	}
+	if p.Status != types.PAYOUT_REQUESTED {
+		t.Errorf("status = %s, want %s", p.Status, types.PAYOUT_REQUESTED)
+	}
+	assertBalances(t, db, 40, 0)
+	if got := accountTotal(t, db, types.LEDGER_ACCOUNT_PAYOUTS); got != 60 {
+		t.Errorf("payouts account = %d, want 60", got)
+	}
+
+	//INFO: THE RESERVED AMOUNT IS NO LONGER AVAILABLE FOR ANOTHER WITHDRAWAL
+	_, err = payoutSvc.Request(ctx, testSellerID, 60, "fake")
+	if !errors.Is(err, ErrInsufficientBalance) {
+		t.Fatalf("second request err = %v, want ErrInsufficientBalance", err)
+	}
+	assertBalances(t, db, 40, 0)
+	if got := countRows(t, db, &types.Payout{}); got != 1 {
+		t.Errorf("payouts = %d, want 1", got)
+	}
+}
+
+func TestPayoutMarkPaid(t *testing.T) {
+	db := newTestDB(t, 100, 0)
+	payoutSvc := NewPayoutService(db)
+	ctx := context.Background()
+
+	p, err := payoutSvc.Request(ctx, testSellerID, 60, "fake")
+	if err != nil {
+		t.Fatalf("request: %v", err)
+	}
+
+	//INFO: ONLY A PAYOUT A WORKER IS PROCESSING CAN BE PAID
+	if err := payoutSvc.MarkPaid(ctx, p.ID.String(), "tr_1"); err != nil {
+		t.Fatalf("mark paid: %v", err)
+	}
+	if got := findPayout(t, db, p); got.Status != types.PAYOUT_REQUESTED {
+		t.Errorf("status of an unclaimed payout = %s, want %s", got.Status, types.PAYOUT_REQUESTED)
+	}
+
+	claim(t, db, p)
+	if err := payoutSvc.MarkPaid(ctx, p.ID.String(), "tr_1"); err != nil {
+		t.Fatalf("mark paid: %v", err)
+	}
+
+	got := findPayout(t, db, p)
+	if got.Status != types.PAYOUT_PAID || got.ProviderRef != "tr_1" || got.PaidAt == nil {
+		t.Errorf("payout = %s ref %q paidAt %v, want PAID with tr_1", got.Status, got.ProviderRef, got.PaidAt)
+	}
+	assertBalances(t, db, 40, 0)
+}
+
+func TestPayoutMarkFailedReversesTheReservation(t *testing.T) {
+	db := newTestDB(t, 100, 0)
+	payoutSvc := NewPayoutService(db)
+	ctx := context.Background()
+
+	p, err := payoutSvc.Request(ctx, testSellerID, 60, "fake")
+	if err != nil {
+		t.Fatalf("request: %v", err)
+	}
+	claim(t, db, p)
+
+	balance, err := payoutSvc.MarkFailed(ctx, p.ID.String(), "account closed")
+	if err != nil {
+		t.Fatalf("mark failed: %v", err)
+	}
+	if balance != 100 {
+		t.Errorf("reported balance = %d, want 100", balance)
+	}
+
+	got := findPayout(t, db, p)
+	if got.Status != types.PAYOUT_FAILED || got.FailureReason != "account closed" || got.FailedAt == nil {
+		t.Errorf("payout = %s reason %q failedAt %v, want FAILED with the reason", got.Status, got.FailureReason, got.FailedAt)
+	}
+	assertBalances(t, db, 100, 0)
+	if got := accountTotal(t, db, types.LEDGER_ACCOUNT_PAYOUTS); got != 0 {
+		t.Errorf("payouts account = %d, want 0", got)
+	}
+
+	//INFO: A FAILED PAYOUT IS NOT PROCESSING ANYMORE, THE AMOUNT IS NOT RETURNED TWICE
+	_, err = payoutSvc.MarkFailed(ctx, p.ID.String(), "account closed")
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Fatalf("second mark failed err = %v, want gorm.ErrRecordNotFound", err)
+	}
+	assertBalances(t, db, 100, 0)
+}
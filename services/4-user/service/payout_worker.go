@@ -0,0 +1,189 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/Akihira77/gojobber/services/4-user/helper"
+	"github.com/Akihira77/gojobber/services/4-user/types"
+	"github.com/Akihira77/gojobber/services/common/genproto/notification"
+	"github.com/Akihira77/gojobber/services/common/locale"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type PayoutWorkerConfig struct {
+	Workers      int
+	BatchSize    int
+	MaxAttempts  int
+	PollInterval time.Duration
+	BaseBackoff  time.Duration
+	MaxBackoff   time.Duration
+	LockDuration time.Duration
+}
+
+type PayoutWorker struct {
+	payoutSvc          PayoutServiceImpl
+	sellerSvc          SellerServiceImpl
+	provider           helper.PayoutProvider
+	notificationClient notification.NotificationServiceClient
+	cfg                PayoutWorkerConfig
+}
+
+// NewPayoutWorker accepts a nil notificationClient, the seller is then not
+// notified about the outcome.
+func NewPayoutWorker(payoutSvc PayoutServiceImpl, sellerSvc SellerServiceImpl, provider helper.PayoutProvider, notificationClient notification.NotificationServiceClient, cfg PayoutWorkerConfig) *PayoutWorker {
+	return &PayoutWorker{
+		payoutSvc:          payoutSvc,
+		sellerSvc:          sellerSvc,
+		provider:           provider,
+		notificationClient: notificationClient,
+		cfg:                cfg,
+	}
+}
+
+// Run polls requested payouts and fans them out to a fixed pool of workers
+// until ctx is canceled.
+func (w *PayoutWorker) Run(ctx context.Context) {
+	jobs := make(chan types.Payout, w.cfg.BatchSize)
+
+	var wg sync.WaitGroup
+	for i := 0; i < w.cfg.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for p := range jobs {
+				w.process(ctx, p)
+			}
+		}()
+	}
+
+	ticker := time.NewTicker(w.cfg.PollInterval)
+	defer func() {
+		ticker.Stop()
+		close(jobs)
+		wg.Wait()
+	}()
+
+	log.Printf("payout worker started with [%s] provider and [%d] workers", w.provider.Name(), w.cfg.Workers)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			payouts, err := w.payoutSvc.ClaimDue(ctx, w.cfg.BatchSize, w.cfg.LockDuration)
+			if err != nil {
+				log.Printf("payout worker claiming error:\n%+v", err)
+				continue
+			}
+
+			for _, p := range payouts {
+				jobs <- p
+			}
+		}
+	}
+}
+
+func (w *PayoutWorker) process(ctx context.Context, p types.Payout) {
+	s, err := w.sellerSvc.FindSellerOverviewByID(ctx, "", p.SellerID)
+	if err != nil {
+		w.retryOrFail(ctx, p, s, err)
+		return
+	}
+
+	ref, err := w.provider.Transfer(ctx, helper.PayoutRequest{
+		PayoutID:    p.ID.String(),
+		Destination: s.StripeAccountID,
+		Amount:      p.Amount,
+		Currency:    p.Currency,
+	})
+	if err != nil {
+		w.retryOrFail(ctx, p, s, err)
+		return
+	}
+
+	if err := w.payoutSvc.MarkPaid(ctx, p.ID.String(), ref); err != nil {
+		log.Printf("payout [%s] marking as paid error:\n%+v", p.ID, err)
+		return
+	}
+
+	w.notify(s, notification.NotificationEventType_SELLER_PAYOUT_PAID, map[string]interface{}{
+		"PayoutID": p.ID.String(),
+		"Amount":   p.Amount,
+	})
+}
+
+// retryOrFail gives up right away when the provider rejected the payout,
+// otherwise the payout is retried with backoff until MaxAttempts.
+func (w *PayoutWorker) retryOrFail(ctx context.Context, p types.Payout, s *types.SellerOverview, cause error) {
+	log.Printf("payout [%s] of seller [%s] attempt %d failed:\n%+v", p.ID, p.SellerID, p.Attempts, cause)
+	if !errors.Is(cause, helper.ErrPayoutRejected) && p.Attempts < w.cfg.MaxAttempts {
+		nextAttemptAt := time.Now().Add(w.backoff(p.Attempts))
+		if err := w.payoutSvc.Retry(ctx, p.ID.String(), cause.Error(), nextAttemptAt); err != nil {
+			log.Printf("payout [%s] marking for retry error:\n%+v", p.ID, err)
+		}
+		return
+	}
+
+	balance, err := w.payoutSvc.MarkFailed(ctx, p.ID.String(), cause.Error())
+	if err != nil {
+		log.Printf("payout [%s] marking as failed error:\n%+v", p.ID, err)
+		return
+	}
+
+	if s == nil || s.Email == "" {
+		return
+	}
+
+	w.notify(s, notification.NotificationEventType_SELLER_PAYOUT_FAILED, map[string]interface{}{
+		"PayoutID":       p.ID.String(),
+		"Amount":         p.Amount,
+		"Reason":         cause.Error(),
+		"CurrentBalance": balance,
+	})
+}
+
+func (w *PayoutWorker) notify(s *types.SellerOverview, eventType notification.NotificationEventType, data map[string]interface{}) {
+	if w.notificationClient == nil {
+		return
+	}
+
+	data["PayoutURL"] = fmt.Sprintf("%s/seller/payouts", os.Getenv("CLIENT_URL"))
+	payload, err := structpb.NewStruct(data)
+	if err != nil {
+		log.Printf("payout notification for [%s] error:\n%+v", s.Email, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+
+	_, err = w.notificationClient.Publish(ctx, &notification.NotificationEvent{
+		Type: eventType,
+		Recipients: []*notification.NotificationRecipient{
+			{
+				Email:  s.Email,
+				Locale: locale.FromCountry(s.Country),
+			},
+		},
+		Payload: payload,
+	})
+	if err != nil {
+		log.Printf("payout notification for [%s] error:\n%+v", s.Email, err)
+	}
+}
+
+// backoff doubles the delay on every attempt: base, 2*base, 4*base, ...
+func (w *PayoutWorker) backoff(attempts int) time.Duration {
+	d := time.Duration(float64(w.cfg.BaseBackoff) * math.Pow(2, float64(attempts-1)))
+	if d <= 0 || d > w.cfg.MaxBackoff {
+		return w.cfg.MaxBackoff
+	}
+
+	return d
+}
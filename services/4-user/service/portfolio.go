@@ -0,0 +1,250 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Akihira77/gojobber/services/4-user/types"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrInvalidOrder      = errors.New("order must list every id exactly once")
+	ErrTooManyMediaFiles = errors.New("portfolio item has too many media")
+)
+
+type PortfolioService struct {
+	db *gorm.DB
+}
+
+type PortfolioServiceImpl interface {
+	FindBySellerID(ctx context.Context, sellerID string) ([]types.PortfolioItem, error)
+	FindByID(ctx context.Context, sellerID, id string) (*types.PortfolioItem, error)
+	Create(ctx context.Context, item *types.PortfolioItem) error
+	Update(ctx context.Context, item *types.PortfolioItem, data *types.UpdatePortfolioItemDTO) error
+	Delete(ctx context.Context, item *types.PortfolioItem) error
+	AddMedia(ctx context.Context, item *types.PortfolioItem, media []types.PortfolioMedia) error
+	RemoveMedia(ctx context.Context, item *types.PortfolioItem, mediaID string) (*types.PortfolioMedia, error)
+	ReorderItems(ctx context.Context, sellerID string, ids []string) error
+	ReorderMedia(ctx context.Context, item *types.PortfolioItem, ids []string) error
+}
+
+func NewPortfolioService(db *gorm.DB) PortfolioServiceImpl {
+	return &PortfolioService{
+		db: db,
+	}
+}
+
+func (ps *PortfolioService) FindBySellerID(ctx context.Context, sellerID string) ([]types.PortfolioItem, error) {
+	var items []types.PortfolioItem
+	result := ps.db.
+		WithContext(ctx).
+		Model(&types.PortfolioItem{}).
+		Preload("Media", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position, created_at")
+		}).
+		Where("seller_id = ?", sellerID).
+		Order("position, created_at").
+		Find(&items)
+
+	return items, result.Error
+}
+
+func (ps *PortfolioService) FindByID(ctx context.Context, sellerID, id string) (*types.PortfolioItem, error) {
+	var item types.PortfolioItem
+	result := ps.db.
+		WithContext(ctx).
+		Model(&types.PortfolioItem{}).
+		Preload("Media", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position, created_at")
+		}).
+		Where("id = ? AND seller_id = ?", id, sellerID).
+		First(&item)
+
+	return &item, result.Error
+}
+
+// Create appends the item after the seller's last item, its media keep the
+// order they are given in.
+func (ps *PortfolioService) Create(ctx context.Context, item *types.PortfolioItem) error {
+	if len(item.Media) > types.PORTFOLIO_MAX_MEDIA {
+		return ErrTooManyMediaFiles
+	}
+
+	now := time.Now()
+	item.CreatedAt = now
+	item.UpdatedAt = now
+	for i := range item.Media {
+		item.Media[i].Position = i
+		item.Media[i].CreatedAt = now
+	}
+
+	return ps.db.
+		WithContext(ctx).
+		Transaction(func(tx *gorm.DB) error {
+			result := tx.
+				Model(&types.PortfolioItem{}).
+				Select("COALESCE(MAX(position) + 1, 0)").
+				Where("seller_id = ?", item.SellerID).
+				Scan(&item.Position)
+			if result.Error != nil {
+				return result.Error
+			}
+
+			return tx.
+				Create(item).
+				Error
+		})
+}
+
+func (ps *PortfolioService) Update(ctx context.Context, item *types.PortfolioItem, data *types.UpdatePortfolioItemDTO) error {
+	item.Title = data.Title
+	item.Description = data.Description
+	item.GigID = data.GigID
+	item.UpdatedAt = time.Now()
+
+	return ps.db.
+		WithContext(ctx).
+		Model(item).
+		Select("title", "description", "gig_id", "updated_at").
+		Updates(item).
+		Error
+}
+
+func (ps *PortfolioService) Delete(ctx context.Context, item *types.PortfolioItem) error {
+	return ps.db.
+		WithContext(ctx).
+		Transaction(func(tx *gorm.DB) error {
+			err := tx.
+				Where("portfolio_item_id = ?", item.ID).
+				Delete(&types.PortfolioMedia{}).
+				Error
+			if err != nil {
+				return err
+			}
+
+			return tx.
+				Delete(item).
+				Error
+		})
+}
+
+// AddMedia appends media after the item's existing ones.
+func (ps *PortfolioService) AddMedia(ctx context.Context, item *types.PortfolioItem, media []types.PortfolioMedia) error {
+	if len(item.Media)+len(media) > types.PORTFOLIO_MAX_MEDIA {
+		return ErrTooManyMediaFiles
+	}
+
+	next := 0
+	for _, m := range item.Media {
+		if m.Position >= next {
+			next = m.Position + 1
+		}
+	}
+
+	now := time.Now()
+	for i := range media {
+		media[i].PortfolioItemID = item.ID
+		media[i].Position = next + i
+		media[i].CreatedAt = now
+	}
+
+	err := ps.db.
+		WithContext(ctx).
+		Transaction(func(tx *gorm.DB) error {
+			err := tx.
+				Create(&media).
+				Error
+			if err != nil {
+				return err
+			}
+
+			return tx.
+				Model(item).
+				Update("updated_at", now).
+				Error
+		})
+	if err != nil {
+		return err
+	}
+
+	item.Media = append(item.Media, media...)
+	return nil
+}
+
+func (ps *PortfolioService) RemoveMedia(ctx context.Context, item *types.PortfolioItem, mediaID string) (*types.PortfolioMedia, error) {
+	var m types.PortfolioMedia
+	result := ps.db.
+		WithContext(ctx).
+		Model(&types.PortfolioMedia{}).
+		Where("id = ? AND portfolio_item_id = ?", mediaID, item.ID).
+		First(&m)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	result = ps.db.
+		WithContext(ctx).
+		Delete(&m)
+
+	return &m, result.Error
+}
+
+func (ps *PortfolioService) ReorderItems(ctx context.Context, sellerID string, ids []string) error {
+	var current []string
+	result := ps.db.
+		WithContext(ctx).
+		Model(&types.PortfolioItem{}).
+		Where("seller_id = ?", sellerID).
+		Pluck("id", &current)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	return ps.reorder(ctx, &types.PortfolioItem{}, current, ids)
+}
+
+func (ps *PortfolioService) ReorderMedia(ctx context.Context, item *types.PortfolioItem, ids []string) error {
+	current := make([]string, len(item.Media))
+	for i, m := range item.Media {
+		current[i] = m.ID.String()
+	}
+
+	return ps.reorder(ctx, &types.PortfolioMedia{}, current, ids)
+}
+
+// reorder sets position to the index in ids, ids must be a permutation of
+// current so a stale client can not drop or duplicate rows.
+func (ps *PortfolioService) reorder(ctx context.Context, model interface{}, current, ids []string) error {
+	if len(current) != len(ids) {
+		return ErrInvalidOrder
+	}
+
+	known := make(map[string]bool, len(current))
+	for _, id := range current {
+		known[id] = true
+	}
+	for _, id := range ids {
+		if !known[id] {
+			return ErrInvalidOrder
+		}
+		delete(known, id)
+	}
+
+	return ps.db.
+		WithContext(ctx).
+		Transaction(func(tx *gorm.DB) error {
+			for position, id := range ids {
+				err := tx.
+					Model(model).
+					Where("id = ?", id).
+					Update("position", position).
+					Error
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+}
@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/Akihira77/gojobber/services/4-user/types"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrReportSelf          = errors.New("you can not report yourself")
+	ErrDuplicateReport     = errors.New("you already have an open report about this user")
+	ErrReportAlreadyClosed = errors.New("report has already been reviewed")
+)
+
+type ReportService struct {
+	db *gorm.DB
+}
+
+type ReportServiceImpl interface {
+	Create(ctx context.Context, reporterID string, data *types.CreateUserReportDTO) (*types.UserReport, error)
+	FindQueue(ctx context.Context, status types.ReportStatus, page, size int) ([]types.UserReport, int64, error)
+	FindByID(ctx context.Context, id string) (*types.UserReport, error)
+	Review(ctx context.Context, id, adminID string, data *types.ReviewUserReportDTO) (*types.UserReport, error)
+}
+
+func NewReportService(db *gorm.DB) ReportServiceImpl {
+	return &ReportService{
+		db: db,
+	}
+}
+
+// Create files the report and, when asked, blocks the reported user in the
+// same transaction. A reporter can only have one open report per user,
+// enforced by the idx_user_reports_open partial unique index.
+func (rs *ReportService) Create(ctx context.Context, reporterID string, data *types.CreateUserReportDTO) (*types.UserReport, error) {
+	if reporterID == data.ReportedID {
+		return nil, ErrReportSelf
+	}
+
+	evidence := slices.Compact(slices.Sorted(slices.Values(data.EvidenceMessageIDs)))
+	if evidence == nil {
+		evidence = []string{}
+	}
+
+	now := time.Now()
+	r := &types.UserReport{
+		ReporterID:         reporterID,
+		ReportedID:         data.ReportedID,
+		Category:           data.Category,
+		Description:        data.Description,
+		EvidenceMessageIDs: evidence,
+		Status:             types.REPORT_OPEN,
+		CreatedAt:          now,
+		UpdatedAt:          now,
+	}
+
+	err := rs.db.
+		WithContext(ctx).
+		Transaction(func(tx *gorm.DB) error {
+			result := tx.
+				Clauses(clause.OnConflict{DoNothing: true}).
+				Create(r)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return ErrDuplicateReport
+			}
+
+			if !data.Block {
+				return nil
+			}
+
+			return tx.
+				Clauses(clause.OnConflict{DoNothing: true}).
+				Create(&types.UserBlock{
+					BlockerID: reporterID,
+					BlockedID: data.ReportedID,
+					CreatedAt: now,
+				}).
+				Error
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// FindQueue lists the reports with status, the oldest open ones come first so
+// the admins work through the queue in order.
+func (rs *ReportService) FindQueue(ctx context.Context, status types.ReportStatus, page, size int) ([]types.UserReport, int64, error) {
+	var total int64
+	result := rs.db.
+		WithContext(ctx).
+		Model(&types.UserReport{}).
+		Where("status = ?", status).
+		Count(&total)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+
+	order := "created_at ASC"
+	if status != types.REPORT_OPEN {
+		order = "reviewed_at DESC"
+	}
+
+	reports := []types.UserReport{}
+	result = rs.db.
+		WithContext(ctx).
+		Model(&types.UserReport{}).
+		Where("status = ?", status).
+		Order(order).
+		Offset((page - 1) * size).
+		Limit(size).
+		Find(&reports)
+
+	return reports, total, result.Error
+}
+
+func (rs *ReportService) FindByID(ctx context.Context, id string) (*types.UserReport, error) {
+	var r types.UserReport
+	result := rs.db.
+		WithContext(ctx).
+		Model(&types.UserReport{}).
+		Where("id = ?", id).
+		First(&r)
+
+	return &r, result.Error
+}
+
+func (rs *ReportService) Review(ctx context.Context, id, adminID string, data *types.ReviewUserReportDTO) (*types.UserReport, error) {
+	var r types.UserReport
+	err := rs.db.
+		WithContext(ctx).
+		Transaction(func(tx *gorm.DB) error {
+			result := tx.
+				Clauses(clause.Locking{Strength: "UPDATE"}).
+				Where("id = ?", id).
+				First(&r)
+			if result.Error != nil {
+				return result.Error
+			}
+			if r.Status != types.REPORT_OPEN {
+				return ErrReportAlreadyClosed
+			}
+
+			now := time.Now()
+			r.Status = data.Status
+			r.ResolutionNote = data.ResolutionNote
+			r.ReviewedBy = adminID
+			r.ReviewedAt = &now
+			r.UpdatedAt = now
+			return tx.
+				Model(&r).
+				Select("status", "resolution_note", "reviewed_by", "reviewed_at", "updated_at").
+				Updates(&r).
+				Error
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
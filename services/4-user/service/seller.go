@@ -6,6 +6,8 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
@@ -21,12 +23,16 @@ type SellerService struct {
 type SellerServiceImpl interface {
 	FindSellerByID(ctx context.Context, id string) (*types.SellerDTO, error)
 	FindSellerOverviewByID(ctx context.Context, buyerId, sellerId string) (*types.SellerOverview, error)
+	FindSellerOverviewsByIDs(ctx context.Context, sellerIds []string) ([]types.SellerOverview, error)
 	FindSellerByBuyerID(ctx context.Context, buyerId string) (*types.Seller, error)
 	FindSellerByUsername(ctx context.Context, username string) (*types.SellerDTO, error)
 	GetRandomSellers(ctx context.Context, count int) ([]types.SellerDTO, error)
+	SearchSellers(ctx context.Context, p *types.SellerSearchParams, q *types.SellerSearchQuery) (types.SellerSearchQueryResult, error)
 	Create(ctx context.Context, sellerDataInBuyerDB *types.Buyer, data *types.CreateSellerDTO) (*types.SellerDTO, error)
 	Update(ctx context.Context, updatedSellerData *types.Seller, data *types.UpdateSellerDTO) error
-	UpdateBalance(ctx context.Context, sellerID string, addedBalance uint64) (*types.SellerIncBalanceDTO, error)
+	FindSellerBalanceByID(ctx context.Context, sellerID string) (*types.SellerIncBalanceDTO, error)
+	SetVacation(ctx context.Context, seller *types.Seller, data *types.SellerVacationDTO) error
+	EndVacation(ctx context.Context, seller *types.Seller) error
 }
 
 func NewSellerService(db *gorm.DB) SellerServiceImpl {
@@ -52,7 +58,13 @@ func (ss *SellerService) FindSellerByID(ctx context.Context, id string) (*types.
 			sellers.bio, 
 			sellers.ratings_count, 
 			sellers.rating_sum, 
-			sellers.rating_categories
+			sellers.rating_categories, 
+			sellers.level, 
+			sellers.vacation_start, 
+			sellers.vacation_end, 
+			sellers.vacation_message, 
+			(sellers.vacation_start <= now() AND sellers.vacation_end > now()) IS TRUE AS on_vacation,
+			sellers.verification_status = 'VERIFIED' AS verified
 		`).
 		Joins("INNER JOIN buyers ON buyers.id = sellers.buyer_id").
 		First(&seller, "sellers.id = ?", id)
@@ -61,7 +73,7 @@ func (ss *SellerService) FindSellerByID(ctx context.Context, id string) (*types.
 	}
 
 	var wg sync.WaitGroup
-	goRoutineSize := 5
+	goRoutineSize := 6
 	errCh := make(chan error, goRoutineSize)
 
 	// INFO: 1. GRAB SELLER LANGUAGES
@@ -148,6 +160,27 @@ func (ss *SellerService) FindSellerByID(ctx context.Context, id string) (*types.
 		errCh <- nil
 	}()
 
+	// INFO: 6. GRAB SELLER PORTFOLIO
+	var sellerPortfolio []types.PortfolioItem
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		err := dbExec.
+			Model(&types.PortfolioItem{}).
+			Preload("Media", func(db *gorm.DB) *gorm.DB {
+				return db.Order("position, created_at")
+			}).
+			Where("seller_id = ?", seller.ID).
+			Order("position, created_at").
+			Find(&sellerPortfolio).
+			Error
+		if err != nil {
+			errCh <- err
+			return
+		}
+		errCh <- nil
+	}()
+
 	go func() {
 		wg.Wait()
 		close(errCh)
@@ -167,6 +200,12 @@ func (ss *SellerService) FindSellerByID(ctx context.Context, id string) (*types.
 		RatingsCount:     seller.RatingsCount,
 		RatingSum:        seller.RatingSum,
 		RatingCategories: seller.RatingCategories,
+		Level:            seller.Level,
+		OnVacation:       seller.OnVacation,
+		Verified:         seller.Verified,
+		VacationStart:    seller.VacationStart,
+		VacationEnd:      seller.VacationEnd,
+		VacationMessage:  seller.VacationMessage,
 		Country:          seller.Country,
 		ProfilePicture:   seller.ProfilePicture,
 		Languages:        sellerLanguages,
@@ -174,6 +213,7 @@ func (ss *SellerService) FindSellerByID(ctx context.Context, id string) (*types.
 		Educations:       sellerEducations,
 		Certificates:     sellerCertificates,
 		Experiences:      sellerExperiences,
+		Portfolio:        sellerPortfolio,
 	}, nil
 }
 
@@ -197,9 +237,16 @@ func (ss *SellerService) FindSellerOverviewByID(ctx context.Context, buyerId, se
             sellers.id,
 			sellers.full_name, 
 			buyers.email, 
+			buyers.country,
 			sellers.ratings_count, 
 			sellers.rating_sum, 
 			sellers.rating_categories,
+			sellers.level,
+			sellers.vacation_start,
+			sellers.vacation_end,
+			sellers.vacation_message,
+			(sellers.vacation_start <= now() AND sellers.vacation_end > now()) IS TRUE AS on_vacation,
+			sellers.verification_status = 'VERIFIED' AS verified,
             sellers.stripe_account_id
 		`).
 		Joins("INNER JOIN buyers ON buyers.id = sellers.buyer_id").
@@ -208,6 +255,34 @@ func (ss *SellerService) FindSellerOverviewByID(ctx context.Context, buyerId, se
 	return &seller, result.Error
 }
 
+func (ss *SellerService) FindSellerOverviewsByIDs(ctx context.Context, sellerIds []string) ([]types.SellerOverview, error) {
+	var sellers []types.SellerOverview
+	result := ss.db.
+		WithContext(ctx).
+		Model(&types.Seller{}).
+		Select(`
+			sellers.id,
+			sellers.full_name, 
+			buyers.email, 
+			buyers.country,
+			sellers.ratings_count, 
+			sellers.rating_sum, 
+			sellers.rating_categories,
+			sellers.level,
+			sellers.vacation_start,
+			sellers.vacation_end,
+			sellers.vacation_message,
+			(sellers.vacation_start <= now() AND sellers.vacation_end > now()) IS TRUE AS on_vacation,
+			sellers.verification_status = 'VERIFIED' AS verified,
+			sellers.stripe_account_id
+		`).
+		Joins("INNER JOIN buyers ON buyers.id = sellers.buyer_id").
+		Where("sellers.id IN ?", sellerIds).
+		Find(&sellers)
+
+	return sellers, result.Error
+}
+
 func (ss *SellerService) FindSellerByUsername(ctx context.Context, username string) (*types.SellerDTO, error) {
 	dbExec := ss.db.
 		Debug().
@@ -225,7 +300,13 @@ func (ss *SellerService) FindSellerByUsername(ctx context.Context, username stri
 			sellers.bio, 
 			sellers.ratings_count, 
 			sellers.rating_sum, 
-			sellers.rating_categories
+			sellers.rating_categories, 
+			sellers.level, 
+			sellers.vacation_start, 
+			sellers.vacation_end, 
+			sellers.vacation_message, 
+			(sellers.vacation_start <= now() AND sellers.vacation_end > now()) IS TRUE AS on_vacation,
+			sellers.verification_status = 'VERIFIED' AS verified
 		`).
 		Joins("INNER JOIN buyers ON buyers.id = sellers.buyer_id").
 		First(&seller, "buyers.username = ?", username)
@@ -234,7 +315,7 @@ func (ss *SellerService) FindSellerByUsername(ctx context.Context, username stri
 	}
 
 	var wg sync.WaitGroup
-	goRoutineSize := 5
+	goRoutineSize := 6
 	errCh := make(chan error, goRoutineSize)
 
 	// INFO: 1. GRAB SELLER LANGUAGES
@@ -321,6 +402,27 @@ func (ss *SellerService) FindSellerByUsername(ctx context.Context, username stri
 		errCh <- nil
 	}()
 
+	// INFO: 6. GRAB SELLER PORTFOLIO
+	var sellerPortfolio []types.PortfolioItem
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		err := dbExec.
+			Model(&types.PortfolioItem{}).
+			Preload("Media", func(db *gorm.DB) *gorm.DB {
+				return db.Order("position, created_at")
+			}).
+			Where("seller_id = ?", seller.ID).
+			Order("position, created_at").
+			Find(&sellerPortfolio).
+			Error
+		if err != nil {
+			errCh <- err
+			return
+		}
+		errCh <- nil
+	}()
+
 	go func() {
 		wg.Wait()
 		close(errCh)
@@ -340,6 +442,12 @@ func (ss *SellerService) FindSellerByUsername(ctx context.Context, username stri
 		RatingsCount:     seller.RatingsCount,
 		RatingSum:        seller.RatingSum,
 		RatingCategories: seller.RatingCategories,
+		Level:            seller.Level,
+		OnVacation:       seller.OnVacation,
+		Verified:         seller.Verified,
+		VacationStart:    seller.VacationStart,
+		VacationEnd:      seller.VacationEnd,
+		VacationMessage:  seller.VacationMessage,
 		Country:          seller.Country,
 		ProfilePicture:   seller.ProfilePicture,
 		Languages:        sellerLanguages,
@@ -347,6 +455,7 @@ func (ss *SellerService) FindSellerByUsername(ctx context.Context, username stri
 		Educations:       sellerEducations,
 		Certificates:     sellerCertificates,
 		Experiences:      sellerExperiences,
+		Portfolio:        sellerPortfolio,
 	}, nil
 }
 
@@ -368,7 +477,13 @@ func (ss *SellerService) GetRandomSellers(ctx context.Context, total int) ([]typ
 				sellers.bio, 
 				sellers.ratings_count, 
 				sellers.rating_sum, 
-				sellers.rating_categories
+				sellers.rating_categories, 
+				sellers.level, 
+				sellers.vacation_start, 
+				sellers.vacation_end, 
+				sellers.vacation_message, 
+				(sellers.vacation_start <= now() AND sellers.vacation_end > now()) IS TRUE AS on_vacation,
+				sellers.verification_status = 'VERIFIED' AS verified
 			FROM sellers 
 			TABLESAMPLE SYSTEM_ROWS(?)
 			INNER JOIN buyers ON buyers.id = sellers.buyer_id
@@ -480,6 +595,12 @@ func (ss *SellerService) GetRandomSellers(ctx context.Context, total int) ([]typ
 			RatingsCount:     sellers[i].RatingsCount,
 			RatingSum:        sellers[i].RatingSum,
 			RatingCategories: sellers[i].RatingCategories,
+			Level:            sellers[i].Level,
+			OnVacation:       sellers[i].OnVacation,
+			Verified:         sellers[i].Verified,
+			VacationStart:    sellers[i].VacationStart,
+			VacationEnd:      sellers[i].VacationEnd,
+			VacationMessage:  sellers[i].VacationMessage,
 			ProfilePicture:   sellers[i].ProfilePicture,
 			Country:          sellers[i].Country,
 			Languages:        sellerLanguages,
@@ -493,6 +614,196 @@ func (ss *SellerService) GetRandomSellers(ctx context.Context, total int) ([]typ
 	return sellers, result.Error
 }
 
+func (ss *SellerService) SearchSellers(ctx context.Context, p *types.SellerSearchParams, q *types.SellerSearchQuery) (types.SellerSearchQueryResult, error) {
+	dbExec := ss.db.
+		Debug().
+		WithContext(ctx)
+
+	var total int64
+	result := dbExec.
+		Model(&types.Seller{}).
+		Count(&total)
+	if result.Error != nil {
+		return types.SellerSearchQueryResult{}, result.Error
+	}
+
+	//NOTE: FILTERS ARE MATCHED ON THE CANONICAL TERMS SO "golang" FINDS "Go" SELLERS
+	skillIDs, ok, err := resolveTaxonomyIDs(dbExec, types.TAXONOMY_SKILL, splitSearchList(q.Skills))
+	if err != nil {
+		return types.SellerSearchQueryResult{}, err
+	}
+	languageIDs, found, err := resolveTaxonomyIDs(dbExec, types.TAXONOMY_LANGUAGE, splitSearchList(q.Languages))
+	if err != nil {
+		return types.SellerSearchQueryResult{}, err
+	}
+	if !ok || !found {
+		return types.SellerSearchQueryResult{
+			Sellers: []types.SellerDTO{},
+			Total:   total,
+		}, nil
+	}
+
+	filtered := func() *gorm.DB {
+		query := dbExec.
+			Model(&types.Seller{}).
+			Joins("INNER JOIN buyers ON buyers.id = sellers.buyer_id")
+
+		if q.Query != "" {
+			like := "%" + escapeLike(strings.TrimSpace(q.Query)) + "%"
+			query = query.Where("sellers.full_name ILIKE ? OR sellers.bio ILIKE ?", like, like)
+		}
+
+		if len(skillIDs) > 0 {
+			query = query.Where(`
+				sellers.id IN (
+					SELECT seller_id
+					FROM seller_skills
+					WHERE skill_id IN ?
+					GROUP BY seller_id
+					HAVING COUNT(DISTINCT skill_id) = ?
+				)`, skillIDs, len(skillIDs))
+		}
+
+		if len(languageIDs) > 0 {
+			query = query.Where(`
+				sellers.id IN (
+					SELECT seller_id
+					FROM seller_languages
+					WHERE language_id IN ?
+					GROUP BY seller_id
+					HAVING COUNT(DISTINCT language_id) = ?
+				)`, languageIDs, len(languageIDs))
+		}
+
+		if q.Country != "" {
+			query = query.Where("LOWER(buyers.country) = ?", strings.ToLower(strings.TrimSpace(q.Country)))
+		}
+
+		if q.MinRating > 0 {
+			query = query.Where("sellers.ratings_count > 0 AND sellers.rating_sum::float / sellers.ratings_count >= ?", q.MinRating)
+		}
+
+		if q.MinRatingsCount > 0 {
+			query = query.Where("sellers.ratings_count >= ?", q.MinRatingsCount)
+		}
+
+		if q.VerifiedOnly {
+			query = query.Where("sellers.verification_status = ?", types.VERIFICATION_VERIFIED)
+		}
+
+		return query
+	}
+
+	var matched int64
+	result = filtered().Count(&matched)
+	if result.Error != nil {
+		return types.SellerSearchQueryResult{}, result.Error
+	}
+
+	orderClause := `
+		CASE WHEN sellers.ratings_count = 0 THEN 0 ELSE sellers.rating_sum::float / sellers.ratings_count END DESC,
+		sellers.ratings_count DESC,
+		sellers.id`
+	if q.Sort == types.SELLER_SORT_NEWEST {
+		orderClause = "sellers.created_at DESC, sellers.id"
+	}
+
+	var sellers []types.SellerDTO
+	result = filtered().
+		Select(`
+			sellers.id, 
+			sellers.full_name, 
+			buyers.email, 
+			buyers.country, 
+			buyers.profile_picture, 
+			sellers.bio, 
+			sellers.ratings_count, 
+			sellers.rating_sum, 
+			sellers.rating_categories, 
+			sellers.level, 
+			sellers.vacation_start, 
+			sellers.vacation_end, 
+			sellers.vacation_message, 
+			(sellers.vacation_start <= now() AND sellers.vacation_end > now()) IS TRUE AS on_vacation,
+			sellers.verification_status = 'VERIFIED' AS verified
+		`).
+		Order(orderClause).
+		Offset((p.Page - 1) * p.Size).
+		Limit(p.Size).
+		Scan(&sellers)
+	if result.Error != nil {
+		return types.SellerSearchQueryResult{}, result.Error
+	}
+
+	if len(sellers) > 0 {
+		ids := make([]string, len(sellers))
+		for i := range sellers {
+			ids[i] = sellers[i].ID
+		}
+
+		//INFO: GRAB SKILLS AND LANGUAGES OF THE WHOLE PAGE AT ONCE
+		var sellerSkills []types.SellerSkillDTO
+		result = dbExec.
+			Table("seller_skills").
+			Select("seller_skills.seller_id, seller_skills.skill_id, skills.name AS skill").
+			Joins("INNER JOIN skills ON skills.id = seller_skills.skill_id").
+			Where("seller_skills.seller_id IN ?", ids).
+			Scan(&sellerSkills)
+		if result.Error != nil {
+			return types.SellerSearchQueryResult{}, result.Error
+		}
+
+		var sellerLanguages []types.SellerLanguageDTO
+		result = dbExec.
+			Table("seller_languages").
+			Select("seller_languages.seller_id, seller_languages.language_id, languages.language").
+			Joins("INNER JOIN languages ON languages.id = seller_languages.language_id").
+			Where("seller_languages.seller_id IN ?", ids).
+			Scan(&sellerLanguages)
+		if result.Error != nil {
+			return types.SellerSearchQueryResult{}, result.Error
+		}
+
+		index := make(map[string]int, len(sellers))
+		for i := range sellers {
+			index[sellers[i].ID] = i
+			sellers[i].Skills = []types.Skill{}
+			sellers[i].Languages = []types.Language{}
+		}
+		for _, sk := range sellerSkills {
+			i := index[sk.SellerID]
+			sellers[i].Skills = append(sellers[i].Skills, types.Skill{ID: sk.SkillID, Name: sk.Skill})
+		}
+		for _, l := range sellerLanguages {
+			i := index[l.SellerID]
+			sellers[i].Languages = append(sellers[i].Languages, types.Language{ID: l.LanguageID, Language: l.Language})
+		}
+	}
+
+	return types.SellerSearchQueryResult{
+		Total:   total,
+		Matched: matched,
+		Count:   len(sellers),
+		Sellers: sellers,
+	}, nil
+}
+
+// splitSearchList turns "Go, react,go" into ["go", "react"].
+func splitSearchList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		item = strings.ToLower(strings.TrimSpace(item))
+		if item != "" && !slices.Contains(items, item) {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
+
 func (ss *SellerService) Create(ctx context.Context, sellerDataInBuyerDB *types.Buyer, data *types.CreateSellerDTO) (*types.SellerDTO, error) {
 	tx := ss.db.
 		Debug().
@@ -514,6 +825,7 @@ func (ss *SellerService) Create(ctx context.Context, sellerDataInBuyerDB *types.
 			Five:  0,
 		},
 		StripeAccountID: data.StripeAccountID,
+		Level:           types.SELLER_LEVEL_NEW,
 		AccountBalance:  0,
 	}
 	result := tx.
@@ -529,18 +841,12 @@ func (ss *SellerService) Create(ctx context.Context, sellerDataInBuyerDB *types.
 	var certificates []types.CertificateDTO
 
 	//INFO: 1. SAVE LANGUAGES
-	for _, lang := range data.Languages {
-		result = tx.
-			Model(&types.Language{}).
-			First(&lang, "id = ?", lang.ID)
-		if result.Error != nil {
-			tx.Rollback()
-			if errors.Is(result.Error, gorm.ErrRecordNotFound) {
-				return &types.SellerDTO{}, errors.New("invalid language")
-			}
-
-			return &types.SellerDTO{}, fmt.Errorf("Error finding language. %v", result.Error)
-		}
+	languages, err := normalizeLanguages(tx, data.Languages)
+	if err != nil {
+		tx.Rollback()
+		return &types.SellerDTO{}, err
+	}
+	for _, lang := range languages {
 		result = tx.
 			Model(&types.SellerLanguage{}).
 			Create(&types.SellerLanguage{
@@ -554,14 +860,12 @@ func (ss *SellerService) Create(ctx context.Context, sellerDataInBuyerDB *types.
 	}
 
 	//INFO: 2. SAVE SKILLS
-	for _, skill := range data.Skills {
-		result = tx.
-			Model(&types.Skill{}).
-			FirstOrCreate(&skill, "name = ?", skill.Name)
-		if result.Error != nil {
-			return &types.SellerDTO{}, fmt.Errorf("Error saving skills %v", result.Error)
-		}
-
+	skills, err := normalizeSkills(tx, data.Skills)
+	if err != nil {
+		tx.Rollback()
+		return &types.SellerDTO{}, err
+	}
+	for _, skill := range skills {
 		result = tx.
 			Model(&types.SellerSkill{}).
 			Create(&types.SellerSkill{
@@ -683,9 +987,10 @@ func (ss *SellerService) Create(ctx context.Context, sellerDataInBuyerDB *types.
 		RatingSum:        seller.RatingSum,
 		RatingsCount:     seller.RatingsCount,
 		RatingCategories: seller.RatingCategories,
+		Level:            seller.Level,
 		ProfilePicture:   sellerDataInBuyerDB.ProfilePicture,
-		Languages:        data.Languages,
-		Skills:           data.Skills,
+		Languages:        languages,
+		Skills:           skills,
 		Educations:       educations,
 		Certificates:     certificates,
 		Experiences:      experiences,
@@ -702,9 +1007,15 @@ func (ss *SellerService) Update(ctx context.Context, updatedSellerData *types.Se
 	var certificates []types.CertificateDTO
 	var result *gorm.DB
 
-	//INFO: 1. UPDATE SELLER'S DATA
+	//INFO: 1. UPDATE SELLER'S PROFILE COLUMNS ONLY. BALANCES (LEDGER), LEVEL, VACATION AND
+	// VERIFICATION STATUS HAVE THEIR OWN FLOWS AND MAY HAVE CHANGED SINCE THE SELLER WAS LOADED
+	updatedSellerData.FullName = data.FullName
+	updatedSellerData.Bio = data.Bio
 	result = tx.
-		Save(updatedSellerData)
+		Model(&types.Seller{}).
+		Where("id = ?", updatedSellerData.ID).
+		Select("full_name", "bio").
+		Updates(updatedSellerData)
 	if result.Error != nil {
 		tx.Rollback()
 		return fmt.Errorf("Error updating seller data. %v", result.Error)
@@ -720,7 +1031,12 @@ func (ss *SellerService) Update(ctx context.Context, updatedSellerData *types.Se
 		return fmt.Errorf("Error deleting sellerLanguages data. %v", result.Error)
 	}
 
-	for _, lang := range data.Languages {
+	languages, err := normalizeLanguages(tx, data.Languages)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	for _, lang := range languages {
 		result = tx.
 			Model(&types.SellerLanguage{}).
 			Create(&types.SellerLanguage{
@@ -743,15 +1059,12 @@ func (ss *SellerService) Update(ctx context.Context, updatedSellerData *types.Se
 		return fmt.Errorf("Error deleting sellerSkills data. %v", result.Error)
 	}
 
-	for _, skill := range data.Skills {
-		result = tx.
-			Model(&types.Skill{}).
-			FirstOrCreate(&skill, "name = ?", skill.Name)
-		if result.Error != nil {
-			tx.Rollback()
-			return fmt.Errorf("Error updating skills data. %v", result.Error)
-		}
-
+	skills, err := normalizeSkills(tx, data.Skills)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	for _, skill := range skills {
 		result = tx.
 			Model(&types.SellerSkill{}).
 			Create(&types.SellerSkill{
@@ -882,10 +1195,12 @@ func (ss *SellerService) Update(ctx context.Context, updatedSellerData *types.Se
 	return result.Error
 }
 
-func (ss *SellerService) UpdateBalance(ctx context.Context, sellerID string, addedBalance uint64) (*types.SellerIncBalanceDTO, error) {
+// FindSellerBalanceByID is read only, balance changes go through LedgerService.
+func (ss *SellerService) FindSellerBalanceByID(ctx context.Context, sellerID string) (*types.SellerIncBalanceDTO, error) {
 	type Result struct {
 		types.Seller
-		Email string `json:"email" gorm:"email"`
+		Email   string `json:"email" gorm:"email"`
+		Country string `json:"country" gorm:"country"`
 	}
 	var resultData Result
 
@@ -893,12 +1208,11 @@ func (ss *SellerService) UpdateBalance(ctx context.Context, sellerID string, add
 		Debug().
 		WithContext(ctx).
 		Raw(`
-            UPDATE sellers
-            SET account_balance = account_balance + ?
-            FROM buyers
-            WHERE sellers.id = ? AND buyers.id = sellers.buyer_id
-            RETURNING sellers.*, buyers.email AS email
-        `, addedBalance, sellerID).
+            SELECT sellers.*, buyers.email AS email, buyers.country AS country
+            FROM sellers
+            INNER JOIN buyers ON buyers.id = sellers.buyer_id
+            WHERE sellers.id = ?
+        `, sellerID).
 		Scan(&resultData)
 
 	if resultData.Email == "" {
@@ -909,6 +1223,7 @@ func (ss *SellerService) UpdateBalance(ctx context.Context, sellerID string, add
 		ID:               resultData.Seller.ID,
 		FullName:         resultData.Seller.FullName,
 		Email:            resultData.Email,
+		Country:          resultData.Country,
 		Bio:              resultData.Seller.Bio,
 		AccountBalance:   resultData.Seller.AccountBalance,
 		RatingSum:        resultData.Seller.RatingSum,
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/Akihira77/gojobber/services/4-user/types"
+	"github.com/Akihira77/gojobber/services/common/genproto/order"
+	"github.com/Akihira77/gojobber/services/common/genproto/review"
+	"gorm.io/gorm"
+)
+
+type SellerLevelService struct {
+	db           *gorm.DB
+	orderClient  order.OrderServiceClient
+	reviewClient review.ReviewServiceClient
+}
+
+type SellerLevelServiceImpl interface {
+	RecomputeLevels(ctx context.Context, afterID string, limit int) (string, int, error)
+}
+
+func NewSellerLevelService(db *gorm.DB, orderClient order.OrderServiceClient, reviewClient review.ReviewServiceClient) SellerLevelServiceImpl {
+	return &SellerLevelService{
+		db:           db,
+		orderClient:  orderClient,
+		reviewClient: reviewClient,
+	}
+}
+
+// RecomputeLevels recomputes the level of up to limit sellers ordered by id
+// after afterID. It returns the last seller id of the page and the page size,
+// a page smaller than limit means every seller has been visited.
+func (sls *SellerLevelService) RecomputeLevels(ctx context.Context, afterID string, limit int) (string, int, error) {
+	var sellers []types.Seller
+	result := sls.db.
+		WithContext(ctx).
+		Model(&types.Seller{}).
+		Select("id, level, created_at").
+		Where("id > ?", afterID).
+		Order("id").
+		Limit(limit).
+		Find(&sellers)
+	if result.Error != nil || len(sellers) == 0 {
+		return afterID, 0, result.Error
+	}
+
+	ids := make([]string, len(sellers))
+	for i, s := range sellers {
+		ids[i] = s.ID
+	}
+
+	orderStats, err := sls.orderClient.FindSellerOrderStats(ctx, &order.FindSellerOrderStatsRequest{
+		SellerIds: ids,
+	})
+	if err != nil {
+		return afterID, 0, err
+	}
+
+	ratingStats, err := sls.reviewClient.FindSellerRatingStats(ctx, &review.FindSellerRatingStatsRequest{
+		SellerIds: ids,
+	})
+	if err != nil {
+		return afterID, 0, err
+	}
+
+	now := time.Now()
+	changed := make(map[types.SellerLevel][]string)
+	for _, s := range sellers {
+		var p types.SellerPerformance
+		if o, ok := orderStats.Stats[s.ID]; ok {
+			p.FinishedOrders = o.FinishedOrders
+			p.CompletedOrders = o.CompletedOrders
+			p.OnTimeOrders = o.OnTimeOrders
+			p.CanceledOrders = o.CanceledOrders
+		}
+		if r, ok := ratingStats.Stats[s.ID]; ok {
+			p.RatingsCount = r.RatingsCount
+			p.RatingSum = r.RatingSum
+		}
+
+		level := types.ComputeSellerLevel(p, now.Sub(s.CreatedAt))
+		if level != s.Level {
+			changed[level] = append(changed[level], s.ID)
+		}
+	}
+
+	for level, sellerIDs := range changed {
+		result = sls.db.
+			WithContext(ctx).
+			Model(&types.Seller{}).
+			Where("id IN ?", sellerIDs).
+			Update("level", level)
+		if result.Error != nil {
+			return afterID, 0, result.Error
+		}
+	}
+
+	return sellers[len(sellers)-1].ID, len(sellers), nil
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+type SellerLevelWorkerConfig struct {
+	BatchSize    int
+	PollInterval time.Duration
+}
+
+// SellerLevelWorker periodically recomputes every seller level from their
+// order and review performance.
+type SellerLevelWorker struct {
+	sellerLevelSvc SellerLevelServiceImpl
+	cfg            SellerLevelWorkerConfig
+}
+
+func NewSellerLevelWorker(sellerLevelSvc SellerLevelServiceImpl, cfg SellerLevelWorkerConfig) *SellerLevelWorker {
+	return &SellerLevelWorker{
+		sellerLevelSvc: sellerLevelSvc,
+		cfg:            cfg,
+	}
+}
+
+// Run recomputes the levels on every tick until ctx is canceled.
+func (w *SellerLevelWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.cfg.PollInterval)
+	defer ticker.Stop()
+
+	log.Printf("seller level worker started, polling every [%s]", w.cfg.PollInterval)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.recomputeAll(ctx)
+		}
+	}
+}
+
+func (w *SellerLevelWorker) recomputeAll(ctx context.Context) {
+	afterID := ""
+	visited := 0
+	for {
+		lastID, count, err := w.sellerLevelSvc.RecomputeLevels(ctx, afterID, w.cfg.BatchSize)
+		if err != nil {
+			log.Printf("seller level worker error:\n%+v", err)
+			return
+		}
+
+		visited += count
+		if count < w.cfg.BatchSize {
+			log.Printf("seller level worker recomputed [%d] sellers", visited)
+			return
+		}
+		afterID = lastID
+	}
+}
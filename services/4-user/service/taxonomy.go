@@ -0,0 +1,552 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/Akihira77/gojobber/services/4-user/types"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrInvalidTaxonomyName   = errors.New("name has no letters or digits")
+	ErrDuplicateTaxonomyName = errors.New("name is already used by another term")
+	ErrInvalidMerge          = errors.New("merge target can not be one of its sources")
+	ErrInvalidLanguage       = errors.New("invalid language")
+)
+
+// taxonomyTable describes where the terms of a kind and their seller links
+// live, the names are fixed so they are safe to format into SQL.
+type taxonomyTable struct {
+	table      string
+	nameColumn string
+	joinTable  string
+	joinColumn string
+}
+
+var taxonomyTables = map[types.TaxonomyKind]taxonomyTable{
+	types.TAXONOMY_SKILL: {
+		table:      "skills",
+		nameColumn: "name",
+		joinTable:  "seller_skills",
+		joinColumn: "skill_id",
+	},
+	types.TAXONOMY_LANGUAGE: {
+		table:      "languages",
+		nameColumn: "language",
+		joinTable:  "seller_languages",
+		joinColumn: "language_id",
+	},
+}
+
+type TaxonomyService struct {
+	db *gorm.DB
+}
+
+type TaxonomyServiceImpl interface {
+	Autocomplete(ctx context.Context, kind types.TaxonomyKind, query string, limit int) ([]types.TaxonomySuggestion, error)
+	FindTerm(ctx context.Context, kind types.TaxonomyKind, id uint) (*types.TaxonomyTerm, error)
+	CreateTerm(ctx context.Context, kind types.TaxonomyKind, data *types.CreateTaxonomyTermDTO) (*types.TaxonomyTerm, error)
+	AddAlias(ctx context.Context, kind types.TaxonomyKind, id uint, alias string) error
+	RemoveAlias(ctx context.Context, kind types.TaxonomyKind, id uint, alias string) error
+	Merge(ctx context.Context, kind types.TaxonomyKind, sourceIDs []uint, targetID uint) (int64, error)
+	BackfillSlugs(ctx context.Context) (int, error)
+}
+
+func NewTaxonomyService(db *gorm.DB) TaxonomyServiceImpl {
+	return &TaxonomyService{
+		db: db,
+	}
+}
+
+// Autocomplete ranks terms whose name or alias starts with or resembles the
+// query, prefix matches come first.
+func (ts *TaxonomyService) Autocomplete(ctx context.Context, kind types.TaxonomyKind, query string, limit int) ([]types.TaxonomySuggestion, error) {
+	suggestions := []types.TaxonomySuggestion{}
+	key := types.NormalizeTaxonomyName(query)
+	if key == "" {
+		return suggestions, nil
+	}
+
+	t := taxonomyTables[kind]
+	prefix := escapeLike(key) + "%"
+	result := ts.db.
+		WithContext(ctx).
+		Raw(fmt.Sprintf(`
+			SELECT id, name, MAX(score) AS score
+			FROM (
+				SELECT t.id, t.%[2]s AS name,
+					CASE WHEN t.slug LIKE ? THEN 1 ELSE similarity(t.slug, ?) END AS score
+				FROM %[1]s t
+				WHERE t.slug LIKE ? OR t.slug %% ?
+				UNION ALL
+				SELECT t.id, t.%[2]s AS name,
+					CASE WHEN a.alias LIKE ? THEN 1 ELSE similarity(a.alias, ?) END AS score
+				FROM taxonomy_aliases a
+				INNER JOIN %[1]s t ON t.id = a.target_id
+				WHERE a.kind = ? AND (a.alias LIKE ? OR a.alias %% ?)
+			) matches
+			GROUP BY id, name
+			ORDER BY score DESC, name
+			LIMIT ?`, t.table, t.nameColumn),
+			prefix, key, prefix, key,
+			prefix, key, kind, prefix, key,
+			limit).
+		Scan(&suggestions)
+
+	return suggestions, result.Error
+}
+
+func (ts *TaxonomyService) FindTerm(ctx context.Context, kind types.TaxonomyKind, id uint) (*types.TaxonomyTerm, error) {
+	return findTaxonomyTerm(ts.db.WithContext(ctx), kind, id)
+}
+
+func (ts *TaxonomyService) CreateTerm(ctx context.Context, kind types.TaxonomyKind, data *types.CreateTaxonomyTermDTO) (*types.TaxonomyTerm, error) {
+	name := types.CleanTaxonomyName(data.Name)
+	key := types.NormalizeTaxonomyName(name)
+	if key == "" {
+		return nil, ErrInvalidTaxonomyName
+	}
+
+	var term *types.TaxonomyTerm
+	err := ts.db.
+		WithContext(ctx).
+		Transaction(func(tx *gorm.DB) error {
+			if _, err := resolveTaxonomyTerm(tx, kind, key, false); !errors.Is(err, gorm.ErrRecordNotFound) {
+				if err == nil {
+					return ErrDuplicateTaxonomyName
+				}
+				return err
+			}
+
+			id, err := insertTaxonomyTerm(tx, kind, name, key)
+			if err != nil {
+				return err
+			}
+
+			for _, alias := range data.Aliases {
+				if err := addTaxonomyAlias(tx, kind, id, alias); err != nil {
+					return err
+				}
+			}
+
+			term, err = findTaxonomyTerm(tx, kind, id)
+			return err
+		})
+
+	return term, err
+}
+
+func (ts *TaxonomyService) AddAlias(ctx context.Context, kind types.TaxonomyKind, id uint, alias string) error {
+	return ts.db.
+		WithContext(ctx).
+		Transaction(func(tx *gorm.DB) error {
+			return addTaxonomyAlias(tx, kind, id, alias)
+		})
+}
+
+func (ts *TaxonomyService) RemoveAlias(ctx context.Context, kind types.TaxonomyKind, id uint, alias string) error {
+	result := ts.db.
+		WithContext(ctx).
+		Where("kind = ? AND target_id = ? AND alias = ?", kind, id, types.NormalizeTaxonomyName(alias)).
+		Delete(&types.TaxonomyAlias{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// Merge re-points the sellers of the source terms to the target and removes
+// the sources, it returns how many seller links were moved.
+func (ts *TaxonomyService) Merge(ctx context.Context, kind types.TaxonomyKind, sourceIDs []uint, targetID uint) (int64, error) {
+	var moved int64
+	err := ts.db.
+		WithContext(ctx).
+		Transaction(func(tx *gorm.DB) error {
+			var err error
+			moved, err = mergeTaxonomyTerms(tx, kind, sourceIDs, targetID)
+			return err
+		})
+
+	return moved, err
+}
+
+// BackfillSlugs fills the slug of terms written before the taxonomy existed,
+// terms that normalize to the same slug are merged into the oldest one.
+func (ts *TaxonomyService) BackfillSlugs(ctx context.Context) (int, error) {
+	filled := 0
+	for kind, t := range taxonomyTables {
+		var terms []types.TaxonomySuggestion
+		result := ts.db.
+			WithContext(ctx).
+			Raw(fmt.Sprintf(`SELECT id, %s AS name FROM %s WHERE slug = '' ORDER BY id`, t.nameColumn, t.table)).
+			Scan(&terms)
+		if result.Error != nil {
+			return filled, result.Error
+		}
+
+		for _, term := range terms {
+			err := ts.db.
+				WithContext(ctx).
+				Transaction(func(tx *gorm.DB) error {
+					key := types.NormalizeTaxonomyName(term.Name)
+					if key == "" {
+						//NOTE: NOTHING TO NORMALIZE, ":" CAN NOT APPEAR IN A REAL SLUG
+						key = fmt.Sprintf("id:%d", term.ID)
+					}
+
+					var ownerID uint
+					result := tx.
+						Table(t.table).
+						Select("id").
+						Where("slug = ?", key).
+						Limit(1).
+						Scan(&ownerID)
+					if result.Error != nil {
+						return result.Error
+					}
+					if ownerID != 0 {
+						_, err := mergeTaxonomyTerms(tx, kind, []uint{term.ID}, ownerID)
+						return err
+					}
+
+					return tx.
+						Table(t.table).
+						Where("id = ?", term.ID).
+						Update("slug", key).
+						Error
+				})
+			if err != nil {
+				return filled, err
+			}
+			filled++
+		}
+	}
+
+	return filled, nil
+}
+
+func findTaxonomyTerm(tx *gorm.DB, kind types.TaxonomyKind, id uint) (*types.TaxonomyTerm, error) {
+	t := taxonomyTables[kind]
+
+	var term types.TaxonomyTerm
+	result := tx.
+		Table(t.table).
+		Select(fmt.Sprintf("id, %s AS name", t.nameColumn)).
+		Where("id = ?", id).
+		Take(&term)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	term.Aliases = []string{}
+	result = tx.
+		Model(&types.TaxonomyAlias{}).
+		Where("kind = ? AND target_id = ?", kind, id).
+		Order("alias").
+		Pluck("alias", &term.Aliases)
+
+	return &term, result.Error
+}
+
+func insertTaxonomyTerm(tx *gorm.DB, kind types.TaxonomyKind, name, key string) (uint, error) {
+	t := taxonomyTables[kind]
+
+	var id uint
+	result := tx.
+		Raw(fmt.Sprintf(`INSERT INTO %s (%s, slug) VALUES (?, ?) RETURNING id`, t.table, t.nameColumn), name, key).
+		Scan(&id)
+
+	return id, result.Error
+}
+
+func addTaxonomyAlias(tx *gorm.DB, kind types.TaxonomyKind, id uint, alias string) error {
+	key := types.NormalizeTaxonomyName(alias)
+	if key == "" {
+		return ErrInvalidTaxonomyName
+	}
+
+	if _, err := findTaxonomyTerm(tx, kind, id); err != nil {
+		return err
+	}
+
+	ownerID, err := resolveTaxonomyTerm(tx, kind, key, false)
+	if err == nil {
+		if ownerID == id {
+			return nil
+		}
+		return ErrDuplicateTaxonomyName
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+
+	return tx.
+		Create(&types.TaxonomyAlias{
+			Kind:      kind,
+			Alias:     key,
+			TargetID:  id,
+			CreatedAt: time.Now(),
+		}).
+		Error
+}
+
+// resolveTaxonomyTerm finds the term a normalized key stands for, by slug
+// first, then alias and, when fuzzy, the most similar slug or alias above
+// TAXONOMY_SIMILARITY_THRESHOLD.
+func resolveTaxonomyTerm(tx *gorm.DB, kind types.TaxonomyKind, key string, fuzzy bool) (uint, error) {
+	if key == "" {
+		return 0, gorm.ErrRecordNotFound
+	}
+
+	t := taxonomyTables[kind]
+
+	var id uint
+	result := tx.
+		Raw(fmt.Sprintf(`
+			SELECT id FROM %s WHERE slug = ?
+			UNION ALL
+			SELECT target_id FROM taxonomy_aliases WHERE kind = ? AND alias = ?
+			LIMIT 1`, t.table),
+			key, kind, key).
+		Scan(&id)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	if id != 0 || !fuzzy {
+		if id == 0 {
+			return 0, gorm.ErrRecordNotFound
+		}
+		return id, nil
+	}
+
+	result = tx.
+		Raw(fmt.Sprintf(`
+			SELECT id FROM (
+				SELECT id, similarity(slug, ?) AS score FROM %s WHERE slug %% ?
+				UNION ALL
+				SELECT target_id, similarity(alias, ?) AS score FROM taxonomy_aliases WHERE kind = ? AND alias %% ?
+			) matches
+			WHERE score >= ?
+			ORDER BY score DESC
+			LIMIT 1`, t.table),
+			key, key, key, kind, key, types.TAXONOMY_SIMILARITY_THRESHOLD).
+		Scan(&id)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	if id == 0 {
+		return 0, gorm.ErrRecordNotFound
+	}
+
+	return id, nil
+}
+
+// resolveTaxonomyIDs maps names on term ids by slug or alias only, ok is
+// false when one of them is unknown.
+func resolveTaxonomyIDs(tx *gorm.DB, kind types.TaxonomyKind, names []string) ([]uint, bool, error) {
+	var ids []uint
+	for _, name := range names {
+		id, err := resolveTaxonomyTerm(tx, kind, types.NormalizeTaxonomyName(name), false)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, false, nil
+		}
+		if err != nil {
+			return nil, false, err
+		}
+		if !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+
+	return ids, true, nil
+}
+
+// normalizeSkill maps a free-text skill on its canonical term and creates the
+// term when nothing matches.
+func normalizeSkill(tx *gorm.DB, skill types.Skill) (types.Skill, error) {
+	if skill.ID != 0 {
+		result := tx.
+			Model(&types.Skill{}).
+			Select("id, name").
+			First(&skill, "id = ?", skill.ID)
+		return skill, result.Error
+	}
+
+	name := types.CleanTaxonomyName(skill.Name)
+	key := types.NormalizeTaxonomyName(name)
+	if key == "" {
+		return skill, ErrInvalidTaxonomyName
+	}
+
+	id, err := resolveTaxonomyTerm(tx, types.TAXONOMY_SKILL, key, true)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return skill, err
+	}
+
+	if id == 0 {
+		created := types.Skill{Name: name, Slug: key}
+		result := tx.
+			Clauses(clause.OnConflict{
+				Columns:   []clause.Column{{Name: "slug"}},
+				DoNothing: true,
+			}).
+			Create(&created)
+		if result.Error != nil {
+			return skill, result.Error
+		}
+		if created.ID != 0 {
+			return types.Skill{ID: created.ID, Name: created.Name}, nil
+		}
+
+		//NOTE: CREATED CONCURRENTLY BY ANOTHER SELLER
+		if id, err = resolveTaxonomyTerm(tx, types.TAXONOMY_SKILL, key, false); err != nil {
+			return skill, err
+		}
+	}
+
+	var canonical types.Skill
+	result := tx.
+		Model(&types.Skill{}).
+		Select("id, name").
+		First(&canonical, "id = ?", id)
+
+	return canonical, result.Error
+}
+
+// normalizeLanguage maps a language on its canonical term, languages are
+// managed by admins so unknown ones are not created.
+func normalizeLanguage(tx *gorm.DB, lang types.Language) (types.Language, error) {
+	id := lang.ID
+	if id == 0 {
+		var err error
+		id, err = resolveTaxonomyTerm(tx, types.TAXONOMY_LANGUAGE, types.NormalizeTaxonomyName(lang.Language), true)
+		if err != nil {
+			return lang, err
+		}
+	}
+
+	var canonical types.Language
+	result := tx.
+		Model(&types.Language{}).
+		Select("id, language").
+		First(&canonical, "id = ?", id)
+
+	return canonical, result.Error
+}
+
+// normalizeSkills maps the skills of a seller on their canonical terms, skills
+// that end up on the same term are kept once.
+func normalizeSkills(tx *gorm.DB, skills []types.Skill) ([]types.Skill, error) {
+	canonical := make([]types.Skill, 0, len(skills))
+	for _, skill := range skills {
+		s, err := normalizeSkill(tx, skill)
+		if errors.Is(err, ErrInvalidTaxonomyName) {
+			return nil, err
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Error saving skills %v", err)
+		}
+
+		if !slices.ContainsFunc(canonical, func(c types.Skill) bool { return c.ID == s.ID }) {
+			canonical = append(canonical, s)
+		}
+	}
+
+	return canonical, nil
+}
+
+// normalizeLanguages maps the languages of a seller on their canonical terms,
+// languages that end up on the same term are kept once.
+func normalizeLanguages(tx *gorm.DB, languages []types.Language) ([]types.Language, error) {
+	canonical := make([]types.Language, 0, len(languages))
+	for _, lang := range languages {
+		l, err := normalizeLanguage(tx, lang)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrInvalidLanguage
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Error finding language. %v", err)
+		}
+
+		if !slices.ContainsFunc(canonical, func(c types.Language) bool { return c.ID == l.ID }) {
+			canonical = append(canonical, l)
+		}
+	}
+
+	return canonical, nil
+}
+
+func mergeTaxonomyTerms(tx *gorm.DB, kind types.TaxonomyKind, sourceIDs []uint, targetID uint) (int64, error) {
+	t := taxonomyTables[kind]
+	if slices.Contains(sourceIDs, targetID) {
+		return 0, ErrInvalidMerge
+	}
+
+	var found int64
+	result := tx.
+		Table(t.table).
+		Where("id IN ? OR id = ?", sourceIDs, targetID).
+		Count(&found)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	unique := slices.Compact(slices.Sorted(slices.Values(sourceIDs)))
+	if found != int64(len(unique)+1) {
+		return 0, gorm.ErrRecordNotFound
+	}
+
+	//INFO: 1. LINK THE SOURCES' SELLERS TO THE TARGET, SKIPPING THE ONES ALREADY LINKED
+	result = tx.
+		Exec(fmt.Sprintf(`
+			INSERT INTO %[1]s (seller_id, %[2]s)
+			SELECT DISTINCT seller_id, ? FROM %[1]s
+			WHERE %[2]s IN ? AND seller_id NOT IN (
+				SELECT seller_id FROM %[1]s WHERE %[2]s = ?
+			)`, t.joinTable, t.joinColumn),
+			targetID, unique, targetID)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	moved := result.RowsAffected
+
+	result = tx.
+		Exec(fmt.Sprintf(`DELETE FROM %s WHERE %s IN ?`, t.joinTable, t.joinColumn), unique)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	//INFO: 2. THE SOURCES' ALIASES AND SLUGS NOW POINT AT THE TARGET
+	result = tx.
+		Model(&types.TaxonomyAlias{}).
+		Where("kind = ? AND target_id IN ?", kind, unique).
+		Update("target_id", targetID)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	result = tx.
+		Exec(fmt.Sprintf(`
+			INSERT INTO taxonomy_aliases (kind, alias, target_id, created_at)
+			SELECT ?, slug, ?, now() FROM %s
+			WHERE id IN ? AND slug <> '' AND slug NOT LIKE 'id:%%'
+			ON CONFLICT DO NOTHING`, t.table),
+			kind, targetID, unique)
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	//INFO: 3. REMOVE THE SOURCES
+	result = tx.
+		Exec(fmt.Sprintf(`DELETE FROM %s WHERE id IN ?`, t.table), unique)
+
+	return moved, result.Error
+}
@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+
+	"github.com/Akihira77/gojobber/services/4-user/types"
+)
+
+func (ss *SellerService) SetVacation(ctx context.Context, seller *types.Seller, data *types.SellerVacationDTO) error {
+	seller.VacationStart = &data.StartDate
+	seller.VacationEnd = &data.EndDate
+	seller.VacationMessage = data.Message
+
+	return ss.db.
+		WithContext(ctx).
+		Model(seller).
+		Select("vacation_start", "vacation_end", "vacation_message").
+		Updates(seller).
+		Error
+}
+
+// EndVacation clears the vacation, an upcoming one is canceled as well.
+func (ss *SellerService) EndVacation(ctx context.Context, seller *types.Seller) error {
+	seller.VacationStart = nil
+	seller.VacationEnd = nil
+	seller.VacationMessage = ""
+
+	return ss.db.
+		WithContext(ctx).
+		Model(seller).
+		Select("vacation_start", "vacation_end", "vacation_message").
+		Updates(seller).
+		Error
+}
@@ -0,0 +1,211 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Akihira77/gojobber/services/4-user/types"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrVerificationNotAllowed      = errors.New("documents can only be submitted while unverified or rejected")
+	ErrVerificationAlreadyReviewed = errors.New("verification has already been reviewed")
+)
+
+type VerificationService struct {
+	db *gorm.DB
+}
+
+type VerificationServiceImpl interface {
+	FindMine(ctx context.Context, seller *types.Seller) (*types.MyVerificationDTO, error)
+	Submit(ctx context.Context, sellerID string, documentType types.IdentityDocumentType, documents []types.VerificationDocument) (*types.SellerVerification, error)
+	FindQueue(ctx context.Context, status types.VerificationStatus, page, size int) ([]types.SellerVerification, int64, error)
+	FindByID(ctx context.Context, id string) (*types.SellerVerification, error)
+	FindDocument(ctx context.Context, verificationID, documentID string) (*types.VerificationDocument, error)
+	Review(ctx context.Context, id, adminID string, data *types.ReviewVerificationDTO) (*types.SellerVerification, error)
+}
+
+func NewVerificationService(db *gorm.DB) VerificationServiceImpl {
+	return &VerificationService{
+		db: db,
+	}
+}
+
+func (vs *VerificationService) FindMine(ctx context.Context, seller *types.Seller) (*types.MyVerificationDTO, error) {
+	res := &types.MyVerificationDTO{
+		Status: seller.VerificationStatus,
+	}
+
+	var latest types.SellerVerification
+	result := vs.db.
+		WithContext(ctx).
+		Model(&types.SellerVerification{}).
+		Preload("Documents").
+		Where("seller_id = ?", seller.ID).
+		Order("created_at DESC").
+		First(&latest)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return res, nil
+		}
+		return nil, result.Error
+	}
+
+	res.Latest = &latest
+	return res, nil
+}
+
+// Submit moves the seller from UNVERIFIED or REJECTED to PENDING. The seller
+// row is locked so two submissions can not both pass the status check.
+func (vs *VerificationService) Submit(ctx context.Context, sellerID string, documentType types.IdentityDocumentType, documents []types.VerificationDocument) (*types.SellerVerification, error) {
+	now := time.Now()
+	v := &types.SellerVerification{
+		SellerID:     sellerID,
+		DocumentType: documentType,
+		Status:       types.VERIFICATION_PENDING,
+		Documents:    documents,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	for i := range v.Documents {
+		v.Documents[i].CreatedAt = now
+	}
+
+	err := vs.db.
+		WithContext(ctx).
+		Transaction(func(tx *gorm.DB) error {
+			var seller types.Seller
+			result := tx.
+				Clauses(clause.Locking{Strength: "UPDATE"}).
+				Select("id", "verification_status").
+				Where("id = ?", sellerID).
+				First(&seller)
+			if result.Error != nil {
+				return result.Error
+			}
+			if seller.VerificationStatus != types.VERIFICATION_UNVERIFIED && seller.VerificationStatus != types.VERIFICATION_REJECTED {
+				return ErrVerificationNotAllowed
+			}
+
+			if err := tx.Create(v).Error; err != nil {
+				return err
+			}
+
+			return tx.
+				Model(&types.Seller{}).
+				Where("id = ?", sellerID).
+				Update("verification_status", types.VERIFICATION_PENDING).
+				Error
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// FindQueue lists the submissions with status, the oldest pending ones come
+// first so the admins work through the queue in order.
+func (vs *VerificationService) FindQueue(ctx context.Context, status types.VerificationStatus, page, size int) ([]types.SellerVerification, int64, error) {
+	var total int64
+	result := vs.db.
+		WithContext(ctx).
+		Model(&types.SellerVerification{}).
+		Where("status = ?", status).
+		Count(&total)
+	if result.Error != nil {
+		return nil, 0, result.Error
+	}
+
+	order := "created_at ASC"
+	if status != types.VERIFICATION_PENDING {
+		order = "reviewed_at DESC"
+	}
+
+	verifications := []types.SellerVerification{}
+	result = vs.db.
+		WithContext(ctx).
+		Model(&types.SellerVerification{}).
+		Preload("Documents").
+		Where("status = ?", status).
+		Order(order).
+		Offset((page - 1) * size).
+		Limit(size).
+		Find(&verifications)
+
+	return verifications, total, result.Error
+}
+
+func (vs *VerificationService) FindByID(ctx context.Context, id string) (*types.SellerVerification, error) {
+	var v types.SellerVerification
+	result := vs.db.
+		WithContext(ctx).
+		Model(&types.SellerVerification{}).
+		Preload("Documents").
+		Where("id = ?", id).
+		First(&v)
+
+	return &v, result.Error
+}
+
+func (vs *VerificationService) FindDocument(ctx context.Context, verificationID, documentID string) (*types.VerificationDocument, error) {
+	var d types.VerificationDocument
+	result := vs.db.
+		WithContext(ctx).
+		Model(&types.VerificationDocument{}).
+		Where("id = ? AND verification_id = ?", documentID, verificationID).
+		First(&d)
+
+	return &d, result.Error
+}
+
+// Review approves or rejects a pending submission and carries the decision
+// over to the seller.
+func (vs *VerificationService) Review(ctx context.Context, id, adminID string, data *types.ReviewVerificationDTO) (*types.SellerVerification, error) {
+	var v types.SellerVerification
+	err := vs.db.
+		WithContext(ctx).
+		Transaction(func(tx *gorm.DB) error {
+			result := tx.
+				Clauses(clause.Locking{Strength: "UPDATE"}).
+				Where("id = ?", id).
+				First(&v)
+			if result.Error != nil {
+				return result.Error
+			}
+			if v.Status != types.VERIFICATION_PENDING {
+				return ErrVerificationAlreadyReviewed
+			}
+
+			now := time.Now()
+			v.Status = data.Status
+			v.RejectionReason = ""
+			if data.Status == types.VERIFICATION_REJECTED {
+				v.RejectionReason = data.Reason
+			}
+			v.ReviewedBy = adminID
+			v.ReviewedAt = &now
+			v.UpdatedAt = now
+			result = tx.
+				Model(&v).
+				Select("status", "rejection_reason", "reviewed_by", "reviewed_at", "updated_at").
+				Updates(&v)
+			if result.Error != nil {
+				return result.Error
+			}
+
+			return tx.
+				Model(&types.Seller{}).
+				Where("id = ?", v.SellerID).
+				Update("verification_status", data.Status).
+				Error
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v, nil
+}
@@ -0,0 +1,18 @@
+package types
+
+import "time"
+
+// UserBlock stops BlockedID from messaging or ordering from BlockerID, and
+// the other way around.
+type UserBlock struct {
+	BlockerID string    `json:"blockerId" gorm:"primaryKey;"`
+	BlockedID string    `json:"blockedId" gorm:"primaryKey;index;"`
+	CreatedAt time.Time `json:"createdAt" gorm:"not null;"`
+}
+
+type UserBlockDTO struct {
+	BlockedID      string    `json:"blockedId"`
+	Username       string    `json:"username"`
+	ProfilePicture string    `json:"profilePicture"`
+	CreatedAt      time.Time `json:"createdAt"`
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CollectionKind string
+
+const (
+	COLLECTION_GIG    CollectionKind = "GIG"
+	COLLECTION_SELLER CollectionKind = "SELLER"
+)
+
+// Collection is a named list of saved gigs or favourite sellers of a buyer.
+type Collection struct {
+	ID        uuid.UUID      `json:"id" gorm:"primaryKey;type:uuid;default:uuid_generate_v4();"`
+	BuyerID   string         `json:"buyerId" gorm:"not null;uniqueIndex:idx_collection_buyer_kind_name;"`
+	Kind      CollectionKind `json:"kind" gorm:"type:varchar(8);not null;uniqueIndex:idx_collection_buyer_kind_name;"`
+	Name      string         `json:"name" gorm:"not null;uniqueIndex:idx_collection_buyer_kind_name;"`
+	CreatedAt time.Time      `json:"createdAt" gorm:"not null;"`
+	UpdatedAt time.Time      `json:"updatedAt" gorm:"not null;"`
+}
+
+// CollectionItem keeps BuyerID and Kind of its collection so saves can be
+// counted per item without joining collections.
+type CollectionItem struct {
+	CollectionID uuid.UUID      `json:"collectionId" gorm:"primaryKey;type:uuid;"`
+	ItemID       string         `json:"itemId" gorm:"primaryKey;index:idx_collection_item_kind_item;"`
+	BuyerID      string         `json:"-" gorm:"not null;index;"`
+	Kind         CollectionKind `json:"kind" gorm:"type:varchar(8);not null;index:idx_collection_item_kind_item;"`
+	CreatedAt    time.Time      `json:"createdAt" gorm:"not null;"`
+}
+
+type CollectionDTO struct {
+	ID         uuid.UUID      `json:"id"`
+	Kind       CollectionKind `json:"kind"`
+	Name       string         `json:"name"`
+	ItemsCount int64          `json:"itemsCount"`
+	CreatedAt  time.Time      `json:"createdAt"`
+	UpdatedAt  time.Time      `json:"updatedAt"`
+}
+
+type CreateCollectionDTO struct {
+	Kind CollectionKind `json:"kind" validate:"required,oneof=GIG SELLER"`
+	Name string         `json:"name" validate:"required,max=64"`
+}
+
+type RenameCollectionDTO struct {
+	Name string `json:"name" validate:"required,max=64"`
+}
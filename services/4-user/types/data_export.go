@@ -0,0 +1,48 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type DataExportStatus string
+
+const (
+	DATA_EXPORT_PENDING    DataExportStatus = "PENDING"    // WAITING FOR THE EXPORT WORKER
+	DATA_EXPORT_PROCESSING DataExportStatus = "PROCESSING" // CLAIMED BY A WORKER, THE SERVICES ARE BEING ASKED FOR THEIR PART
+	DATA_EXPORT_READY      DataExportStatus = "READY"      // THE ARCHIVE CAN BE DOWNLOADED UNTIL ExpiresAt
+	DATA_EXPORT_FAILED     DataExportStatus = "FAILED"     // GAVE UP AFTER THE LAST ATTEMPT
+	DATA_EXPORT_EXPIRED    DataExportStatus = "EXPIRED"    // THE DOWNLOAD LINK HAS EXPIRED AND THE ARCHIVE IS REMOVED
+)
+
+// DataExport is a user's request for a copy of their personal data. The
+// archive is kept in the row until the download link expires.
+type DataExport struct {
+	ID            uuid.UUID        `json:"id" gorm:"primaryKey;type:uuid;default:uuid_generate_v4();"`
+	BuyerID       string           `json:"-" gorm:"not null;index;"`
+	Status        DataExportStatus `json:"status" gorm:"type:varchar(16);not null;default:'PENDING';index;"`
+	Attempts      int              `json:"-" gorm:"not null;default:0;"`
+	FailureReason string           `json:"failureReason,omitempty"`
+	Archive       []byte           `json:"-" gorm:"type:bytea;"`
+	Size          int64            `json:"size" gorm:"not null;default:0;"`
+	LockedUntil   *time.Time       `json:"-"`
+	CompletedAt   *time.Time       `json:"completedAt,omitempty"`
+	ExpiresAt     *time.Time       `json:"expiresAt,omitempty"`
+	CreatedAt     time.Time        `json:"createdAt" gorm:"not null;"`
+	UpdatedAt     time.Time        `json:"updatedAt" gorm:"not null;"`
+}
+
+// DataExportProfile is the profile.json entry of the archive, Seller is nil
+// when the user never became a seller.
+type DataExportProfile struct {
+	Buyer  *Buyer     `json:"buyer"`
+	Seller *SellerDTO `json:"seller"`
+}
+
+type DataExportManifest struct {
+	ExportID    uuid.UUID `json:"exportId"`
+	UserID      string    `json:"userId"`
+	GeneratedAt time.Time `json:"generatedAt"`
+	Files       []string  `json:"files"`
+}
@@ -0,0 +1,115 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type LedgerKind string
+
+const (
+	LEDGER_ORDER_EARNING   LedgerKind = "ORDER_EARNING"   // ORDER PRICE MOVES FROM ESCROW TO THE SELLER PENDING BALANCE
+	LEDGER_PLATFORM_FEE    LedgerKind = "PLATFORM_FEE"    // SERVICE FEE MOVES FROM THE SELLER PENDING BALANCE TO PLATFORM REVENUE
+	LEDGER_CLEARANCE       LedgerKind = "CLEARANCE"       // CLEARED EARNING MOVES FROM PENDING TO AVAILABLE
+	LEDGER_REFUND          LedgerKind = "REFUND"          // EARNING GOES BACK TO ESCROW FOR THE BUYER
+	LEDGER_PAYOUT          LedgerKind = "PAYOUT"          // WITHDRAWAL LEAVES THE SELLER BALANCE
+	LEDGER_PAYOUT_REVERSAL LedgerKind = "PAYOUT_REVERSAL" // FAILED WITHDRAWAL RETURNS TO THE SELLER BALANCE
+	LEDGER_ADJUSTMENT      LedgerKind = "ADJUSTMENT"      // MANUAL CORRECTION OR OPENING BALANCE
+)
+
+const (
+	LEDGER_ACCOUNT_ESCROW  = "platform:escrow"
+	LEDGER_ACCOUNT_REVENUE = "platform:revenue"
+	LEDGER_ACCOUNT_PAYOUTS = "platform:payouts"
+	LEDGER_ACCOUNT_EQUITY  = "platform:equity"
+)
+
+// SellerLedgerAccount holds the available, withdrawable balance.
+func SellerLedgerAccount(sellerID string) string {
+	return "seller:" + sellerID
+}
+
+// SellerPendingLedgerAccount holds earnings until their clearance period ends.
+func SellerPendingLedgerAccount(sellerID string) string {
+	return "seller:" + sellerID + ":pending"
+}
+
+// LedgerTransaction groups entries whose amounts sum to zero. Transactions
+// and entries are never updated, a mistake is fixed by posting a new one.
+type LedgerTransaction struct {
+	ID             uuid.UUID     `json:"id" gorm:"primaryKey;type:uuid;default:uuid_generate_v4();"`
+	Kind           LedgerKind    `json:"kind" gorm:"type:varchar(16);not null;"`
+	SellerID       string        `json:"sellerId" gorm:"not null;index;"`
+	IdempotencyKey string        `json:"-" gorm:"not null;uniqueIndex;"`
+	SourceType     string        `json:"sourceType" gorm:"type:varchar(16);not null;"`
+	SourceID       string        `json:"sourceId" gorm:"not null;"`
+	Description    string        `json:"description" gorm:"not null;"`
+	CreatedAt      time.Time     `json:"createdAt" gorm:"not null;index;"`
+	Entries        []LedgerEntry `json:"entries,omitempty" gorm:"foreignKey:TransactionID;"`
+}
+
+// LedgerEntry amount is signed, positive credits and negative debits the
+// account. Amounts are in whole currency units like Seller.AccountBalance.
+type LedgerEntry struct {
+	ID            uuid.UUID `json:"id" gorm:"primaryKey;type:uuid;default:uuid_generate_v4();"`
+	TransactionID uuid.UUID `json:"transactionId" gorm:"type:uuid;not null;index;"`
+	Account       string    `json:"account" gorm:"not null;index;"`
+	Amount        int64     `json:"amount" gorm:"not null;"`
+	CreatedAt     time.Time `json:"createdAt" gorm:"not null;"`
+}
+
+const (
+	LEDGER_SOURCE_ORDER  = "ORDER"
+	LEDGER_SOURCE_PAYOUT = "PAYOUT"
+	LEDGER_SOURCE_MANUAL = "MANUAL"
+)
+
+// SellerTransactionDTO is one line of the seller's history, Amount is the
+// net change of the available balance and PendingAmount of the pending one.
+type SellerTransactionDTO struct {
+	ID            uuid.UUID  `json:"id"`
+	Kind          LedgerKind `json:"kind"`
+	SourceType    string     `json:"sourceType"`
+	SourceID      string     `json:"sourceId"`
+	Description   string     `json:"description"`
+	Amount        int64      `json:"amount"`
+	PendingAmount int64      `json:"pendingAmount"`
+	CreatedAt     time.Time  `json:"createdAt"`
+}
+
+type BalanceReconciliation struct {
+	SellerID      string `json:"sellerId"`
+	CachedBalance int64  `json:"cachedBalance"`
+	LedgerBalance int64  `json:"ledgerBalance"`
+	CachedPending int64  `json:"cachedPending"`
+	LedgerPending int64  `json:"ledgerPending"`
+	Consistent    bool   `json:"consistent"`
+}
+
+// EarningClearance schedules an order earning to move from the pending to
+// the available balance once ClearsAt has passed.
+type EarningClearance struct {
+	ID        uuid.UUID  `json:"id" gorm:"primaryKey;type:uuid;default:uuid_generate_v4();"`
+	SellerID  string     `json:"sellerId" gorm:"not null;index;"`
+	OrderID   string     `json:"orderId" gorm:"not null;uniqueIndex;"`
+	Amount    uint64     `json:"amount" gorm:"not null;"`
+	ClearsAt  time.Time  `json:"clearsAt" gorm:"not null;index;"`
+	ClearedAt *time.Time `json:"clearedAt,omitempty"`
+	CreatedAt time.Time  `json:"createdAt" gorm:"not null;"`
+}
+
+type SellerBalanceDTO struct {
+	Available uint64             `json:"available"`
+	Pending   uint64             `json:"pending"`
+	Upcoming  []EarningClearance `json:"upcoming"`
+}
+
+type LedgerAdjustmentDTO struct {
+	SellerID string     `json:"sellerId" validate:"required"`
+	Kind     LedgerKind `json:"kind" validate:"required,oneof=ADJUSTMENT REFUND"`
+	Amount   int64      `json:"amount" validate:"required,ne=0"`
+	Reason   string     `json:"reason" validate:"required"`
+	// NOTE: OPTIONAL, RESENDING THE SAME REFERENCE DOES NOT POST TWICE
+	Reference string `json:"reference"`
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PayoutStatus string
+
+const (
+	PAYOUT_REQUESTED  PayoutStatus = "REQUESTED"  // BALANCE IS RESERVED, WAITING FOR THE PAYOUT WORKER
+	PAYOUT_PROCESSING PayoutStatus = "PROCESSING" // CLAIMED BY A WORKER AND SENT TO THE PROVIDER
+	PAYOUT_PAID       PayoutStatus = "PAID"       // PROVIDER HAS TRANSFERRED THE AMOUNT
+	PAYOUT_FAILED     PayoutStatus = "FAILED"     // GAVE UP, THE AMOUNT IS BACK IN THE SELLER BALANCE
+)
+
+// Payout is a withdrawal of a seller's balance. The amount leaves
+// Seller.AccountBalance as soon as it is requested so it can not be spent
+// twice, and is returned if the payout fails.
+type Payout struct {
+	ID            uuid.UUID    `json:"id" gorm:"primaryKey;type:uuid;default:uuid_generate_v4();"`
+	SellerID      string       `json:"sellerId" gorm:"not null;index;"`
+	Amount        uint64       `json:"amount" gorm:"not null;"`
+	Currency      string       `json:"currency" gorm:"type:varchar(3);not null;"`
+	Status        PayoutStatus `json:"status" gorm:"type:varchar(16);not null;default:'REQUESTED';index;"`
+	Provider      string       `json:"provider" gorm:"type:varchar(16);not null;"`
+	ProviderRef   string       `json:"providerRef,omitempty"`
+	FailureReason string       `json:"failureReason,omitempty"`
+	Attempts      int          `json:"attempts" gorm:"not null;default:0;"`
+	NextAttemptAt time.Time    `json:"-" gorm:"not null;index;"`
+	LockedUntil   *time.Time   `json:"-"`
+	PaidAt        *time.Time   `json:"paidAt,omitempty"`
+	FailedAt      *time.Time   `json:"failedAt,omitempty"`
+	CreatedAt     time.Time    `json:"createdAt" gorm:"not null;"`
+	UpdatedAt     time.Time    `json:"updatedAt" gorm:"not null;"`
+}
+
+type WithdrawDTO struct {
+	Amount uint64 `json:"amount" validate:"required,gt=0"`
+}
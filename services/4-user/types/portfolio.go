@@ -0,0 +1,67 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PortfolioMediaKind string
+
+const (
+	PORTFOLIO_IMAGE PortfolioMediaKind = "IMAGE" // UPLOADED TO CLOUDINARY
+	PORTFOLIO_VIDEO PortfolioMediaKind = "VIDEO" // LINK TO AN EXTERNAL VIDEO
+)
+
+const (
+	PORTFOLIO_MAX_MEDIA      = 10
+	PORTFOLIO_MAX_IMAGE_SIZE = 2 * 1024 * 1024
+)
+
+// PortfolioItem is a piece of past work shown on the seller profile, items
+// and their media are shown by ascending Position.
+type PortfolioItem struct {
+	ID          uuid.UUID        `json:"id" gorm:"primaryKey;type:uuid;default:uuid_generate_v4();"`
+	SellerID    string           `json:"sellerId" gorm:"not null;index;"`
+	Title       string           `json:"title" gorm:"not null;"`
+	Description string           `json:"description" gorm:"not null;"`
+	GigID       string           `json:"gigId,omitempty"`
+	Position    int              `json:"position" gorm:"not null;default:0;"`
+	CreatedAt   time.Time        `json:"createdAt" gorm:"not null;"`
+	UpdatedAt   time.Time        `json:"updatedAt" gorm:"not null;"`
+	Media       []PortfolioMedia `json:"media" gorm:"foreignKey:PortfolioItemID;"`
+}
+
+type PortfolioMedia struct {
+	ID              uuid.UUID          `json:"id" gorm:"primaryKey;type:uuid;default:uuid_generate_v4();"`
+	PortfolioItemID uuid.UUID          `json:"portfolioItemId" gorm:"type:uuid;not null;index;"`
+	Kind            PortfolioMediaKind `json:"kind" gorm:"type:varchar(8);not null;"`
+	URL             string             `json:"url" gorm:"not null;"`
+	PublicID        string             `json:"-"`
+	Position        int                `json:"position" gorm:"not null;default:0;"`
+	CreatedAt       time.Time          `json:"createdAt" gorm:"not null;"`
+}
+
+// NOTE: SENT AS multipart/form-data, IMAGES ARE THE "images" FILES
+type CreatePortfolioItemDTO struct {
+	Title       string   `json:"title" form:"title" validate:"required,max=100"`
+	Description string   `json:"description" form:"description" validate:"required,max=2000"`
+	GigID       string   `json:"gigId" form:"gigId" validate:"omitempty,uuid"`
+	VideoURLs   []string `json:"videoUrls" form:"videoUrls" validate:"omitempty,dive,http_url"`
+}
+
+type UpdatePortfolioItemDTO struct {
+	Title       string `json:"title" validate:"required,max=100"`
+	Description string `json:"description" validate:"required,max=2000"`
+	GigID       string `json:"gigId" validate:"omitempty,uuid"`
+}
+
+// NOTE: SENT AS multipart/form-data, IMAGES ARE THE "images" FILES
+type AddPortfolioMediaDTO struct {
+	VideoURLs []string `json:"videoUrls" form:"videoUrls" validate:"omitempty,dive,http_url"`
+}
+
+// ReorderDTO lists every id of the reordered set in its new order.
+type ReorderDTO struct {
+	IDs []string `json:"ids" validate:"required,min=1,dive,uuid"`
+}
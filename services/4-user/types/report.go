@@ -0,0 +1,57 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ReportCategory string
+
+const (
+	REPORT_SPAM          ReportCategory = "SPAM"
+	REPORT_HARASSMENT    ReportCategory = "HARASSMENT"
+	REPORT_SCAM          ReportCategory = "SCAM"
+	REPORT_OFF_PLATFORM  ReportCategory = "OFF_PLATFORM_PAYMENT"
+	REPORT_INAPPROPRIATE ReportCategory = "INAPPROPRIATE_CONTENT"
+	REPORT_OTHER         ReportCategory = "OTHER"
+)
+
+type ReportStatus string
+
+const (
+	REPORT_OPEN      ReportStatus = "OPEN"      // WAITING IN THE ADMIN REVIEW QUEUE
+	REPORT_ACTIONED  ReportStatus = "ACTIONED"  // AN ADMIN TOOK ACTION AGAINST THE REPORTED USER
+	REPORT_DISMISSED ReportStatus = "DISMISSED" // AN ADMIN FOUND NOTHING TO ACT ON
+)
+
+// UserReport is a complaint about another user, EvidenceMessageIDs point at
+// chat messages the admin should look at.
+type UserReport struct {
+	ID                 uuid.UUID      `json:"id" gorm:"primaryKey;type:uuid;default:uuid_generate_v4();"`
+	ReporterID         string         `json:"reporterId" gorm:"not null;index;"`
+	ReportedID         string         `json:"reportedId" gorm:"not null;index;"`
+	Category           ReportCategory `json:"category" gorm:"type:varchar(32);not null;"`
+	Description        string         `json:"description" gorm:"not null;default:'';"`
+	EvidenceMessageIDs []string       `json:"evidenceMessageIds" gorm:"type:jsonb;not null;default:'[]';serializer:json;"`
+	Status             ReportStatus   `json:"status" gorm:"type:varchar(16);not null;default:'OPEN';index;"`
+	ResolutionNote     string         `json:"resolutionNote,omitempty" gorm:"not null;default:'';"`
+	ReviewedBy         string         `json:"reviewedBy,omitempty" gorm:"not null;default:'';"`
+	ReviewedAt         *time.Time     `json:"reviewedAt,omitempty"`
+	CreatedAt          time.Time      `json:"createdAt" gorm:"not null;"`
+	UpdatedAt          time.Time      `json:"updatedAt" gorm:"not null;"`
+}
+
+type CreateUserReportDTO struct {
+	ReportedID         string         `json:"reportedId" validate:"required"`
+	Category           ReportCategory `json:"category" validate:"required,oneof=SPAM HARASSMENT SCAM OFF_PLATFORM_PAYMENT INAPPROPRIATE_CONTENT OTHER"`
+	Description        string         `json:"description" validate:"max=2000"`
+	EvidenceMessageIDs []string       `json:"evidenceMessageIds" validate:"max=20,dive,uuid"`
+	// Block also blocks the reported user.
+	Block bool `json:"block"`
+}
+
+type ReviewUserReportDTO struct {
+	Status         ReportStatus `json:"status" validate:"required,oneof=ACTIONED DISMISSED"`
+	ResolutionNote string       `json:"resolutionNote" validate:"max=2000"`
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	"gorm.io/gorm"
 )
@@ -17,11 +18,15 @@ type RatingCategory struct {
 type Language struct {
 	ID       uint   `json:"id" gorm:"primaryKey;"`
 	Language string `json:"language" gorm:"not null;"`
+	// Slug is the normalized language, see NormalizeTaxonomyName.
+	Slug string `json:"-" gorm:"not null;default:'';uniqueIndex;"`
 }
 
 type Skill struct {
 	ID   uint   `json:"id" gorm:"primaryKey;"`
 	Name string `json:"name" gorm:"not null;"`
+	// Slug is the normalized name, see NormalizeTaxonomyName.
+	Slug string `json:"-" gorm:"not null;default:'';uniqueIndex;"`
 }
 
 type Certificate struct {
@@ -98,15 +103,32 @@ type Seller struct {
 	RatingSum        uint64         `json:"ratingSum" gorm:"not null;"`
 	RatingCategories RatingCategory `json:"ratingCategories" gorm:"type:jsonb;not null;serializer:json;"`
 	AccountBalance   uint64         `json:"accountBalance" gorm:"not null; default:0;"`
+	// NOTE: EARNINGS STILL IN CLEARANCE, NOT WITHDRAWABLE YET
+	PendingBalance uint64    `json:"pendingBalance" gorm:"not null; default:0;"`
+	CreatedAt      time.Time `json:"createdAt" gorm:"not null; default:now();"`
+	// NOTE: RECOMPUTED PERIODICALLY BY THE SELLER LEVEL WORKER
+	Level           SellerLevel `json:"level" gorm:"type:varchar(16);not null;default:'NEW';index;"`
+	VacationStart   *time.Time  `json:"vacationStart,omitempty"`
+	VacationEnd     *time.Time  `json:"vacationEnd,omitempty"`
+	VacationMessage string      `json:"vacationMessage,omitempty" gorm:"not null;default:'';"`
+	// VerificationStatus only moves through the identity verification flow.
+	VerificationStatus VerificationStatus `json:"verificationStatus" gorm:"type:varchar(16);not null;default:'UNVERIFIED';index;"`
 }
 
 type SellerOverview struct {
 	ID               string         `json:"id"`
 	FullName         string         `json:"fullName"`
 	Email            string         `json:"email"`
+	Country          string         `json:"country"`
 	RatingsCount     uint64         `json:"ratingsCount"`
 	RatingSum        uint64         `json:"ratingSum"`
 	RatingCategories RatingCategory `json:"ratingCategories"`
+	Level            SellerLevel    `json:"level"`
+	OnVacation       bool           `json:"onVacation"`
+	Verified         bool           `json:"verified"`
+	VacationStart    *time.Time     `json:"vacationStart,omitempty"`
+	VacationEnd      *time.Time     `json:"vacationEnd,omitempty"`
+	VacationMessage  string         `json:"vacationMessage,omitempty"`
 	StripeAccountID  string         `json:"stringAccountId"`
 }
 
@@ -138,9 +160,46 @@ type SellerDTO struct {
 	Certificates     []CertificateDTO `json:"certificates"`
 	Educations       []EducationDTO   `json:"educations"`
 	Experiences      []ExperienceDTO  `json:"experiences"`
+	Portfolio        []PortfolioItem  `json:"portfolio" gorm:"-"`
 	RatingsCount     uint64           `json:"ratingsCount"`
 	RatingSum        uint64           `json:"ratingSum"`
 	RatingCategories RatingCategory   `json:"ratingCategories" gorm:"serializer:json"`
+	Level            SellerLevel      `json:"level"`
+	OnVacation       bool             `json:"onVacation"`
+	Verified         bool             `json:"verified"`
+	VacationStart    *time.Time       `json:"vacationStart,omitempty"`
+	VacationEnd      *time.Time       `json:"vacationEnd,omitempty"`
+	VacationMessage  string           `json:"vacationMessage,omitempty"`
+}
+
+const (
+	SELLER_SORT_RATING = "rating"
+	SELLER_SORT_NEWEST = "newest"
+)
+
+type SellerSearchParams struct {
+	Page int `json:"page" params:"page"`
+	Size int `json:"size" params:"size"`
+}
+
+// SellerSearchQuery filters are combined with AND, a seller must have every
+// listed skill and language. Skills and languages are comma separated names.
+type SellerSearchQuery struct {
+	Query           string  `json:"query" query:"query"`
+	Skills          string  `json:"skills" query:"skills"`
+	Languages       string  `json:"languages" query:"languages"`
+	Country         string  `json:"country" query:"country"`
+	MinRating       float64 `json:"min_rating" query:"min_rating" validate:"gte=0,lte=5"`
+	MinRatingsCount uint64  `json:"min_ratings_count" query:"min_ratings_count"`
+	VerifiedOnly    bool    `json:"verified" query:"verified"`
+	Sort            string  `json:"sort" query:"sort" validate:"omitempty,oneof=rating newest"`
+}
+
+type SellerSearchQueryResult struct {
+	Total   int64       `json:"total"`
+	Matched int64       `json:"matched"`
+	Count   int         `json:"count"`
+	Sellers []SellerDTO `json:"sellers"`
 }
 
 type UpdateSellerDTO struct {
@@ -0,0 +1,97 @@
+package types
+
+import "time"
+
+type SellerLevel string
+
+const (
+	SELLER_LEVEL_NEW       SellerLevel = "NEW"
+	SELLER_LEVEL_ONE       SellerLevel = "LEVEL_ONE"
+	SELLER_LEVEL_TWO       SellerLevel = "LEVEL_TWO"
+	SELLER_LEVEL_TOP_RATED SellerLevel = "TOP_RATED"
+)
+
+// SellerLevelRequirement is what a seller needs to reach Level, every
+// threshold has to be met.
+type SellerLevelRequirement struct {
+	Level               SellerLevel
+	MinCompletedOrders  int64
+	MinOnTimeRate       float64
+	MaxCancellationRate float64
+	MinAverageRating    float64
+	MinAccountAge       time.Duration
+}
+
+// NOTE: ORDERED FROM THE HIGHEST LEVEL, THE FIRST ONE MET WINS
+var SELLER_LEVEL_REQUIREMENTS = []SellerLevelRequirement{
+	{
+		Level:               SELLER_LEVEL_TOP_RATED,
+		MinCompletedOrders:  100,
+		MinOnTimeRate:       0.95,
+		MaxCancellationRate: 0.02,
+		MinAverageRating:    4.8,
+		MinAccountAge:       180 * 24 * time.Hour,
+	},
+	{
+		Level:               SELLER_LEVEL_TWO,
+		MinCompletedOrders:  50,
+		MinOnTimeRate:       0.9,
+		MaxCancellationRate: 0.05,
+		MinAverageRating:    4.6,
+		MinAccountAge:       120 * 24 * time.Hour,
+	},
+	{
+		Level:               SELLER_LEVEL_ONE,
+		MinCompletedOrders:  10,
+		MinOnTimeRate:       0.9,
+		MaxCancellationRate: 0.1,
+		MinAverageRating:    4.4,
+		MinAccountAge:       60 * 24 * time.Hour,
+	},
+}
+
+// SellerPerformance is gathered from the order and review services.
+type SellerPerformance struct {
+	FinishedOrders  int64
+	CompletedOrders int64
+	OnTimeOrders    int64
+	CanceledOrders  int64
+	RatingsCount    int64
+	RatingSum       int64
+}
+
+func (p SellerPerformance) OnTimeRate() float64 {
+	if p.CompletedOrders == 0 {
+		return 0
+	}
+	return float64(p.OnTimeOrders) / float64(p.CompletedOrders)
+}
+
+func (p SellerPerformance) CancellationRate() float64 {
+	if p.FinishedOrders == 0 {
+		return 0
+	}
+	return float64(p.CanceledOrders) / float64(p.FinishedOrders)
+}
+
+func (p SellerPerformance) AverageRating() float64 {
+	if p.RatingsCount == 0 {
+		return 0
+	}
+	return float64(p.RatingSum) / float64(p.RatingsCount)
+}
+
+// ComputeSellerLevel returns the highest level whose requirements are met.
+func ComputeSellerLevel(p SellerPerformance, accountAge time.Duration) SellerLevel {
+	for _, r := range SELLER_LEVEL_REQUIREMENTS {
+		if p.CompletedOrders >= r.MinCompletedOrders &&
+			p.OnTimeRate() >= r.MinOnTimeRate &&
+			p.CancellationRate() <= r.MaxCancellationRate &&
+			p.AverageRating() >= r.MinAverageRating &&
+			accountAge >= r.MinAccountAge {
+			return r.Level
+		}
+	}
+
+	return SELLER_LEVEL_NEW
+}
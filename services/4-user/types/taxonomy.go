@@ -0,0 +1,91 @@
+package types
+
+import (
+	"strings"
+	"time"
+	"unicode"
+)
+
+type TaxonomyKind string
+
+const (
+	TAXONOMY_SKILL    TaxonomyKind = "SKILL"
+	TAXONOMY_LANGUAGE TaxonomyKind = "LANGUAGE"
+)
+
+// TAXONOMY_SIMILARITY_THRESHOLD is the pg_trgm similarity a free-text name
+// needs to be mapped on an existing term instead of creating a new one.
+const TAXONOMY_SIMILARITY_THRESHOLD = 0.6
+
+// TaxonomyAlias maps another spelling of a skill or language (e.g. "golang")
+// on its canonical term, Alias is stored normalized.
+type TaxonomyAlias struct {
+	Kind      TaxonomyKind `json:"kind" gorm:"primaryKey;type:varchar(16);"`
+	Alias     string       `json:"alias" gorm:"primaryKey;"`
+	TargetID  uint         `json:"targetId" gorm:"not null;index;"`
+	CreatedAt time.Time    `json:"createdAt" gorm:"not null;"`
+}
+
+type TaxonomyTerm struct {
+	ID      uint     `json:"id"`
+	Name    string   `json:"name"`
+	Aliases []string `json:"aliases"`
+}
+
+type TaxonomySuggestion struct {
+	ID    uint    `json:"id"`
+	Name  string  `json:"name"`
+	Score float64 `json:"score"`
+}
+
+type CreateTaxonomyTermDTO struct {
+	Name    string   `json:"name" validate:"required,max=64"`
+	Aliases []string `json:"aliases" validate:"omitempty,dive,required,max=64"`
+}
+
+type TaxonomyAliasDTO struct {
+	Alias string `json:"alias" validate:"required,max=64"`
+}
+
+// MergeTaxonomyDTO folds the source terms into the target, their names
+// become aliases of the target.
+type MergeTaxonomyDTO struct {
+	SourceIDs []uint `json:"sourceIds" validate:"required,min=1,dive,gt=0"`
+	TargetID  uint   `json:"targetId" validate:"required,gt=0"`
+}
+
+// ParseTaxonomyKind reads the "skills" / "languages" route param.
+func ParseTaxonomyKind(s string) (TaxonomyKind, bool) {
+	switch strings.ToLower(s) {
+	case "skills":
+		return TAXONOMY_SKILL, true
+	case "languages":
+		return TAXONOMY_LANGUAGE, true
+	}
+	return "", false
+}
+
+// NormalizeTaxonomyName lowercases the name and turns everything but letters,
+// digits, "+" and "#" into single spaces, so "  Node.JS " becomes "node js"
+// while "C++" and "C#" stay apart.
+func NormalizeTaxonomyName(s string) string {
+	var b strings.Builder
+	space := false
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '+' || r == '#' {
+			if space && b.Len() > 0 {
+				b.WriteByte(' ')
+			}
+			b.WriteRune(r)
+			space = false
+			continue
+		}
+		space = true
+	}
+	return b.String()
+}
+
+// CleanTaxonomyName trims and collapses the whitespace of a display name.
+func CleanTaxonomyName(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
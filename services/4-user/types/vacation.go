@@ -0,0 +1,27 @@
+package types
+
+import "time"
+
+const SELLER_VACATION_MAX_DURATION = 90 * 24 * time.Hour
+
+type SellerVacationDTO struct {
+	StartDate time.Time `json:"startDate" validate:"required"`
+	EndDate   time.Time `json:"endDate" validate:"required,gtfield=StartDate"`
+	Message   string    `json:"message" validate:"required,max=500"`
+}
+
+type SellerVacation struct {
+	OnVacation bool       `json:"onVacation"`
+	StartDate  *time.Time `json:"startDate,omitempty"`
+	EndDate    *time.Time `json:"endDate,omitempty"`
+	Message    string     `json:"message,omitempty"`
+}
+
+// OnVacation reports whether now is inside the seller's vacation window.
+//
+// A vacation is only a time window, nothing is toggled when it starts or ends
+// so gigs and orders are back to normal as soon as VacationEnd passes.
+func (s *Seller) OnVacation(now time.Time) bool {
+	return s.VacationStart != nil && s.VacationEnd != nil &&
+		!s.VacationStart.After(now) && s.VacationEnd.After(now)
+}
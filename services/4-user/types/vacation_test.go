@@ -0,0 +1,36 @@
+package types
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSellerOnVacation(t *testing.T) {
+	now := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+	before := now.Add(-time.Hour)
+	after := now.Add(time.Hour)
+	longBefore := now.Add(-2 * time.Hour)
+	longAfter := now.Add(2 * time.Hour)
+
+	tests := []struct {
+		name       string
+		start, end *time.Time
+		want       bool
+	}{
+		{name: "no vacation", want: false},
+		{name: "missing end", start: &before, want: false},
+		{name: "missing start", end: &after, want: false},
+		{name: "inside the window", start: &before, end: &after, want: true},
+		{name: "starts now", start: &now, end: &after, want: true},
+		{name: "ends now", start: &before, end: &now, want: false},
+		{name: "not started yet", start: &after, end: &longAfter, want: false},
+		{name: "already over", start: &longBefore, end: &before, want: false},
+	}
+
+	for _, tt := range tests {
+		s := Seller{VacationStart: tt.start, VacationEnd: tt.end}
+		if got := s.OnVacation(now); got != tt.want {
+			t.Errorf("%s: OnVacation = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
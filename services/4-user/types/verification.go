@@ -0,0 +1,78 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type VerificationStatus string
+
+const (
+	VERIFICATION_UNVERIFIED VerificationStatus = "UNVERIFIED" // NO DOCUMENTS SUBMITTED YET
+	VERIFICATION_PENDING    VerificationStatus = "PENDING"    // WAITING IN THE ADMIN REVIEW QUEUE
+	VERIFICATION_VERIFIED   VerificationStatus = "VERIFIED"   // APPROVED BY AN ADMIN
+	VERIFICATION_REJECTED   VerificationStatus = "REJECTED"   // THE SELLER CAN SUBMIT NEW DOCUMENTS
+)
+
+type IdentityDocumentType string
+
+const (
+	IDENTITY_DOCUMENT_ID_CARD         IdentityDocumentType = "ID_CARD"
+	IDENTITY_DOCUMENT_PASSPORT        IdentityDocumentType = "PASSPORT"
+	IDENTITY_DOCUMENT_DRIVING_LICENSE IdentityDocumentType = "DRIVING_LICENSE"
+)
+
+type VerificationDocumentSide string
+
+const (
+	VERIFICATION_DOCUMENT_FRONT  VerificationDocumentSide = "FRONT"
+	VERIFICATION_DOCUMENT_BACK   VerificationDocumentSide = "BACK"
+	VERIFICATION_DOCUMENT_SELFIE VerificationDocumentSide = "SELFIE"
+)
+
+// MAX_VERIFICATION_DOCUMENT_SIZE is the largest document upload, in bytes.
+// A whole submission still has to fit in the 5MB request body limit.
+const MAX_VERIFICATION_DOCUMENT_SIZE = 2 * 1024 * 1024
+
+// SellerVerification is one submission of identity documents. Only the latest
+// submission of a seller can be PENDING, its status is mirrored on
+// Seller.VerificationStatus.
+type SellerVerification struct {
+	ID              uuid.UUID              `json:"id" gorm:"primaryKey;type:uuid;default:uuid_generate_v4();"`
+	SellerID        string                 `json:"sellerId" gorm:"not null;index;"`
+	DocumentType    IdentityDocumentType   `json:"documentType" gorm:"type:varchar(32);not null;"`
+	Status          VerificationStatus     `json:"status" gorm:"type:varchar(16);not null;default:'PENDING';index;"`
+	RejectionReason string                 `json:"rejectionReason,omitempty" gorm:"not null;default:'';"`
+	ReviewedBy      string                 `json:"reviewedBy,omitempty" gorm:"not null;default:'';"`
+	ReviewedAt      *time.Time             `json:"reviewedAt,omitempty"`
+	Documents       []VerificationDocument `json:"documents" gorm:"foreignKey:VerificationID;constraint:OnDelete:CASCADE;"`
+	CreatedAt       time.Time              `json:"createdAt" gorm:"not null;"`
+	UpdatedAt       time.Time              `json:"updatedAt" gorm:"not null;"`
+}
+
+// VerificationDocument points at a file kept by the document storage, it is
+// never public and only admins can read it back.
+type VerificationDocument struct {
+	ID             uuid.UUID                `json:"id" gorm:"primaryKey;type:uuid;default:uuid_generate_v4();"`
+	VerificationID uuid.UUID                `json:"verificationId" gorm:"type:uuid;not null;index;"`
+	Side           VerificationDocumentSide `json:"side" gorm:"type:varchar(16);not null;"`
+	StorageKey     string                   `json:"-" gorm:"not null;"`
+	ContentType    string                   `json:"contentType" gorm:"not null;"`
+	Size           int64                    `json:"size" gorm:"not null;"`
+	CreatedAt      time.Time                `json:"createdAt" gorm:"not null;"`
+}
+
+type MyVerificationDTO struct {
+	Status VerificationStatus  `json:"status"`
+	Latest *SellerVerification `json:"latest"`
+}
+
+type SubmitVerificationDTO struct {
+	DocumentType IdentityDocumentType `json:"documentType" form:"documentType" validate:"required,oneof=ID_CARD PASSPORT DRIVING_LICENSE"`
+}
+
+type ReviewVerificationDTO struct {
+	Status VerificationStatus `json:"status" validate:"required,oneof=VERIFIED REJECTED"`
+	Reason string             `json:"reason" validate:"required_if=Status REJECTED,max=1000"`
+}
@@ -0,0 +1,101 @@
+package util
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"mime/multipart"
+	"os"
+
+	"github.com/cloudinary/cloudinary-go/v2"
+	"github.com/cloudinary/cloudinary-go/v2/api"
+	"github.com/cloudinary/cloudinary-go/v2/api/uploader"
+)
+
+type Cloudinary struct {
+	cld *cloudinary.Cloudinary
+}
+
+func NewCloudinary() *Cloudinary {
+	cld, err := cloudinary.NewFromURL(os.Getenv("CLOUDINARY_URL"))
+	if err != nil {
+		log.Fatalf("failed to initialize cloudinary, %v", err)
+	}
+	log.Println("Cloudinary connected")
+
+	return &Cloudinary{
+		cld: cld,
+	}
+}
+
+func (c *Cloudinary) UploadImg(ctx context.Context, file multipart.File, filePath string) (*uploader.UploadResult, error) {
+	uploadParams := uploader.UploadParams{
+		PublicID:     fmt.Sprintf("jobber/portfolio/%s", filePath),
+		Format:       "webp",
+		ResourceType: "image",
+	}
+
+	result, err := c.cld.Upload.Upload(ctx, file, uploadParams)
+	if err != nil {
+		log.Println("error uploading file", err)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (c *Cloudinary) Destroy(ctx context.Context, publicID string) (string, error) {
+	newBool := func(b bool) *bool {
+		return &b
+	}
+
+	result, err := c.cld.Upload.Destroy(ctx, uploader.DestroyParams{
+		PublicID:   publicID,
+		Invalidate: newBool(true),
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return result.Result, nil
+}
+
+// UploadPrivateDocument keeps the file behind Cloudinary's authenticated
+// delivery type, it can only be read through a signed URL.
+func (c *Cloudinary) UploadPrivateDocument(ctx context.Context, file io.Reader, publicID string) (*uploader.UploadResult, error) {
+	uploadParams := uploader.UploadParams{
+		PublicID:     fmt.Sprintf("jobber/verification/%s", publicID),
+		ResourceType: "image",
+		Type:         api.Authenticated,
+	}
+
+	result, err := c.cld.Upload.Upload(ctx, file, uploadParams)
+	if err != nil {
+		log.Println("error uploading private document", err)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (c *Cloudinary) SignedDocumentURL(publicID string) (string, error) {
+	doc, err := c.cld.Image(publicID)
+	if err != nil {
+		return "", err
+	}
+
+	doc.DeliveryType = api.Authenticated
+	doc.Config.URL.SignURL = true
+	return doc.String()
+}
+
+func (c *Cloudinary) DestroyPrivateDocument(ctx context.Context, publicID string) error {
+	_, err := c.cld.Upload.Destroy(ctx, uploader.DestroyParams{
+		PublicID: publicID,
+		Type:     api.Authenticated,
+	})
+
+	return err
+}
@@ -3,6 +3,9 @@ package util
 import (
 	"fmt"
 	"math/rand"
+	"mime/multipart"
+	"os"
+	"strconv"
 	"time"
 
 	"github.com/Akihira77/gojobber/services/3-auth/types"
@@ -21,6 +24,18 @@ func RandomStr(length int) string {
 
 }
 
+func ValidateImgExtension(file *multipart.FileHeader) bool {
+	imgExtAllowed := []string{"image/webp", "image/png", "image/jpg", "image/jpeg"}
+
+	for _, ext := range imgExtAllowed {
+		if file.Header.Get("Content-Type") == ext {
+			return true
+		}
+	}
+
+	return false
+}
+
 // CountryCodeMap holds the complete list of ISO 3166-1 alpha-2 codes
 var CountryCodeMap = map[string]string{
 	"Afghanistan":                            "AF",
@@ -298,3 +313,21 @@ func CustomValidationErrors(err error) []types.ErrorResult {
 
 	return errs
 }
+
+func GetEnvInt(key string, defaultValue int) int {
+	v, err := strconv.Atoi(os.Getenv(key))
+	if err != nil {
+		return defaultValue
+	}
+
+	return v
+}
+
+func GetEnvDuration(key string, defaultValue time.Duration) time.Duration {
+	v, err := time.ParseDuration(os.Getenv(key))
+	if err != nil {
+		return defaultValue
+	}
+
+	return v
+}
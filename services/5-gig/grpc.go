@@ -1 +1,39 @@
 package main
+
+import (
+	"log"
+	"net"
+	"time"
+
+	"github.com/Akihira77/gojobber/services/5-gig/handler"
+	"github.com/Akihira77/gojobber/services/5-gig/service"
+	"github.com/Akihira77/gojobber/services/5-gig/util"
+	"google.golang.org/grpc"
+	"gorm.io/gorm"
+)
+
+type gRPCServer struct {
+	addr string
+}
+
+func NewGRPCServer(addr string) *gRPCServer {
+	return &gRPCServer{
+		addr: addr,
+	}
+}
+
+func (s *gRPCServer) Run(db *gorm.DB) error {
+	lis, err := net.Listen("tcp", s.addr)
+	if err != nil {
+		log.Fatalf("failed to listen: %v", err)
+	}
+
+	grpcServer := grpc.NewServer()
+
+	gigSvc := service.NewGigService(db, util.GetEnvDuration("SELLER_CACHE_TTL", 30*time.Second))
+	handler.NewGigGRPCHandler(grpcServer, gigSvc)
+
+	log.Println("starting grpc server on", s.addr)
+
+	return grpcServer.Serve(lis)
+}
@@ -0,0 +1,81 @@
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Akihira77/gojobber/services/5-gig/service"
+	"github.com/Akihira77/gojobber/services/5-gig/types"
+	"github.com/Akihira77/gojobber/services/common/testdb"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type testGig struct {
+	gig      types.Gig
+	packages []types.GigPackage
+	addOns   []types.GigAddOn
+}
+
+// newTestGig saves an active gig of the seller with a BASIC and a PREMIUM
+// package and two add-ons.
+func newTestGig(t *testing.T, db *gorm.DB, sellerID string) testGig {
+	t.Helper()
+
+	g := testGig{
+		gig: types.Gig{
+			ID:                   uuid.New(),
+			SellerID:             sellerID,
+			Title:                "I will design your logo",
+			Description:          "A logo for your brand",
+			Category:             "Graphics & Design",
+			SubCategories:        []string{"Logo Design"},
+			Tags:                 []string{"logo"},
+			Active:               true,
+			ExpectedDeliveryDays: 3,
+			Price:                50,
+			CoverImage:           "cover.png",
+			CreatedAt:            time.Now(),
+		},
+	}
+	g.packages = []types.GigPackage{
+		{ID: uuid.New(), GigID: g.gig.ID, Tier: types.GIG_PACKAGE_PREMIUM, Name: "Premium", Description: "Three concepts", Price: 150, DeliveryDays: 7, Revisions: 5, Features: []string{"source file"}},
+		{ID: uuid.New(), GigID: g.gig.ID, Tier: types.GIG_PACKAGE_BASIC, Name: "Basic", Description: "One concept", Price: 50, DeliveryDays: 3, Revisions: 1, Features: []string{}},
+	}
+	g.addOns = []types.GigAddOn{
+		{ID: uuid.New(), GigID: g.gig.ID, Title: "Source file", Price: 20, DeliveryDaysDelta: 1, CreatedAt: time.Now()},
+		{ID: uuid.New(), GigID: g.gig.ID, Title: "Express delivery", Price: 30, DeliveryDaysDelta: -2, CreatedAt: time.Now().Add(time.Second)},
+	}
+
+	for _, v := range []interface{}{&g.gig, &g.packages, &g.addOns} {
+		if err := db.Create(v).Error; err != nil {
+			t.Fatalf("create %T: %v", v, err)
+		}
+	}
+
+	return g
+}
+
+func newTestHandler(t *testing.T) (*GigGrpcHandler, *gorm.DB) {
+	t.Helper()
+
+	//NOTE: THE SELLERS TABLE IS OWNED BY THE USER SERVICE, SEARCH ONLY READS THESE COLUMNS
+	db := testdb.Open(t,
+		[]interface{}{&types.Gig{}, &types.GigPackage{}, &types.GigAddOn{}},
+		"CREATE TABLE sellers (id TEXT PRIMARY KEY, level TEXT NOT NULL, vacation_start DATETIME, vacation_end DATETIME)",
+	)
+	return &GigGrpcHandler{gigSvc: service.NewGigService(db, time.Minute)}, db
+}
+
+// newTestSeller saves a seller with the level and, when both are set, a
+// vacation window.
+func newTestSeller(t *testing.T, db *gorm.DB, id, level string, vacationStart, vacationEnd *time.Time) {
+	t.Helper()
+
+	err := db.
+		Exec("INSERT INTO sellers (id, level, vacation_start, vacation_end) VALUES (?, ?, ?, ?)", id, level, vacationStart, vacationEnd).
+		Error
+	if err != nil {
+		t.Fatalf("create seller: %v", err)
+	}
+}
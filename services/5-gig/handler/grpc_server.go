@@ -0,0 +1,107 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"slices"
+
+	"github.com/Akihira77/gojobber/services/5-gig/service"
+	"github.com/Akihira77/gojobber/services/5-gig/types"
+	"github.com/Akihira77/gojobber/services/common/genproto/gig"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type GigGrpcHandler struct {
+	gigSvc service.GigServiceImpl
+	gig.UnimplementedGigServiceServer
+}
+
+func NewGigGRPCHandler(grpc *grpc.Server, gigSvc service.GigServiceImpl) {
+	gRPCHandler := &GigGrpcHandler{
+		gigSvc: gigSvc,
+	}
+
+	gig.RegisterGigServiceServer(grpc, gRPCHandler)
+}
+
+func (h *GigGrpcHandler) ExportGigData(ctx context.Context, req *gig.ExportGigDataRequest) (*gig.ExportGigDataResponse, error) {
+	log.Println("ExportGigData receive data", req)
+
+	gigs, err := h.gigSvc.FindAllSellerGigs(ctx, req.SellerId)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(gigs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gig.ExportGigDataResponse{
+		Data: data,
+	}, nil
+}
+
+func (h *GigGrpcHandler) FindGigPackage(ctx context.Context, req *gig.FindGigPackageRequest) (*gig.FindGigPackageResponse, error) {
+	log.Println("FindGigPackage receive data", req)
+
+	if _, err := uuid.Parse(req.GigId); err != nil {
+		return nil, status.Error(codes.NotFound, "gig is not found")
+	}
+
+	g, err := h.gigSvc.FindGigByID(ctx, req.GigId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "gig is not found")
+		}
+		return nil, err
+	}
+
+	//INFO: ONLY THE REQUESTED ADD-ONS ARE RETURNED, ONE THAT IS NOT OFFERED FAILS THE CALL
+	addOns := make([]*gig.GigAddOn, 0, len(req.AddOnIds))
+	for _, id := range req.AddOnIds {
+		i := slices.IndexFunc(g.AddOns, func(a types.GigAddOn) bool {
+			return a.ID.String() == id
+		})
+		if i == -1 {
+			return nil, status.Errorf(codes.NotFound, "add-on %s is not found", id)
+		}
+
+		addOns = append(addOns, &gig.GigAddOn{
+			Id:                g.AddOns[i].ID.String(),
+			Title:             g.AddOns[i].Title,
+			Price:             g.AddOns[i].Price,
+			DeliveryDaysDelta: int32(g.AddOns[i].DeliveryDaysDelta),
+		})
+	}
+
+	for _, p := range g.Packages {
+		if p.ID.String() != req.PackageId {
+			continue
+		}
+
+		return &gig.FindGigPackageResponse{
+			GigId:        g.ID.String(),
+			SellerId:     g.SellerID,
+			GigTitle:     g.Title,
+			Active:       g.Active,
+			PackageId:    p.ID.String(),
+			Tier:         string(p.Tier),
+			Name:         p.Name,
+			Description:  p.Description,
+			Price:        p.Price,
+			DeliveryDays: uint32(p.DeliveryDays),
+			Revisions:    uint32(p.Revisions),
+			Features:     p.Features,
+			AddOns:       addOns,
+		}, nil
+	}
+
+	return nil, status.Error(codes.NotFound, "package is not found")
+}
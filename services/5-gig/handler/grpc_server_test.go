@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Akihira77/gojobber/services/common/genproto/gig"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestFindGigPackage(t *testing.T) {
+	h, db := newTestHandler(t)
+	g := newTestGig(t, db, "seller-1")
+	other := newTestGig(t, db, "seller-2")
+
+	res, err := h.FindGigPackage(context.Background(), &gig.FindGigPackageRequest{
+		GigId:     g.gig.ID.String(),
+		PackageId: g.packages[0].ID.String(),
+	})
+	if err != nil {
+		t.Fatalf("find gig package: %v", err)
+	}
+	if res.SellerId != "seller-1" || !res.Active || res.GigTitle != g.gig.Title {
+		t.Errorf("gig = %s %v %q, want the gig of seller-1", res.SellerId, res.Active, res.GigTitle)
+	}
+	if res.Tier != "PREMIUM" || res.Price != 150 || res.DeliveryDays != 7 || res.Revisions != 5 {
+		t.Errorf("package = %s %d %d days %d revisions, want PREMIUM 150 7 days 5 revisions", res.Tier, res.Price, res.DeliveryDays, res.Revisions)
+	}
+	if len(res.AddOns) != 0 {
+		t.Errorf("add-ons = %d, want none", len(res.AddOns))
+	}
+
+	//INFO: A PACKAGE IS ONLY FOUND THROUGH ITS OWN GIG
+	_, err = h.FindGigPackage(context.Background(), &gig.FindGigPackageRequest{
+		GigId:     g.gig.ID.String(),
+		PackageId: other.packages[0].ID.String(),
+	})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("package of another gig err = %v, want NotFound", err)
+	}
+
+	_, err = h.FindGigPackage(context.Background(), &gig.FindGigPackageRequest{
+		GigId:     "not-a-uuid",
+		PackageId: g.packages[0].ID.String(),
+	})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("invalid gig id err = %v, want NotFound", err)
+	}
+}
+
+func TestFindGigPackageReturnsTheRequestedAddOns(t *testing.T) {
+	h, db := newTestHandler(t)
+	g := newTestGig(t, db, "seller-1")
+	other := newTestGig(t, db, "seller-2")
+
+	res, err := h.FindGigPackage(context.Background(), &gig.FindGigPackageRequest{
+		GigId:     g.gig.ID.String(),
+		PackageId: g.packages[1].ID.String(),
+		AddOnIds:  []string{g.addOns[1].ID.String(), g.addOns[0].ID.String()},
+	})
+	if err != nil {
+		t.Fatalf("find gig package: %v", err)
+	}
+	if len(res.AddOns) != 2 {
+		t.Fatalf("add-ons = %d, want 2", len(res.AddOns))
+	}
+	if a := res.AddOns[0]; a.Id != g.addOns[1].ID.String() || a.Price != 30 || a.DeliveryDaysDelta != -2 {
+		t.Errorf("first add-on = %s %d %d, want express delivery 30 -2", a.Id, a.Price, a.DeliveryDaysDelta)
+	}
+	if a := res.AddOns[1]; a.Id != g.addOns[0].ID.String() || a.Price != 20 || a.DeliveryDaysDelta != 1 {
+		t.Errorf("second add-on = %s %d %d, want source file 20 1", a.Id, a.Price, a.DeliveryDaysDelta)
+	}
+
+	//INFO: AN ADD-ON OF ANOTHER GIG CAN NOT BE BOUGHT WITH THIS PACKAGE
+	_, err = h.FindGigPackage(context.Background(), &gig.FindGigPackageRequest{
+		GigId:     g.gig.ID.String(),
+		PackageId: g.packages[1].ID.String(),
+		AddOnIds:  []string{g.addOns[0].ID.String(), other.addOns[0].ID.String()},
+	})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("add-on of another gig err = %v, want NotFound", err)
+	}
+}
@@ -2,10 +2,12 @@ package handler
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
 	"net/http"
+	"slices"
 	"strings"
 	"time"
 
@@ -53,30 +55,21 @@ func (gh *GigHandler) FindGigByID(c *fiber.Ctx) error {
 	}
 
 	userGrpcClient := user.NewUserServiceClient(cc)
-	res, err := userGrpcClient.FindSeller(ctx, &user.FindSellerRequest{
-		SellerId: gig.SellerID,
-	})
-
+	sellers, err := gh.gigSvc.FindSellerOverviews(ctx, userGrpcClient, []string{gig.SellerID})
 	if err != nil {
 		log.Println("find gig by id", err)
 		return fiber.NewError(http.StatusInternalServerError, "Error while searching gig")
 	}
 
+	seller, ok := sellers[gig.SellerID]
+	if !ok {
+		log.Println("find gig by id: seller is not found", gig.SellerID)
+		return fiber.NewError(http.StatusInternalServerError, "Error while searching gig")
+	}
+
 	return c.Status(http.StatusOK).JSON(fiber.Map{
-		"seller": &types.SellerOverview{
-			SellerID:  gig.SellerID,
-			FullName:  res.FullName,
-			RatingSum: uint64(res.RatingSum),
-			RatingCategories: types.RatingCategory{
-				One:   uint(res.RatingCategories.One),
-				Two:   uint(res.RatingCategories.Two),
-				Three: uint(res.RatingCategories.Three),
-				Four:  uint(res.RatingCategories.Four),
-				Five:  uint(res.RatingCategories.Five),
-			},
-			RatingsCount: uint64(res.RatingsCount),
-		},
-		"gig": gig,
+		"seller": &seller,
+		"gig":    gig,
 	})
 }
 
@@ -98,6 +91,13 @@ func (gh *GigHandler) GigQuerySearch(c *fiber.Ctx) error {
 		return fiber.NewError(http.StatusBadRequest, "searching error")
 	}
 
+	for _, level := range strings.Split(q.SellerLevel, ",") {
+		level = strings.ToUpper(strings.TrimSpace(level))
+		if level != "" && !slices.Contains(types.SELLER_LEVELS, level) {
+			return fiber.NewError(http.StatusBadRequest, "invalid seller level")
+		}
+	}
+
 	result, err := gh.gigSvc.GigQuerySearch(ctx, &p, &q)
 	if err != nil {
 		log.Println("gig query search:", err)
@@ -116,6 +116,15 @@ func (gh *GigHandler) GigQuerySearch(c *fiber.Ctx) error {
 		return fiber.NewError(http.StatusInternalServerError, "Error while searching")
 	}
 
+	//HACK: SAVED FLAGS ARE EXTRA INFO, SEARCH RESULTS ARE STILL RETURNED WITHOUT THEM
+	var buyerID string
+	if userInfo, ok := c.UserContext().Value("current_user").(*types.JWTClaims); ok {
+		buyerID = userInfo.UserID
+	}
+	if err := gh.gigSvc.MarkSavedGigs(ctx, userGrpcClient, buyerID, gigs); err != nil {
+		log.Println("gig query search saved gigs", err)
+	}
+
 	return c.Status(http.StatusOK).JSON(fiber.Map{
 		"total":   result.Total,
 		"matched": result.Matched,
@@ -342,6 +351,18 @@ func (gh *GigHandler) Create(c *fiber.Ctx) error {
 		return fiber.NewError(http.StatusInternalServerError, "Error while parsing body")
 	}
 
+	if data.PackagesForm != "" {
+		if err := json.Unmarshal([]byte(data.PackagesForm), &data.Packages); err != nil {
+			return fiber.NewError(http.StatusBadRequest, "invalid packages")
+		}
+	}
+
+	if data.AddOnsForm != "" {
+		if err := json.Unmarshal([]byte(data.AddOnsForm), &data.AddOns); err != nil {
+			return fiber.NewError(http.StatusBadRequest, "invalid add-ons")
+		}
+	}
+
 	err = gh.validate.Struct(data)
 	if err != nil {
 		return c.Status(http.StatusBadRequest).JSON(fiber.Map{
@@ -349,6 +370,12 @@ func (gh *GigHandler) Create(c *fiber.Ctx) error {
 		})
 	}
 
+	data.Packages, err = types.ResolvePackages(data.Title, data.Packages, data.Price, data.ExpectedDeliveryDays)
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, err.Error())
+	}
+	data.Price, data.ExpectedDeliveryDays = types.MinPackagePriceAndDays(data.Packages)
+
 	cc, err := gh.grpcClient.GetClient(types.USER_SERVICE)
 	if err != nil {
 		return fiber.NewError(http.StatusInternalServerError, "Error while searching gig")
@@ -426,6 +453,18 @@ func (gh *GigHandler) Update(c *fiber.Ctx) error {
 		return fiber.NewError(http.StatusInternalServerError, "Error while parsing body")
 	}
 
+	if data.PackagesForm != "" {
+		if err := json.Unmarshal([]byte(data.PackagesForm), &data.Packages); err != nil {
+			return fiber.NewError(http.StatusBadRequest, "invalid packages")
+		}
+	}
+
+	if data.AddOnsForm != "" {
+		if err := json.Unmarshal([]byte(data.AddOnsForm), &data.AddOns); err != nil {
+			return fiber.NewError(http.StatusBadRequest, "invalid add-ons")
+		}
+	}
+
 	err = gh.validate.Struct(data)
 	if err != nil {
 		return c.Status(http.StatusBadRequest).JSON(fiber.Map{
@@ -433,6 +472,12 @@ func (gh *GigHandler) Update(c *fiber.Ctx) error {
 		})
 	}
 
+	data.Packages, err = types.ResolvePackages(data.Title, data.Packages, data.Price, data.ExpectedDeliveryDays)
+	if err != nil {
+		return fiber.NewError(http.StatusBadRequest, err.Error())
+	}
+	data.Price, data.ExpectedDeliveryDays = types.MinPackagePriceAndDays(data.Packages)
+
 	if data.CoverImage == "" {
 		formHeader, err := c.FormFile("imageFile")
 		if err != nil {
@@ -488,7 +533,7 @@ func (gh *GigHandler) Update(c *fiber.Ctx) error {
 		data.CoverImage = uploadResult.SecureURL
 	}
 
-	gig, err = gh.gigSvc.Update(ctx, data)
+	gig, err = gh.gigSvc.Update(ctx, gig.ID.String(), data)
 	if err != nil {
 		log.Println("update gig", err)
 		return fiber.NewError(http.StatusInternalServerError, "Error while updating")
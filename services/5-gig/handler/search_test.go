@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/Akihira77/gojobber/services/5-gig/types"
+)
+
+func searchSellerIDs(t *testing.T, h *GigGrpcHandler, q *types.GigSearchQuery) []string {
+	t.Helper()
+
+	res, err := h.gigSvc.GigQuerySearch(context.Background(), &types.GigSearchParams{Page: 1, Size: 10}, q)
+	if err != nil {
+		t.Fatalf("search: %v", err)
+	}
+
+	sellerIDs := make([]string, len(res.Gigs))
+	for i, g := range res.Gigs {
+		sellerIDs[i] = g.SellerID
+	}
+	slices.Sort(sellerIDs)
+	return sellerIDs
+}
+
+func TestGigQuerySearchFiltersBySellerLevel(t *testing.T) {
+	h, db := newTestHandler(t)
+	for id, level := range map[string]string{"seller-new": "NEW", "seller-two": "LEVEL_TWO", "seller-top": "TOP_RATED"} {
+		newTestSeller(t, db, id, level, nil, nil)
+		newTestGig(t, db, id)
+	}
+
+	tests := []struct {
+		level string
+		want  []string
+	}{
+		{level: "", want: []string{"seller-new", "seller-top", "seller-two"}},
+		{level: "NEW", want: []string{"seller-new"}},
+		{level: "level_two, TOP_RATED,,top_rated", want: []string{"seller-top", "seller-two"}},
+		{level: "LEVEL_ONE", want: []string{}},
+	}
+
+	for _, tt := range tests {
+		got := searchSellerIDs(t, h, &types.GigSearchQuery{SellerLevel: tt.level})
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("seller level %q: sellers = %v, want %v", tt.level, got, tt.want)
+		}
+	}
+}
+
+func TestGigQuerySearchHidesSellersOnVacation(t *testing.T) {
+	h, db := newTestHandler(t)
+	now := time.Now()
+	lastWeek, yesterday, tomorrow, nextWeek := now.AddDate(0, 0, -7), now.AddDate(0, 0, -1), now.AddDate(0, 0, 1), now.AddDate(0, 0, 7)
+
+	sellers := []struct {
+		id         string
+		start, end *time.Time
+	}{
+		{id: "seller-home"},
+		{id: "seller-away", start: &yesterday, end: &tomorrow},
+		{id: "seller-back", start: &lastWeek, end: &yesterday},
+		{id: "seller-leaving", start: &tomorrow, end: &nextWeek},
+	}
+	for _, s := range sellers {
+		newTestSeller(t, db, s.id, "NEW", s.start, s.end)
+		newTestGig(t, db, s.id)
+	}
+
+	got := searchSellerIDs(t, h, &types.GigSearchQuery{})
+	want := []string{"seller-back", "seller-home", "seller-leaving"}
+	if !slices.Equal(got, want) {
+		t.Errorf("sellers = %v, want %v", got, want)
+	}
+}
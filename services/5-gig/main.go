@@ -37,11 +37,32 @@ func main() {
 		log.Fatalf("Error applying DB setup:\n%+v", err)
 	}
 
+	err = db.Debug().AutoMigrate(&types.GigPackage{}, &types.GigAddOn{})
+	if err != nil {
+		log.Fatalf("Error migrating gig packages and add-ons:\n%+v", err)
+	}
+
+	err = types.ApplyGigPackageSetup(db)
+	if err != nil {
+		log.Fatalf("Error applying gig packages setup:\n%+v", err)
+	}
+
+	err = types.ApplyGigAddOnSetup(db)
+	if err != nil {
+		log.Fatalf("Error applying gig add-ons setup:\n%+v", err)
+	}
+
 	cld := util.NewCloudinary()
 	ccs := handler.NewGRPCClients()
 	ccs.AddClient(types.USER_SERVICE, os.Getenv("USER_GRPC_PORT"))
 
-	NewHttpServer(db, cld, ccs)
+	go NewHttpServer(db, cld, ccs)
+
+	grpcServer := NewGRPCServer(os.Getenv("GIG_GRPC_PORT"))
+	err = grpcServer.Run(db)
+	if err != nil {
+		log.Fatalf("Failed running GRPC Server %v", err)
+	}
 }
 
 func seedingGig(db *gorm.DB) {
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/Akihira77/gojobber/services/5-gig/handler"
 	"github.com/Akihira77/gojobber/services/5-gig/service"
@@ -27,11 +28,11 @@ func MainRouter(db *gorm.DB, cld *util.Cloudinary, app *fiber.App, ccs *handler.
 	api := app.Group(BASE_PATH)
 	api.Use(verifyGatewayReq)
 
-	gigSvc := service.NewGigService(db)
+	gigSvc := service.NewGigService(db, util.GetEnvDuration("SELLER_CACHE_TTL", 30*time.Second))
 	gigHandler := handler.NewGigHandler(gigSvc, cld, ccs)
 
 	api.Get("/id/:id", gigHandler.FindGigByID)
-	api.Get("/search/:page/:size", gigHandler.GigQuerySearch)
+	api.Get("/search/:page/:size", optionalAuth, gigHandler.GigQuerySearch)
 	api.Get("/category/:category/:page/:size", gigHandler.FindGigByCategory)
 	api.Get("/popular/:page/:size", gigHandler.GetPopularGigs)
 	api.Get("/similar/:gigId/:page/:size", gigHandler.FindSimilarGigs)
@@ -98,3 +99,26 @@ func authOnly(c *fiber.Ctx) error {
 	c.SetUserContext(context.WithValue(c.UserContext(), "current_user", claims))
 	return c.Next()
 }
+
+// optionalAuth sets current_user when the request carries a valid token and
+// lets anonymous requests through.
+func optionalAuth(c *fiber.Ctx) error {
+	tokenStr := c.Cookies("token")
+	if tokenStr == "" {
+		authHeader := c.Get("Authorization")
+		if len(strings.Split(authHeader, " ")) < 2 {
+			return c.Next()
+		}
+		tokenStr = strings.Split(authHeader, " ")[1]
+	}
+
+	token, err := util.VerifyingJWT(os.Getenv("JWT_SECRET"), tokenStr)
+	if err != nil {
+		return c.Next()
+	}
+
+	if claims, ok := token.Claims.(*types.JWTClaims); ok {
+		c.SetUserContext(context.WithValue(c.UserContext(), "current_user", claims))
+	}
+	return c.Next()
+}
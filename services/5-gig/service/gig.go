@@ -4,14 +4,17 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"maps"
 	"math"
+	"slices"
 	"strings"
-	"sync"
 	"time"
 
 	"github.com/Akihira77/gojobber/services/5-gig/types"
 	"github.com/Akihira77/gojobber/services/common/genproto/user"
+	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type GigServiceImpl interface {
@@ -19,83 +22,112 @@ type GigServiceImpl interface {
 	FindGigBySellerIDAndGigID(ctx context.Context, sellerId, id string) (*types.GigDTO, error)
 	GigQuerySearch(ctx context.Context, p *types.GigSearchParams, q *types.GigSearchQuery) (types.GigSearchQueryResult, error)
 	FindSellerGigs(ctx context.Context, gigStatus bool, sellerId string, p *types.GigSearchParams) ([]types.GigDTO, error)
+	FindAllSellerGigs(ctx context.Context, sellerId string) ([]types.GigDTO, error)
 	FindGigByCategory(ctx context.Context, category string, p *types.GigSearchParams) ([]types.GigDTO, error)
 	GetPopularGigs(ctx context.Context, p *types.GigSearchParams) ([]types.GigDTO, error)
 	FindSimilarGigs(ctx context.Context, p *types.GigSearchParams, data *types.GigDTO) ([]types.GigDTO, error)
 	Create(ctx context.Context, data *types.CreateGigDTO) (*types.GigDTO, error)
-	Update(ctx context.Context, data *types.UpdateGigDTO) (*types.GigDTO, error)
+	Update(ctx context.Context, gigID string, data *types.UpdateGigDTO) (*types.GigDTO, error)
 	ChangeGigStatus(ctx context.Context, gigId string, s bool) error
 	DeleteGigByID(ctx context.Context, gigId string) error
 	FindAndMapSellerInGigs(ctx context.Context, userGrpcClient user.UserServiceClient, gigs []types.GigDTO) ([]types.GigSellerDTO, error)
+	FindSellerOverviews(ctx context.Context, userGrpcClient user.UserServiceClient, sellerIDs []string) (map[string]types.SellerOverview, error)
+	MarkSavedGigs(ctx context.Context, userGrpcClient user.UserServiceClient, buyerID string, gigs []types.GigSellerDTO) error
 }
 
+// sellerNotOnVacation hides the gigs of sellers whose vacation is running.
+// Sellers share the database, vacations are set through the user service.
+const sellerNotOnVacation = "seller_id NOT IN (SELECT id FROM sellers WHERE vacation_start <= now() AND vacation_end > now())"
+
+// packageTierOrder lists the packages BASIC first.
+const packageTierOrder = "CASE tier WHEN 'BASIC' THEN 1 WHEN 'STANDARD' THEN 2 WHEN 'PREMIUM' THEN 3 END"
+
+// FIND_SELLERS_BATCH_SIZE matches the most sellers the user service returns
+// from one FindSellers call.
+const FIND_SELLERS_BATCH_SIZE = 100
+
 type GigService struct {
-	db *gorm.DB
+	db      *gorm.DB
+	sellers *sellerCache
 }
 
-func NewGigService(db *gorm.DB) GigServiceImpl {
+func NewGigService(db *gorm.DB, sellerCacheTTL time.Duration) GigServiceImpl {
 	return &GigService{
-		db: db,
+		db:      db,
+		sellers: newSellerCache(sellerCacheTTL),
 	}
 }
 
 func (gs *GigService) FindAndMapSellerInGigs(ctx context.Context, userGrpcClient user.UserServiceClient, gigs []types.GigDTO) ([]types.GigSellerDTO, error) {
-	var result []types.GigSellerDTO
-	var wg sync.WaitGroup
-	errCh := make(chan error, len(gigs))
-
+	sellerIDs := make([]string, 0, len(gigs))
 	for _, gig := range gigs {
-		wg.Add(1)
-		go func() {
-			defer wg.Done()
-
-			newCtx, cancel := context.WithTimeout(ctx, 200*time.Millisecond)
-			defer cancel()
-
-			s, err := userGrpcClient.FindSeller(newCtx, &user.FindSellerRequest{
-				SellerId: gig.SellerID,
-			})
-
-			if err != nil {
-				errCh <- fmt.Errorf("Invalid seller data")
-				return
-			}
+		if !slices.Contains(sellerIDs, gig.SellerID) {
+			sellerIDs = append(sellerIDs, gig.SellerID)
+		}
+	}
 
-			result = append(result, types.GigSellerDTO{
-				Seller: types.SellerOverview{
-					SellerID:  gig.SellerID,
-					FullName:  s.FullName,
-					RatingSum: uint64(s.RatingSum),
-					RatingCategories: types.RatingCategory{
-						One:   uint(s.RatingCategories.One),
-						Two:   uint(s.RatingCategories.Two),
-						Three: uint(s.RatingCategories.Three),
-						Four:  uint(s.RatingCategories.Four),
-						Five:  uint(s.RatingCategories.Five),
-					},
-					RatingsCount: uint64(s.RatingsCount),
-				},
-				Gig: gig,
-			})
+	sellers, err := gs.FindSellerOverviews(ctx, userGrpcClient, sellerIDs)
+	if err != nil {
+		return nil, err
+	}
 
-			errCh <- nil
-		}()
+	result := make([]types.GigSellerDTO, 0, len(gigs))
+	for _, gig := range gigs {
+		seller, ok := sellers[gig.SellerID]
+		if !ok {
+			return nil, fmt.Errorf("Invalid seller data")
+		}
 
+		result = append(result, types.GigSellerDTO{
+			Seller: seller,
+			Gig:    gig,
+		})
 	}
 
-	go func() {
-		wg.Wait()
-		close(errCh)
-	}()
+	return result, nil
+}
 
-	for err := range errCh {
+// FindSellerOverviews resolves the sellers from the cache and asks the user
+// service only for the missing ones, FIND_SELLERS_BATCH_SIZE at a time.
+func (gs *GigService) FindSellerOverviews(ctx context.Context, userGrpcClient user.UserServiceClient, sellerIDs []string) (map[string]types.SellerOverview, error) {
+	now := time.Now()
+	sellers, missing := gs.sellers.get(sellerIDs, now)
+
+	fetched := make(map[string]types.SellerOverview, len(missing))
+	for batch := range slices.Chunk(missing, FIND_SELLERS_BATCH_SIZE) {
+		newCtx, cancel := context.WithTimeout(ctx, 200*time.Millisecond)
+		res, err := userGrpcClient.FindSellers(newCtx, &user.FindSellersRequest{
+			SellerIds: batch,
+		})
+		cancel()
 		if err != nil {
-			fmt.Println(err)
-			return result, err
+			return nil, err
+		}
+
+		for id, s := range res.Sellers {
+			fetched[id] = types.SellerOverview{
+				SellerID:  id,
+				FullName:  s.FullName,
+				RatingSum: uint64(s.RatingSum),
+				RatingCategories: types.RatingCategory{
+					One:   uint(s.RatingCategories.GetOne()),
+					Two:   uint(s.RatingCategories.GetTwo()),
+					Three: uint(s.RatingCategories.GetThree()),
+					Four:  uint(s.RatingCategories.GetFour()),
+					Five:  uint(s.RatingCategories.GetFive()),
+				},
+				RatingsCount: uint64(s.RatingsCount),
+				Level:        s.Level,
+				OnVacation:   s.OnVacation,
+				Verified:     s.Verified,
+			}
 		}
 	}
 
-	return result, nil
+	gs.sellers.set(fetched, now)
+	maps.Copy(sellers, fetched)
+
+	return sellers, nil
 }
 
 func (gs *GigService) FindGigByID(ctx context.Context, id string) (*types.GigDTO, error) {
@@ -103,7 +135,13 @@ func (gs *GigService) FindGigByID(ctx context.Context, id string) (*types.GigDTO
 	result := gs.db.WithContext(ctx).
 		Model(&types.Gig{}).
 		First(&gig, "id = ?", id)
-	return &gig, result.Error
+	if result.Error != nil {
+		return &gig, result.Error
+	}
+
+	gigs := []types.GigDTO{gig}
+	err := gs.attachPackagesAndAddOns(ctx, gigs)
+	return &gigs[0], err
 }
 
 func (gs *GigService) FindGigBySellerIDAndGigID(ctx context.Context, sellerId, id string) (*types.GigDTO, error) {
@@ -111,7 +149,13 @@ func (gs *GigService) FindGigBySellerIDAndGigID(ctx context.Context, sellerId, i
 	result := gs.db.WithContext(ctx).
 		Model(&types.Gig{}).
 		First(&gig, "id = ? AND seller_id = ?", id, sellerId)
-	return &gig, result.Error
+	if result.Error != nil {
+		return &gig, result.Error
+	}
+
+	gigs := []types.GigDTO{gig}
+	err := gs.attachPackagesAndAddOns(ctx, gigs)
+	return &gigs[0], err
 }
 
 func (gs *GigService) GigQuerySearch(ctx context.Context, p *types.GigSearchParams, q *types.GigSearchQuery) (types.GigSearchQueryResult, error) {
@@ -133,7 +177,8 @@ func (gs *GigService) GigQuerySearch(ctx context.Context, p *types.GigSearchPara
 		WithContext(ctx).
 		Model(&types.Gig{}).
 		Count(&total).
-		Where("active = true AND price <= ? AND expected_delivery_days <= ?", q.Max, q.DeliveryTime)
+		Where("active = true AND price <= ? AND expected_delivery_days <= ?", q.Max, q.DeliveryTime).
+		Where(sellerNotOnVacation)
 
 	if q.Query != "" {
 		q.Query = strings.ReplaceAll(strings.ToLower(q.Query), "-", " ")
@@ -157,6 +202,11 @@ func (gs *GigService) GigQuerySearch(ctx context.Context, p *types.GigSearchPara
 		query = query.Order(orderClause)
 	}
 
+	if levels := splitSellerLevels(q.SellerLevel); len(levels) > 0 {
+		//NOTE: SELLERS SHARE THE DATABASE, THEIR LEVEL IS KEPT BY THE USER SERVICE
+		query = query.Where("seller_id IN (SELECT id FROM sellers WHERE level IN ?)", levels)
+	}
+
 	var matchedQuery int64
 	result := query.
 		Order(`rating_sum DESC`).
@@ -164,6 +214,9 @@ func (gs *GigService) GigQuerySearch(ctx context.Context, p *types.GigSearchPara
 		Offset((p.Page - 1) * p.Size).
 		Limit(p.Size).
 		Find(&gigs)
+	if result.Error == nil {
+		result.Error = gs.attachPackagesAndAddOns(ctx, gigs)
+	}
 
 	return types.GigSearchQueryResult{
 		Total:   total,
@@ -257,6 +310,18 @@ func (gs *GigService) Create(ctx context.Context, data *types.CreateGigDTO) (*ty
 		return &types.GigDTO{}, result.Error
 	}
 
+	packages, err := savePackages(tx, gig.ID, data.Packages)
+	if err != nil {
+		tx.Rollback()
+		return &types.GigDTO{}, err
+	}
+
+	addOns, err := saveAddOns(tx, gig.ID, data.AddOns)
+	if err != nil {
+		tx.Rollback()
+		return &types.GigDTO{}, err
+	}
+
 	result = tx.Commit()
 	if result.Error != nil {
 		tx.Rollback()
@@ -279,6 +344,8 @@ func (gs *GigService) Create(ctx context.Context, data *types.CreateGigDTO) (*ty
 		CoverImage:           gig.CoverImage,
 		SortID:               gig.SortID,
 		CreatedAt:            gig.CreatedAt,
+		Packages:             packages,
+		AddOns:               addOns,
 	}, result.Error
 }
 
@@ -299,11 +366,16 @@ func (gs *GigService) FindGigByCategory(ctx context.Context, c string, p *types.
 		Debug().
 		WithContext(ctx).
 		Model(&types.Gig{}).
+		Where(sellerNotOnVacation).
 		Offset((p.Page-1)*p.Size).
 		Limit(p.Size).
 		Find(&gigs, "active = true AND category_tokens @@ websearch_to_tsquery('english', ?)", strings.ToLower(c))
 
-	return gigs, result.Error
+	if result.Error != nil {
+		return gigs, result.Error
+	}
+
+	return gigs, gs.attachPackagesAndAddOns(ctx, gigs)
 }
 
 func (gs *GigService) FindSellerGigs(ctx context.Context, active bool, sellerID string, p *types.GigSearchParams) ([]types.GigDTO, error) {
@@ -316,7 +388,28 @@ func (gs *GigService) FindSellerGigs(ctx context.Context, active bool, sellerID
 		Limit(p.Size).
 		Find(&gigs, "seller_id = ? AND active = ?", sellerID, active)
 
-	return gigs, result.Error
+	if result.Error != nil {
+		return gigs, result.Error
+	}
+
+	return gigs, gs.attachPackagesAndAddOns(ctx, gigs)
+}
+
+// FindAllSellerGigs returns the active and inactive gigs of a seller, it is
+// used by the personal data export.
+func (gs *GigService) FindAllSellerGigs(ctx context.Context, sellerID string) ([]types.GigDTO, error) {
+	var gigs []types.GigDTO
+	result := gs.db.
+		WithContext(ctx).
+		Model(&types.Gig{}).
+		Order("created_at").
+		Find(&gigs, "seller_id = ?", sellerID)
+
+	if result.Error != nil {
+		return gigs, result.Error
+	}
+
+	return gigs, gs.attachPackagesAndAddOns(ctx, gigs)
 }
 
 func (gs *GigService) FindSimilarGigs(ctx context.Context, p *types.GigSearchParams, gig *types.GigDTO) ([]types.GigDTO, error) {
@@ -335,7 +428,8 @@ func (gs *GigService) FindSimilarGigs(ctx context.Context, p *types.GigSearchPar
 			strings.ToLower(gig.Title),
 			strings.ToLower(gig.Category),
 			strings.Join(gig.SubCategories, ","),
-			strings.Join(gig.Tags, ","))
+			strings.Join(gig.Tags, ",")).
+		Where(sellerNotOnVacation)
 
 	orderClause := fmt.Sprintf(`
 		ts_rank(title_tokens, websearch_to_tsquery('english', '%s')) +
@@ -355,46 +449,119 @@ func (gs *GigService) FindSimilarGigs(ctx context.Context, p *types.GigSearchPar
 		Limit(p.Size).
 		Find(&gigs)
 
-	return gigs, result.Error
+	if result.Error != nil {
+		return gigs, result.Error
+	}
+
+	return gigs, gs.attachPackagesAndAddOns(ctx, gigs)
+}
+
+// MarkSavedGigs flags the gigs buyerID saved and fills how many buyers saved
+// each gig. An empty buyerID only fills the counts.
+func (gs *GigService) MarkSavedGigs(ctx context.Context, userGrpcClient user.UserServiceClient, buyerID string, gigs []types.GigSellerDTO) error {
+	if len(gigs) == 0 {
+		return nil
+	}
+
+	gigIDs := make([]string, len(gigs))
+	for i := range gigs {
+		gigIDs[i] = gigs[i].Gig.ID.String()
+	}
+
+	newCtx, cancel := context.WithTimeout(ctx, 200*time.Millisecond)
+	defer cancel()
+
+	res, err := userGrpcClient.FindSavedGigs(newCtx, &user.FindSavedGigsRequest{
+		BuyerId: buyerID,
+		GigIds:  gigIDs,
+	})
+	if err != nil {
+		return err
+	}
+
+	saved := make(map[string]bool, len(res.SavedGigIds))
+	for _, id := range res.SavedGigIds {
+		saved[id] = true
+	}
+
+	for i := range gigs {
+		id := gigs[i].Gig.ID.String()
+		gigs[i].Gig.Saved = saved[id]
+		gigs[i].Gig.SavesCount = res.SaveCounts[id]
+	}
+
+	return nil
 }
 
 func (gs *GigService) GetPopularGigs(ctx context.Context, p *types.GigSearchParams) ([]types.GigDTO, error) {
+	//NOTE: SAVE COUNTS FROM THE USER SERVICE (FindSavedGigs) COULD BE PART OF THIS RANKING
 	var gigs []types.GigDTO
 	result := gs.db.
 		WithContext(ctx).
 		Model(&types.Gig{}).
+		Where(sellerNotOnVacation).
 		Order("rating_sum DESC").
 		Offset((p.Page - 1) * p.Size).
 		Limit(p.Size).
 		Find(&gigs)
 
-	return gigs, result.Error
+	if result.Error != nil {
+		return gigs, result.Error
+	}
+
+	return gigs, gs.attachPackagesAndAddOns(ctx, gigs)
 }
 
-func (gs *GigService) Update(ctx context.Context, data *types.UpdateGigDTO) (*types.GigDTO, error) {
+func (gs *GigService) Update(ctx context.Context, gigID string, data *types.UpdateGigDTO) (*types.GigDTO, error) {
 	var gig types.Gig
-	result := gs.db.
+	var packages []types.GigPackage
+	var addOns []types.GigAddOn
+	err := gs.db.
 		Debug().
-		Raw(
-			`UPDATE gigs 
-			SET title = ?, description = ?, category = ?, sub_categories = ?, tags = ?,
-			expected_delivery_days = ?, price = ?, cover_image = ?, title_tokens = strip(to_tsvector('english', ?)), 
-			description_tokens = strip(to_tsvector('english', ?)), category_tokens = strip(to_tsvector('english', ?)), 
-			sub_categories_tokens = strip(to_tsvector('english', ?)), tags_tokens = strip(to_tsvector('english', ?)) RETURNING *`,
-			data.Title,
-			data.Description,
-			data.Category,
-			data.SubCategories,
-			data.Tags,
-			data.ExpectedDeliveryDays,
-			data.Price,
-			data.CoverImage,
-			data.Title,
-			data.Description,
-			data.Category,
-			strings.Join(data.SubCategories, ","),
-			strings.Join(data.Tags, ","),
-		).Scan(&gig)
+		WithContext(ctx).
+		Transaction(func(tx *gorm.DB) error {
+			result := tx.
+				Raw(
+					`UPDATE gigs 
+					SET title = ?, description = ?, category = ?, sub_categories = ?, tags = ?,
+					expected_delivery_days = ?, price = ?, cover_image = ?, title_tokens = strip(to_tsvector('english', ?)), 
+					description_tokens = strip(to_tsvector('english', ?)), category_tokens = strip(to_tsvector('english', ?)), 
+					sub_categories_tokens = strip(to_tsvector('english', ?)), tags_tokens = strip(to_tsvector('english', ?))
+					WHERE id = ? RETURNING *`,
+					data.Title,
+					data.Description,
+					data.Category,
+					data.SubCategories,
+					data.Tags,
+					data.ExpectedDeliveryDays,
+					data.Price,
+					data.CoverImage,
+					data.Title,
+					data.Description,
+					data.Category,
+					strings.Join(data.SubCategories, ","),
+					strings.Join(data.Tags, ","),
+					gigID,
+				).Scan(&gig)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return gorm.ErrRecordNotFound
+			}
+
+			var err error
+			packages, err = savePackages(tx, gig.ID, data.Packages)
+			if err != nil {
+				return err
+			}
+
+			addOns, err = saveAddOns(tx, gig.ID, data.AddOns)
+			return err
+		})
+	if err != nil {
+		return &types.GigDTO{}, err
+	}
 
 	return &types.GigDTO{
 		ID:                   gig.ID,
@@ -413,7 +580,9 @@ func (gs *GigService) Update(ctx context.Context, data *types.UpdateGigDTO) (*ty
 		CoverImage:           gig.CoverImage,
 		SortID:               gig.SortID,
 		CreatedAt:            gig.CreatedAt,
-	}, result.Error
+		Packages:             packages,
+		AddOns:               addOns,
+	}, nil
 }
 
 func (gs *GigService) ChangeGigStatus(ctx context.Context, gigID string, s bool) error {
@@ -425,3 +594,176 @@ func (gs *GigService) ChangeGigStatus(ctx context.Context, gigID string, s bool)
 
 	return result.Error
 }
+
+// savePackages upserts the packages of a gig by tier and removes the tiers
+// that are not offered anymore, so package ids stay the same across edits.
+func savePackages(tx *gorm.DB, gigID uuid.UUID, data []types.GigPackageDTO) ([]types.GigPackage, error) {
+	now := time.Now()
+	packages := make([]types.GigPackage, len(data))
+	tiers := make([]types.GigPackageTier, len(data))
+	for i, p := range data {
+		packages[i] = types.GigPackage{
+			GigID:        gigID,
+			Tier:         p.Tier,
+			Name:         p.Name,
+			Description:  p.Description,
+			Price:        p.Price,
+			DeliveryDays: p.DeliveryDays,
+			Revisions:    p.Revisions,
+			Features:     p.Features,
+			CreatedAt:    now,
+			UpdatedAt:    now,
+		}
+		tiers[i] = p.Tier
+	}
+
+	result := tx.
+		Where("gig_id = ? AND tier NOT IN ?", gigID, tiers).
+		Delete(&types.GigPackage{})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	result = tx.
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "gig_id"}, {Name: "tier"}},
+			DoUpdates: clause.AssignmentColumns([]string{"name", "description", "price", "delivery_days", "revisions", "features", "updated_at"}),
+		}).
+		Create(&packages)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	//INFO: ON CONFLICT KEEPS THE STORED ID, READ THE ROWS BACK INSTEAD OF TRUSTING THE SLICE
+	result = tx.
+		Where("gig_id = ?", gigID).
+		Order(packageTierOrder).
+		Find(&packages)
+
+	return packages, result.Error
+}
+
+// saveAddOns replaces the add-ons of a gig. Add-ons sent back with the id of
+// one of the gig's add-ons are updated in place, other ids are ignored.
+func saveAddOns(tx *gorm.DB, gigID uuid.UUID, data []types.GigAddOnDTO) ([]types.GigAddOn, error) {
+	var existing []uuid.UUID
+	result := tx.
+		Model(&types.GigAddOn{}).
+		Where("gig_id = ?", gigID).
+		Pluck("id", &existing)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	now := time.Now()
+	addOns := make([]types.GigAddOn, len(data))
+	kept := make([]uuid.UUID, 0, len(data))
+	for i, a := range data {
+		id, err := uuid.Parse(a.ID)
+		if err != nil || !slices.Contains(existing, id) || slices.Contains(kept, id) {
+			id = uuid.New()
+		}
+		kept = append(kept, id)
+
+		addOns[i] = types.GigAddOn{
+			ID:                id,
+			GigID:             gigID,
+			Title:             a.Title,
+			Description:       a.Description,
+			Price:             a.Price,
+			DeliveryDaysDelta: a.DeliveryDaysDelta,
+			CreatedAt:         now,
+			UpdatedAt:         now,
+		}
+	}
+
+	query := tx.Where("gig_id = ?", gigID)
+	if len(kept) > 0 {
+		query = query.Where("id NOT IN ?", kept)
+	}
+	result = query.Delete(&types.GigAddOn{})
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	if len(addOns) == 0 {
+		return []types.GigAddOn{}, nil
+	}
+
+	result = tx.
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"title", "description", "price", "delivery_days_delta", "updated_at"}),
+		}).
+		Create(&addOns)
+
+	return addOns, result.Error
+}
+
+// attachPackagesAndAddOns fills the packages and add-ons of the gigs with one
+// query each.
+func (gs *GigService) attachPackagesAndAddOns(ctx context.Context, gigs []types.GigDTO) error {
+	if len(gigs) == 0 {
+		return nil
+	}
+
+	gigIDs := make([]uuid.UUID, len(gigs))
+	for i := range gigs {
+		gigIDs[i] = gigs[i].ID
+		gigs[i].Packages = []types.GigPackage{}
+		gigs[i].AddOns = []types.GigAddOn{}
+	}
+
+	var packages []types.GigPackage
+	result := gs.db.
+		WithContext(ctx).
+		Model(&types.GigPackage{}).
+		Where("gig_id IN ?", gigIDs).
+		Order(packageTierOrder).
+		Find(&packages)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	var addOns []types.GigAddOn
+	result = gs.db.
+		WithContext(ctx).
+		Model(&types.GigAddOn{}).
+		Where("gig_id IN ?", gigIDs).
+		Order("created_at").
+		Find(&addOns)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	packagesByGig := make(map[uuid.UUID][]types.GigPackage, len(gigs))
+	for _, p := range packages {
+		packagesByGig[p.GigID] = append(packagesByGig[p.GigID], p)
+	}
+	addOnsByGig := make(map[uuid.UUID][]types.GigAddOn, len(gigs))
+	for _, a := range addOns {
+		addOnsByGig[a.GigID] = append(addOnsByGig[a.GigID], a)
+	}
+	for i := range gigs {
+		if p, ok := packagesByGig[gigs[i].ID]; ok {
+			gigs[i].Packages = p
+		}
+		if a, ok := addOnsByGig[gigs[i].ID]; ok {
+			gigs[i].AddOns = a
+		}
+	}
+
+	return nil
+}
+
+// splitSellerLevels turns "level_two, TOP_RATED" into ["LEVEL_TWO", "TOP_RATED"].
+func splitSellerLevels(s string) []string {
+	var levels []string
+	for _, level := range strings.Split(s, ",") {
+		level = strings.ToUpper(strings.TrimSpace(level))
+		if level != "" && !slices.Contains(levels, level) {
+			levels = append(levels, level)
+		}
+	}
+	return levels
+}
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"sync"
+	"time"
+
+	"github.com/Akihira77/gojobber/services/5-gig/types"
+)
+
+// sellerCacheSweepSize is how large the cache may grow before expired entries
+// are swept.
+const sellerCacheSweepSize = 1024
+
+type sellerCacheEntry struct {
+	seller    types.SellerOverview
+	expiresAt time.Time
+}
+
+// sellerCache keeps seller overviews for a short while so listing pages do not
+// ask the user service for the same sellers on every request. A zero ttl
+// disables it.
+type sellerCache struct {
+	mu      sync.RWMutex
+	ttl     time.Duration
+	entries map[string]sellerCacheEntry
+}
+
+func newSellerCache(ttl time.Duration) *sellerCache {
+	return &sellerCache{
+		ttl:     ttl,
+		entries: make(map[string]sellerCacheEntry),
+	}
+}
+
+// get returns the cached sellers and the ids that still have to be fetched.
+func (c *sellerCache) get(ids []string, now time.Time) (map[string]types.SellerOverview, []string) {
+	found := make(map[string]types.SellerOverview, len(ids))
+	var missing []string
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for _, id := range ids {
+		entry, ok := c.entries[id]
+		if ok && now.Before(entry.expiresAt) {
+			found[id] = entry.seller
+			continue
+		}
+		missing = append(missing, id)
+	}
+
+	return found, missing
+}
+
+func (c *sellerCache) set(sellers map[string]types.SellerOverview, now time.Time) {
+	if c.ttl <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.entries) > sellerCacheSweepSize {
+		for id, entry := range c.entries {
+			if !now.Before(entry.expiresAt) {
+				delete(c.entries, id)
+			}
+		}
+	}
+
+	for id, seller := range sellers {
+		c.entries[id] = sellerCacheEntry{
+			seller:    seller,
+			expiresAt: now.Add(c.ttl),
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// GigAddOn is an extra a buyer can pick on top of a package at checkout, such
+// as express delivery or source files.
+type GigAddOn struct {
+	ID          uuid.UUID `json:"id" gorm:"primaryKey;type:uuid"`
+	GigID       uuid.UUID `json:"gigId" gorm:"type:uuid;not null;index"`
+	Title       string    `json:"title" gorm:"not null"`
+	Description string    `json:"description" gorm:"not null;default:''"`
+	// NOTE: IN WHOLE CURRENCY UNITS, THE SAME AS THE ORDER PRICE
+	Price uint64 `json:"price" gorm:"not null"`
+	// NOTE: ADDED TO THE PACKAGE DELIVERY DAYS, NEGATIVE FOR EXPRESS DELIVERY
+	DeliveryDaysDelta int       `json:"deliveryDaysDelta" gorm:"not null;default:0"`
+	CreatedAt         time.Time `json:"createdAt" gorm:"not null"`
+	UpdatedAt         time.Time `json:"updatedAt" gorm:"not null"`
+}
+
+// GigAddOnDTO keeps its ID when it is sent back on update, add-ons without one
+// are created.
+type GigAddOnDTO struct {
+	ID                string `json:"id" validate:"omitempty,uuid"`
+	Title             string `json:"title" validate:"required,max=64"`
+	Description       string `json:"description" validate:"max=300"`
+	Price             uint64 `json:"price" validate:"required,gt=0"`
+	DeliveryDaysDelta int    `json:"deliveryDaysDelta" validate:"gte=-365,lte=365"`
+}
+
+// ApplyGigAddOnSetup removes the add-ons together with their gig. It is safe
+// to run on every start.
+func ApplyGigAddOnSetup(db *gorm.DB) error {
+	if db.Migrator().HasConstraint(&GigAddOn{}, "fk_gig_add_ons_gig") {
+		return nil
+	}
+
+	result := db.Debug().Exec(`
+		ALTER TABLE gig_add_ons
+		ADD CONSTRAINT fk_gig_add_ons_gig FOREIGN KEY (gig_id) REFERENCES gigs(id) ON DELETE CASCADE ON UPDATE CASCADE;
+		`)
+	return result.Error
+}
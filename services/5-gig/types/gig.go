@@ -37,7 +37,8 @@ type Gig struct {
 	Tags       pq.StringArray `json:"tags" gorm:"type:text[];not null"`
 	TagsTokens string         `json:"tagsTokens" gorm:"type:tsvector;column:tags_tokens;"`
 
-	Active               bool           `json:"active" gorm:"type:bool;default:true;not null"`
+	Active bool `json:"active" gorm:"type:bool;default:true;not null"`
+	// NOTE: THE LOWEST DELIVERY DAYS AND PRICE OF THE GIG PACKAGES
 	ExpectedDeliveryDays uint           `json:"expectedDeliveryDays" gorm:"not null;"`
 	RatingsCount         uint64         `json:"ratingsCount" gorm:"not null"`
 	RatingSum            uint64         `json:"ratingSum" gorm:"not null;"`
@@ -94,6 +95,9 @@ type SellerOverview struct {
 	RatingsCount     uint64         `json:"ratingsCount"`
 	RatingSum        uint64         `json:"ratingSum"`
 	RatingCategories RatingCategory `json:"ratingCategories" gorm:"serializer:json"`
+	Level            string         `json:"level"`
+	OnVacation       bool           `json:"onVacation"`
+	Verified         bool           `json:"verified"`
 }
 
 type GigDTO struct {
@@ -113,6 +117,11 @@ type GigDTO struct {
 	CoverImage           string         `json:"coverImage"`
 	SortID               uint           `json:"sortId"`
 	CreatedAt            time.Time      `json:"createdAt"`
+	Packages             []GigPackage   `json:"packages" gorm:"-"`
+	AddOns               []GigAddOn     `json:"addOns" gorm:"-"`
+	// NOTE: FILLED FROM THE USER SERVICE, NOT STORED WITH THE GIG
+	Saved      bool  `json:"saved" gorm:"-"`
+	SavesCount int64 `json:"savesCount" gorm:"-"`
 }
 
 type GigSellerDTO struct {
@@ -121,30 +130,40 @@ type GigSellerDTO struct {
 }
 
 type CreateGigDTO struct {
-	SellerID             string         `json:"sellerId"`
-	Title                string         `json:"title" form:"title" validate:"required"`
-	Description          string         `json:"description" form:"description" validate:"required"`
-	Category             string         `json:"category" form:"category" validate:"required"`
-	SubCategories        pq.StringArray `json:"subCategories" form:"subCategories" validate:"required,min=1"`
-	Tags                 pq.StringArray `json:"tags" form:"tags" validate:"required,min=1"`
-	Active               bool           `json:"active" form:"active" validate:"required"`
-	ExpectedDeliveryDays int            `json:"expectedDeliveryDays" form:"expectedDeliveryDays" validate:"required,lte=365,gt=0"`
-	Price                float64        `json:"price" form:"price" validate:"required,gt=0"`
-	ImageFile            multipart.File `json:"imageFile,omitempty" form:"imageFile"`
-	CoverImage           string         `json:"coverImage"`
+	SellerID             string          `json:"sellerId"`
+	Title                string          `json:"title" form:"title" validate:"required"`
+	Description          string          `json:"description" form:"description" validate:"required"`
+	Category             string          `json:"category" form:"category" validate:"required"`
+	SubCategories        pq.StringArray  `json:"subCategories" form:"subCategories" validate:"required,min=1"`
+	Tags                 pq.StringArray  `json:"tags" form:"tags" validate:"required,min=1"`
+	Active               bool            `json:"active" form:"active" validate:"required"`
+	ExpectedDeliveryDays int             `json:"expectedDeliveryDays" form:"expectedDeliveryDays" validate:"omitempty,lte=365,gt=0"`
+	Price                float64         `json:"price" form:"price" validate:"omitempty,gt=0"`
+	Packages             []GigPackageDTO `json:"packages" form:"-" validate:"max=3,dive"`
+	AddOns               []GigAddOnDTO   `json:"addOns" form:"-" validate:"max=10,dive"`
+	// NOTE: JSON ENCODED packages AND addOns OF A MULTIPART FORM
+	PackagesForm string         `json:"-" form:"packages"`
+	AddOnsForm   string         `json:"-" form:"addOns"`
+	ImageFile    multipart.File `json:"imageFile,omitempty" form:"imageFile"`
+	CoverImage   string         `json:"coverImage"`
 }
 
 type UpdateGigDTO struct {
-	SellerID             string         `json:"sellerId" form:"sellerId" validate:"required"`
-	Title                string         `json:"title" form:"title" validate:"required"`
-	Description          string         `json:"description" form:"description" validate:"required"`
-	Category             string         `json:"category" form:"category" validate:"required"`
-	SubCategories        pq.StringArray `json:"subCategories" form:"subCategories" validate:"required,min=1"`
-	Tags                 pq.StringArray `json:"tags" form:"tags" validate:"required,min=1"`
-	ExpectedDeliveryDays int            `json:"expectedDeliveryDays" form:"expectedDeliveryDays" validate:"required,lte=365,gt=0"`
-	Price                float64        `json:"price" form:"price" validate:"required,gt=0"`
-	ImageFile            multipart.File `json:"imageFile,omitempty" form:"imageFile"`
-	CoverImage           string         `json:"coverImage"`
+	SellerID             string          `json:"sellerId" form:"sellerId" validate:"required"`
+	Title                string          `json:"title" form:"title" validate:"required"`
+	Description          string          `json:"description" form:"description" validate:"required"`
+	Category             string          `json:"category" form:"category" validate:"required"`
+	SubCategories        pq.StringArray  `json:"subCategories" form:"subCategories" validate:"required,min=1"`
+	Tags                 pq.StringArray  `json:"tags" form:"tags" validate:"required,min=1"`
+	ExpectedDeliveryDays int             `json:"expectedDeliveryDays" form:"expectedDeliveryDays" validate:"omitempty,lte=365,gt=0"`
+	Price                float64         `json:"price" form:"price" validate:"omitempty,gt=0"`
+	Packages             []GigPackageDTO `json:"packages" form:"-" validate:"max=3,dive"`
+	AddOns               []GigAddOnDTO   `json:"addOns" form:"-" validate:"max=10,dive"`
+	// NOTE: JSON ENCODED packages AND addOns OF A MULTIPART FORM
+	PackagesForm string         `json:"-" form:"packages"`
+	AddOnsForm   string         `json:"-" form:"addOns"`
+	ImageFile    multipart.File `json:"imageFile,omitempty" form:"imageFile"`
+	CoverImage   string         `json:"coverImage"`
 }
 
 type GigSearchQueryResult struct {
@@ -163,4 +182,9 @@ type GigSearchQuery struct {
 	Query        string `json:"query" query:"query"`
 	DeliveryTime int    `json:"delivery_time" query:"delivery_time"`
 	Max          int    `json:"max" query:"max"`
+	// NOTE: COMMA SEPARATED, e.g. "LEVEL_TWO,TOP_RATED"
+	SellerLevel string `json:"seller_level" query:"seller_level"`
 }
+
+// SELLER_LEVELS are the levels computed by the user service.
+var SELLER_LEVELS = []string{"NEW", "LEVEL_ONE", "LEVEL_TWO", "TOP_RATED"}
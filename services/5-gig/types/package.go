@@ -0,0 +1,137 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+type GigPackageTier string
+
+const (
+	GIG_PACKAGE_BASIC    GigPackageTier = "BASIC"
+	GIG_PACKAGE_STANDARD GigPackageTier = "STANDARD"
+	GIG_PACKAGE_PREMIUM  GigPackageTier = "PREMIUM"
+)
+
+// GIG_PACKAGE_TIERS is the order packages are offered in, a gig with two
+// packages has BASIC and STANDARD.
+var GIG_PACKAGE_TIERS = []GigPackageTier{GIG_PACKAGE_BASIC, GIG_PACKAGE_STANDARD, GIG_PACKAGE_PREMIUM}
+
+var ErrInvalidPackages = errors.New("invalid gig packages")
+
+// GigPackage is one tier a buyer can order a gig with. Gig.Price and
+// Gig.ExpectedDeliveryDays hold the lowest price and delivery days of the
+// packages so the search filters keep working on the gigs table.
+type GigPackage struct {
+	ID          uuid.UUID      `json:"id" gorm:"primaryKey;type:uuid;default:uuid_generate_v4()"`
+	GigID       uuid.UUID      `json:"gigId" gorm:"type:uuid;not null;uniqueIndex:idx_gig_packages_gig_tier"`
+	Tier        GigPackageTier `json:"tier" gorm:"type:varchar(16);not null;uniqueIndex:idx_gig_packages_gig_tier"`
+	Name        string         `json:"name" gorm:"not null"`
+	Description string         `json:"description" gorm:"not null"`
+	// NOTE: IN WHOLE CURRENCY UNITS, THE SAME AS THE ORDER PRICE
+	Price        uint64         `json:"price" gorm:"not null"`
+	DeliveryDays uint           `json:"deliveryDays" gorm:"not null"`
+	Revisions    uint           `json:"revisions" gorm:"not null;default:0"`
+	Features     pq.StringArray `json:"features" gorm:"type:text[];not null"`
+	CreatedAt    time.Time      `json:"createdAt" gorm:"not null"`
+	UpdatedAt    time.Time      `json:"updatedAt" gorm:"not null"`
+}
+
+type GigPackageDTO struct {
+	Tier         GigPackageTier `json:"tier" validate:"required,oneof=BASIC STANDARD PREMIUM"`
+	Name         string         `json:"name" validate:"required,max=64"`
+	Description  string         `json:"description" validate:"required,max=500"`
+	Price        uint64         `json:"price" validate:"required,gt=0"`
+	DeliveryDays uint           `json:"deliveryDays" validate:"required,gt=0,lte=365"`
+	Revisions    uint           `json:"revisions" validate:"lte=100"`
+	Features     []string       `json:"features" validate:"max=10,dive,required,max=100"`
+}
+
+// ResolvePackages sorts the packages by tier and checks they start at BASIC
+// without gaps. A gig sent without packages gets a single BASIC package from
+// price and deliveryDays, the way gigs were created before packages.
+func ResolvePackages(title string, packages []GigPackageDTO, price float64, deliveryDays int) ([]GigPackageDTO, error) {
+	if len(packages) == 0 {
+		if price <= 0 || deliveryDays <= 0 {
+			return nil, fmt.Errorf("%w: packages or price and expectedDeliveryDays are required", ErrInvalidPackages)
+		}
+		if price != math.Trunc(price) {
+			return nil, fmt.Errorf("%w: price must be a whole amount", ErrInvalidPackages)
+		}
+
+		return []GigPackageDTO{{
+			Tier:         GIG_PACKAGE_BASIC,
+			Name:         "Basic",
+			Description:  title,
+			Price:        uint64(price),
+			DeliveryDays: uint(deliveryDays),
+			Features:     []string{},
+		}}, nil
+	}
+
+	packages = slices.Clone(packages)
+	slices.SortFunc(packages, func(a, b GigPackageDTO) int {
+		return slices.Index(GIG_PACKAGE_TIERS, a.Tier) - slices.Index(GIG_PACKAGE_TIERS, b.Tier)
+	})
+	for i := range packages {
+		if i >= len(GIG_PACKAGE_TIERS) || packages[i].Tier != GIG_PACKAGE_TIERS[i] {
+			return nil, fmt.Errorf("%w: tiers must be BASIC, STANDARD and PREMIUM without duplicates or gaps", ErrInvalidPackages)
+		}
+		if packages[i].Features == nil {
+			packages[i].Features = []string{}
+		}
+	}
+
+	return packages, nil
+}
+
+// MinPackagePriceAndDays returns the price and delivery days stored on the gig.
+func MinPackagePriceAndDays(packages []GigPackageDTO) (float64, int) {
+	price, days := packages[0].Price, packages[0].DeliveryDays
+	for _, p := range packages[1:] {
+		price = min(price, p.Price)
+		days = min(days, p.DeliveryDays)
+	}
+
+	return float64(price), int(days)
+}
+
+// ApplyGigPackageSetup links the packages to their gig and gives every gig
+// created before packages a BASIC package. It is safe to run on every start.
+func ApplyGigPackageSetup(db *gorm.DB) error {
+	if !db.Migrator().HasConstraint(&GigPackage{}, "fk_gig_packages_gig") {
+		result := db.Debug().Exec(`
+			ALTER TABLE gig_packages
+			ADD CONSTRAINT fk_gig_packages_gig FOREIGN KEY (gig_id) REFERENCES gigs(id) ON DELETE CASCADE ON UPDATE CASCADE;
+			`)
+		if result.Error != nil {
+			return result.Error
+		}
+	}
+
+	result := db.Debug().Exec(`
+		INSERT INTO gig_packages (id, gig_id, tier, name, description, price, delivery_days, revisions, features, created_at, updated_at)
+		SELECT uuid_generate_v4(), g.id, 'BASIC', 'Basic', g.title, GREATEST(CEIL(g.price), 1)::bigint, g.expected_delivery_days, 0, '{}', now(), now()
+		FROM gigs g
+		WHERE NOT EXISTS (SELECT 1 FROM gig_packages p WHERE p.gig_id = g.id);
+		`)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	//INFO: KEEP THE GIG PRICE EQUAL TO THE LOWEST PACKAGE PRICE
+	result = db.Debug().Exec(`
+		UPDATE gigs g
+		SET price = p.price
+		FROM (SELECT gig_id, MIN(price) AS price FROM gig_packages GROUP BY gig_id) p
+		WHERE p.gig_id = g.id AND g.price <> p.price;
+		`)
+	return result.Error
+}
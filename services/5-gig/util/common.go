@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/rand"
 	"mime/multipart"
+	"os"
 	"time"
 
 	"github.com/Akihira77/gojobber/services/3-auth/types"
@@ -59,3 +60,12 @@ func CustomValidationErrors(err error) []types.ErrorResult {
 
 	return errs
 }
+
+func GetEnvDuration(key string, defaultValue time.Duration) time.Duration {
+	v, err := time.ParseDuration(os.Getenv(key))
+	if err != nil {
+		return defaultValue
+	}
+
+	return v
+}
@@ -2,14 +2,20 @@ package handler
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 
 	"github.com/Akihira77/gojobber/services/6-chat/service"
 	"github.com/Akihira77/gojobber/services/6-chat/types"
 	"github.com/Akihira77/gojobber/services/common/genproto/chat"
+	"github.com/google/uuid"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
 )
 
 type ChatGRPCHandler struct {
@@ -34,6 +40,91 @@ func (ch *ChatGRPCHandler) BuyerAcceptedOffer(ctx context.Context, req *chat.Buy
 		return nil, fmt.Errorf("Message is not found")
 	}
 
+	//INFO: A CANCELED OR ALREADY ACCEPTED OFFER CAN NOT BE ACCEPTED AGAIN
+	if m.Offer == nil || m.Offer.Status != types.PENDING {
+		return nil, status.Error(codes.FailedPrecondition, "offer is not pending")
+	}
+
 	err = ch.chatSvc.ChangeOfferStatus(ctx, m, types.ACCEPTED)
-	return nil, err
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// FindOffer lets the order service price an order from the offer the seller
+// sent instead of what the buyer submits.
+func (ch *ChatGRPCHandler) FindOffer(ctx context.Context, req *chat.FindOfferRequest) (*chat.FindOfferResponse, error) {
+	log.Println("FindOffer receive data", req)
+
+	if _, err := uuid.Parse(req.MessageId); err != nil {
+		return nil, status.Error(codes.NotFound, "offer is not found")
+	}
+
+	m, err := ch.chatSvc.FindMessageByID(ctx, req.MessageId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "offer is not found")
+		}
+		log.Printf("FindOffer error:\n+%v", err)
+		return nil, fmt.Errorf("Error while finding offer")
+	}
+	if m.Offer == nil || m.Offer.GigTitle == "" {
+		return nil, status.Error(codes.NotFound, "offer is not found")
+	}
+
+	conv, err := ch.chatSvc.FindConversationByID(ctx, m.ConversationID)
+	if err != nil {
+		log.Printf("FindOffer error:\n+%v", err)
+		return nil, fmt.Errorf("Error while finding offer")
+	}
+
+	receiverID := conv.UserOneID
+	if receiverID == m.SenderID {
+		receiverID = conv.UserTwoID
+	}
+
+	return &chat.FindOfferResponse{
+		MessageId:            m.ID.String(),
+		SenderId:             m.SenderID,
+		ReceiverId:           receiverID,
+		GigId:                m.Offer.GigID,
+		GigTitle:             m.Offer.GigTitle,
+		Description:          m.Offer.Description,
+		Price:                uint64(m.Offer.Price),
+		ExpectedDeliveryDays: uint32(m.Offer.ExpectedDeliveryDays),
+		Status:               string(m.Offer.Status),
+	}, nil
+}
+
+func (ch *ChatGRPCHandler) FindUnreadMessages(ctx context.Context, req *chat.FindUnreadMessagesRequest) (*chat.FindUnreadMessagesResponse, error) {
+	ids, err := ch.chatSvc.FindUnreadMessageIDs(ctx, req.MessageIds)
+	if err != nil {
+		log.Printf("FindUnreadMessages error:\n+%v", err)
+		return nil, fmt.Errorf("Error while finding unread messages")
+	}
+
+	return &chat.FindUnreadMessagesResponse{
+		UnreadMessageIds: ids,
+	}, nil
+}
+
+func (ch *ChatGRPCHandler) ExportChatData(ctx context.Context, req *chat.ExportChatDataRequest) (*chat.ExportChatDataResponse, error) {
+	log.Println("ExportChatData receive data", req)
+
+	conversations, err := ch.chatSvc.FindUserConversationsWithMessages(ctx, req.UserId)
+	if err != nil {
+		log.Printf("ExportChatData error:\n+%v", err)
+		return nil, fmt.Errorf("Error while finding conversations")
+	}
+
+	data, err := json.Marshal(conversations)
+	if err != nil {
+		return nil, err
+	}
+
+	return &chat.ExportChatDataResponse{
+		Data: data,
+	}, nil
 }
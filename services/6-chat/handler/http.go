@@ -14,6 +14,7 @@ import (
 	"github.com/Akihira77/gojobber/services/6-chat/util"
 	"github.com/Akihira77/gojobber/services/common/genproto/notification"
 	"github.com/Akihira77/gojobber/services/common/genproto/user"
+	"github.com/Akihira77/gojobber/services/common/locale"
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
 	"gorm.io/gorm"
@@ -125,6 +126,14 @@ func (ch *ChatHandler) GetMessagesInsideConversation(c *fiber.Ctx) error {
 		return fiber.NewError(http.StatusInternalServerError, "Error while searching conversations data")
 	}
 
+	//INFO: OPENING A CONVERSATION READS IT, SO THOSE MESSAGES ARE LEFT OUT OF EMAIL DIGESTS
+	if userInfo, ok := c.UserContext().Value("current_user").(*types.JWTClaims); ok {
+		err = ch.cs.MarkConversationAsRead(ctx, c.Params("conversationId"), userInfo.UserID)
+		if err != nil {
+			fmt.Printf("Mark Conversation As Read Error:\n+%v", err)
+		}
+	}
+
 	return c.Status(http.StatusOK).JSON(fiber.Map{
 		"conversation": chat,
 		"total":        len(chat),
@@ -155,6 +164,19 @@ func (ch *ChatHandler) InsertMessage(c *fiber.Ctx) error {
 		return fiber.NewError(http.StatusBadRequest, "Error saving message")
 	}
 
+	//INFO: A BLOCK IN EITHER DIRECTION STOPS THE MESSAGE BEFORE ANYTHING IS SAVED OR UPLOADED
+	block, err := userGrpcClient.IsBlocked(ctx, &user.IsBlockedRequest{
+		UserId:      userInfo.UserID,
+		OtherUserId: data.ReceiverID,
+	})
+	if err != nil {
+		fmt.Printf("InsertMessage Error:\n%+v", err)
+		return fiber.NewError(http.StatusInternalServerError, "Error saving message")
+	}
+	if block.Blocked {
+		return fiber.NewError(http.StatusForbidden, "You can't send messages to this user")
+	}
+
 	if data.Offer != nil {
 		_, err = userGrpcClient.FindSeller(ctx, &user.FindSellerRequest{
 			SellerId: userInfo.UserID,
@@ -211,9 +233,14 @@ func (ch *ChatHandler) InsertMessage(c *fiber.Ctx) error {
 		return fiber.NewError(http.StatusInternalServerError, "Error saving your chat")
 	}
 
+	//NOTE: THE NOTIFICATION SERVICE COALESCES CHAT EMAILS INTO DIGESTS,
+	// SO EVERY MESSAGE IS REPORTED
 	go func() {
-		if chat.Offer == nil {
-			return
+		message := chat.Body
+		if chat.Offer != nil && chat.Offer.GigTitle != "" {
+			message = fmt.Sprintf("You receive a Gig Offer from seller: %s", userInfo.Email)
+		} else if message == "" {
+			message = "Sent you a file"
 		}
 
 		cc, err = ch.grpcClient.GetClient(types.NOTIFICATION_SERVICE)
@@ -224,9 +251,12 @@ func (ch *ChatHandler) InsertMessage(c *fiber.Ctx) error {
 
 		notificationGrpcClient := notification.NewNotificationServiceClient(cc)
 		_, err = notificationGrpcClient.SendEmailChatNotification(context.TODO(), &notification.EmailChatNotificationRequest{
-			ReceiverEmail: data.ReceiverEmail,
-			SenderEmail:   userInfo.Email,
-			Message:       fmt.Sprintf("You receive a Gig Offer from seller: %s", userInfo.Email),
+			ReceiverEmail:  data.ReceiverEmail,
+			SenderEmail:    userInfo.Email,
+			Message:        message,
+			MessageId:      chat.ID.String(),
+			ConversationId: chat.ConversationID,
+			Locale:         locale.FromCountry(receiverUser.Country),
 		})
 		if err != nil {
 			fmt.Printf("InsertMessage Error:\n+%v", err)
@@ -234,6 +264,18 @@ func (ch *ChatHandler) InsertMessage(c *fiber.Ctx) error {
 		}
 	}()
 
+	//HACK: THE AUTO-REPLY IS EXTRA, THE MESSAGE IS ALREADY SAVED SO ERRORS ARE ONLY LOGGED
+	var autoReply *types.Message
+	receiverSeller, err := userGrpcClient.FindSeller(ctx, &user.FindSellerRequest{
+		BuyerId: data.ReceiverID,
+	})
+	if err == nil && receiverSeller.OnVacation && receiverSeller.VacationStart != nil {
+		autoReply, err = ch.cs.InsertAutoReply(ctx, chat.ConversationID, data.ReceiverID, receiverSeller.VacationMessage, receiverSeller.VacationStart.AsTime())
+		if err != nil {
+			fmt.Printf("InsertMessage Error:\n+%v", err)
+		}
+	}
+
 	unreadMessages := ch.cs.CalculateUnreadMessages(ctx, chat.ConversationID, userInfo.UserID)
 
 	return c.Status(http.StatusCreated).JSON(fiber.Map{
@@ -241,6 +283,7 @@ func (ch *ChatHandler) InsertMessage(c *fiber.Ctx) error {
 		"receiver":       receiverUser,
 		"unreadMessages": unreadMessages,
 		"chat":           chat,
+		"autoReply":      autoReply,
 	})
 }
 
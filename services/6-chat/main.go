@@ -31,6 +31,16 @@ func main() {
 	// 	log.Fatal("Error applying DB entities setup")
 	// }
 
+	if !db.Migrator().HasColumn(&types.Message{}, "AutoReply") {
+		err = db.
+			Debug().
+			Migrator().
+			AddColumn(&types.Message{}, "AutoReply")
+		if err != nil {
+			log.Fatal("Error adding messages auto_reply column", err)
+		}
+	}
+
 	cld := util.NewCloudinary()
 	ccs := handler.NewGRPCClients()
 	ccs.AddClient(types.AUTH_SERVICE, os.Getenv("AUTH_GRPC_PORT"))
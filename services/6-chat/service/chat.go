@@ -19,7 +19,12 @@ type ChatServiceImpl interface {
 	InsertMessage(ctx context.Context, senderID string, data *types.CreateMessageDTO) (*types.Message, error)
 	CalculateUnreadMessages(ctx context.Context, conversationID, senderID string) int
 	FindMessageByID(ctx context.Context, id string) (*types.Message, error)
+	FindConversationByID(ctx context.Context, id string) (*types.Conversation, error)
 	ChangeOfferStatus(ctx context.Context, m *types.Message, status types.OfferStatus) error
+	MarkConversationAsRead(ctx context.Context, conversationID, readerID string) error
+	FindUnreadMessageIDs(ctx context.Context, ids []string) ([]string, error)
+	InsertAutoReply(ctx context.Context, conversationID, senderID, body string, since time.Time) (*types.Message, error)
+	FindUserConversationsWithMessages(ctx context.Context, userID string) ([]types.Conversation, error)
 }
 
 func NewChatService(db *gorm.DB) ChatServiceImpl {
@@ -50,6 +55,35 @@ func (cs *ChatService) GetMessages(ctx context.Context, conversationID string) (
 	return messages, result.Error
 }
 
+// MarkConversationAsRead marks every message the reader received in the
+// conversation as read.
+func (cs *ChatService) MarkConversationAsRead(ctx context.Context, conversationID, readerID string) error {
+	result := cs.db.
+		Debug().
+		WithContext(ctx).
+		Model(&types.Message{}).
+		Where("conversation_id = ? AND sender_id <> ? AND unread = ?", conversationID, readerID, true).
+		Update("unread", false)
+
+	return result.Error
+}
+
+func (cs *ChatService) FindUnreadMessageIDs(ctx context.Context, ids []string) ([]string, error) {
+	var unreadIDs []string
+	if len(ids) == 0 {
+		return unreadIDs, nil
+	}
+
+	result := cs.db.
+		Debug().
+		WithContext(ctx).
+		Model(&types.Message{}).
+		Where("id IN ? AND unread = ?", ids, true).
+		Pluck("id", &unreadIDs)
+
+	return unreadIDs, result.Error
+}
+
 func (cs *ChatService) FindMessageByID(ctx context.Context, id string) (*types.Message, error) {
 	var m types.Message
 	result := cs.db.
@@ -62,6 +96,18 @@ func (cs *ChatService) FindMessageByID(ctx context.Context, id string) (*types.M
 	return &m, result.Error
 }
 
+func (cs *ChatService) FindConversationByID(ctx context.Context, id string) (*types.Conversation, error) {
+	var conv types.Conversation
+	result := cs.db.
+		Debug().
+		WithContext(ctx).
+		Model(&types.Conversation{}).
+		Where("id = ?", id).
+		First(&conv)
+
+	return &conv, result.Error
+}
+
 func (cs *ChatService) GetAllMyConversations(ctx context.Context, userID string) ([]types.UserConversationDTO, error) {
 	subQuery := cs.db.
 		Model(&types.Message{}).
@@ -160,3 +206,57 @@ func (cs *ChatService) CalculateUnreadMessages(ctx context.Context, conversation
 
 	return int(count)
 }
+
+// InsertAutoReply posts body as senderID into the conversation unless an auto
+// reply was already posted there since since, so a buyer gets it once per
+// vacation. It returns nil when nothing is posted.
+func (cs *ChatService) InsertAutoReply(ctx context.Context, conversationID, senderID, body string, since time.Time) (*types.Message, error) {
+	var msg *types.Message
+	err := cs.db.
+		Debug().
+		WithContext(ctx).
+		Transaction(func(tx *gorm.DB) error {
+			var count int64
+			result := tx.
+				Model(&types.Message{}).
+				Where("conversation_id = ? AND sender_id = ? AND auto_reply = true AND created_at >= ?", conversationID, senderID, since).
+				Count(&count)
+			if result.Error != nil || count > 0 {
+				return result.Error
+			}
+
+			msg = &types.Message{
+				SenderID:       senderID,
+				Body:           body,
+				Unread:         true,
+				AutoReply:      true,
+				CreatedAt:      time.Now(),
+				ConversationID: conversationID,
+			}
+			return tx.
+				Model(&types.Message{}).
+				Create(msg).
+				Error
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// FindUserConversationsWithMessages loads every conversation of the user with
+// all of its messages, it is used by the personal data export.
+func (cs *ChatService) FindUserConversationsWithMessages(ctx context.Context, userID string) ([]types.Conversation, error) {
+	var conversations []types.Conversation
+	result := cs.db.
+		WithContext(ctx).
+		Model(&types.Conversation{}).
+		Preload("Messages", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at")
+		}).
+		Where("user_one_id = ? OR user_two_id = ?", userID, userID).
+		Find(&conversations)
+
+	return conversations, result.Error
+}
@@ -29,6 +29,8 @@ func (p OfferStatus) Value() (driver.Value, error) {
 }
 
 type Offer struct {
+	// NOTE: THE ORDER SERVICE ONLY ACCEPTS A PAYMENT FOR THE GIG THE OFFER IS FOR
+	GigID                string      `json:"gigId" form:"gigId" validate:"required,uuid"`
 	GigTitle             string      `json:"gigTitle" form:"gigTitle" validate:"required"`
 	Price                uint        `json:"price" form:"price" validate:"required,gt=0"`
 	ExpectedDeliveryDays uint        `json:"expectedDeliveryDays" form:"expectedDeliveryDays" validate:"required,gt=0,lte=365"`
@@ -65,6 +67,8 @@ type Message struct {
 	CreatedAt      time.Time `json:"createdAt" gorm:"not null;"`
 	ConversationID string    `json:"conversationId" gorm:"not null;"`
 	SenderID       string    `json:"senderId"`
+	// NOTE: POSTED ON BEHALF OF A SELLER ON VACATION
+	AutoReply bool `json:"autoReply" gorm:"not null;default:false;"`
 }
 
 type MessageDTO struct {
@@ -75,6 +79,7 @@ type MessageDTO struct {
 	Unread    bool      `json:"unread"`
 	CreatedAt time.Time `json:"createdAt"`
 	SenderID  string    `json:"senderId"`
+	AutoReply bool      `json:"autoReply"`
 }
 
 type CreateMessageDTO struct {
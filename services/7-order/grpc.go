@@ -4,6 +4,8 @@ import (
 	"log"
 	"net"
 
+	"github.com/Akihira77/gojobber/services/7-order/handler"
+	"github.com/Akihira77/gojobber/services/7-order/service"
 	"google.golang.org/grpc"
 	"gorm.io/gorm"
 )
@@ -26,6 +28,9 @@ func (s *gRPCServer) Run(db *gorm.DB) error {
 
 	grpcServer := grpc.NewServer()
 
+	orderSvc := service.NewOrderService(db)
+	handler.NewOrderGRPCHandler(grpcServer, orderSvc)
+
 	log.Println("starting grpc server on", s.addr)
 
 	return grpcServer.Serve(lis)
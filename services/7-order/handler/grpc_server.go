@@ -0,0 +1,65 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/Akihira77/gojobber/services/7-order/service"
+	"github.com/Akihira77/gojobber/services/common/genproto/order"
+	"google.golang.org/grpc"
+)
+
+type OrderGrpcHandler struct {
+	orderSvc service.OrderServiceImpl
+	order.UnimplementedOrderServiceServer
+}
+
+func NewOrderGRPCHandler(grpc *grpc.Server, orderSvc service.OrderServiceImpl) {
+	gRPCHandler := &OrderGrpcHandler{
+		orderSvc: orderSvc,
+	}
+
+	order.RegisterOrderServiceServer(grpc, gRPCHandler)
+}
+
+func (h *OrderGrpcHandler) FindSellerOrderStats(ctx context.Context, req *order.FindSellerOrderStatsRequest) (*order.FindSellerOrderStatsResponse, error) {
+	log.Println("FindSellerOrderStats receive data", req)
+
+	stats, err := h.orderSvc.FindSellerOrderStats(ctx, req.SellerIds)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &order.FindSellerOrderStatsResponse{
+		Stats: make(map[string]*order.SellerOrderStats, len(stats)),
+	}
+	for _, s := range stats {
+		res.Stats[s.SellerID] = &order.SellerOrderStats{
+			FinishedOrders:  s.FinishedOrders,
+			CompletedOrders: s.CompletedOrders,
+			OnTimeOrders:    s.OnTimeOrders,
+			CanceledOrders:  s.CanceledOrders,
+		}
+	}
+
+	return res, nil
+}
+
+func (h *OrderGrpcHandler) ExportOrderData(ctx context.Context, req *order.ExportOrderDataRequest) (*order.ExportOrderDataResponse, error) {
+	log.Println("ExportOrderData receive data", req)
+
+	orders, err := h.orderSvc.FindUserOrdersForExport(ctx, req.UserId, req.SellerId)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(orders)
+	if err != nil {
+		return nil, err
+	}
+
+	return &order.ExportOrderDataResponse{
+		Data: data,
+	}, nil
+}
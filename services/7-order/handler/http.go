@@ -15,8 +15,10 @@ import (
 	"github.com/Akihira77/gojobber/services/7-order/types"
 	"github.com/Akihira77/gojobber/services/7-order/util"
 	"github.com/Akihira77/gojobber/services/common/genproto/chat"
+	"github.com/Akihira77/gojobber/services/common/genproto/gig"
 	"github.com/Akihira77/gojobber/services/common/genproto/notification"
 	"github.com/Akihira77/gojobber/services/common/genproto/user"
+	"github.com/Akihira77/gojobber/services/common/locale"
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
 	"github.com/stripe/stripe-go/v80"
@@ -24,6 +26,9 @@ import (
 	"github.com/stripe/stripe-go/v80/paymentintent"
 	"github.com/stripe/stripe-go/v80/refund"
 	"github.com/stripe/stripe-go/v80/webhook"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/gorm"
 )
@@ -155,6 +160,39 @@ func (oh *OrderHttpHandler) CreatePaymentIntent(c *fiber.Ctx) error {
 		})
 	}
 
+	if len(data.AddOnIDs) > 0 && data.PackageID == "" {
+		return fiber.NewError(http.StatusBadRequest, "Add-ons can only be ordered with a gig package")
+	}
+
+	if data.PackageID != "" {
+		cc, err := oh.grpcClient.GetClient(types.GIG_SERVICE)
+		if err != nil {
+			log.Printf("CreatePaymentIntent error:\n+%v", err)
+			return fiber.NewError(http.StatusInternalServerError, "Error while validating gig")
+		}
+
+		pkg, err := gig.NewGigServiceClient(cc).FindGigPackage(ctx, &gig.FindGigPackageRequest{
+			GigId:     data.GigID,
+			PackageId: data.PackageID,
+			AddOnIds:  data.AddOnIDs,
+		})
+		if err != nil {
+			log.Printf("CreatePaymentIntent error:\n+%v", err)
+			if status.Code(err) == codes.NotFound {
+				return fiber.NewError(http.StatusNotFound, "Gig package or add-on is not found")
+			}
+			return fiber.NewError(http.StatusInternalServerError, "Error while validating gig")
+		}
+		if pkg.SellerId != data.SellerID {
+			return fiber.NewError(http.StatusBadRequest, "Gig package does not belong to this seller")
+		}
+		if !pkg.Active {
+			return fiber.NewError(http.StatusBadRequest, "Gig is not active")
+		}
+
+		applyPackage(data, pkg)
+	}
+
 	cc, err := oh.grpcClient.GetClient(types.USER_SERVICE)
 	if err != nil {
 		log.Printf("CreatePaymentIntent error:\n+%v", err)
@@ -171,6 +209,28 @@ func (oh *OrderHttpHandler) CreatePaymentIntent(c *fiber.Ctx) error {
 		return fiber.NewError(http.StatusInternalServerError, "Error while finding seller related to this gig")
 	}
 
+	block, err := userGrpcClient.IsBlocked(ctx, &user.IsBlockedRequest{
+		UserId:        userInfo.UserID,
+		OtherSellerId: data.SellerID,
+	})
+	if err != nil {
+		log.Printf("CreatePaymentIntent error:\n+%v", err)
+		return fiber.NewError(http.StatusInternalServerError, "Error while finding seller related to this gig")
+	}
+	if block.Blocked {
+		return fiber.NewError(http.StatusForbidden, "You can't order from this seller")
+	}
+
+	if s.OnVacation {
+		return fiber.NewError(http.StatusBadRequest, fmt.Sprintf("Seller is on vacation until %s", s.VacationEnd.AsTime().Format(time.DateOnly)))
+	}
+
+	if data.MessageID != "" {
+		if err := oh.applyOffer(ctx, userGrpcClient, userInfo.UserID, data); err != nil {
+			return err
+		}
+	}
+
 	pi, err := paymentintent.New(&stripe.PaymentIntentParams{
 		Amount:   stripe.Int64(int64(data.Price * 100)),
 		Currency: stripe.String(string(stripe.CurrencyUSD)),
@@ -180,9 +240,10 @@ func (oh *OrderHttpHandler) CreatePaymentIntent(c *fiber.Ctx) error {
 		ReceiptEmail: &userInfo.Email,
 		OnBehalfOf:   &s.StripeAccountId,
 		Metadata: map[string]string{
-			"buyer_id":     userInfo.UserID,
-			"seller_id":    data.SellerID,
-			"seller_email": s.Email,
+			"buyer_id":      userInfo.UserID,
+			"seller_id":     data.SellerID,
+			"seller_email":  s.Email,
+			"seller_locale": locale.FromCountry(s.Country),
 		},
 	})
 	if err != nil {
@@ -231,6 +292,83 @@ func (oh *OrderHttpHandler) CreatePaymentIntent(c *fiber.Ctx) error {
 	})
 }
 
+// applyOffer prices a custom offer order from the offer the seller sent in
+// chat, the offer is accepted once the order is saved.
+func (oh *OrderHttpHandler) applyOffer(ctx context.Context, userGrpcClient user.UserServiceClient, buyerID string, data *types.CreateOrderDTO) error {
+	cc, err := oh.grpcClient.GetClient(types.CHAT_SERVICE)
+	if err != nil {
+		log.Printf("CreatePaymentIntent error:\n+%v", err)
+		return fiber.NewError(http.StatusInternalServerError, "Error while validating offer")
+	}
+
+	offer, err := chat.NewChatServiceClient(cc).FindOffer(ctx, &chat.FindOfferRequest{
+		MessageId: data.MessageID,
+	})
+	if err != nil {
+		log.Printf("CreatePaymentIntent error:\n+%v", err)
+		if status.Code(err) == codes.NotFound {
+			return fiber.NewError(http.StatusNotFound, "Offer is not found")
+		}
+		return fiber.NewError(http.StatusInternalServerError, "Error while validating offer")
+	}
+
+	if offer.ReceiverId != buyerID {
+		return fiber.NewError(http.StatusForbidden, "Offer was not sent to you")
+	}
+	if offer.GigId != data.GigID {
+		return fiber.NewError(http.StatusBadRequest, "Offer is not for this gig")
+	}
+	if offer.Status != types.OFFER_PENDING {
+		return fiber.NewError(http.StatusBadRequest, "Offer is no longer available")
+	}
+
+	//INFO: THE SENDER IS A USER ID, IT MUST BE THE ACCOUNT OF THE SELLER BEING PAID
+	sender, err := userGrpcClient.FindSeller(ctx, &user.FindSellerRequest{
+		BuyerId:  offer.SenderId,
+		SellerId: "",
+	})
+	if err != nil {
+		log.Printf("CreatePaymentIntent error:\n+%v", err)
+		return fiber.NewError(http.StatusInternalServerError, "Error while validating offer")
+	}
+	if sender.Id != data.SellerID {
+		return fiber.NewError(http.StatusBadRequest, "Offer was not sent by this seller")
+	}
+
+	data.GigTitle = offer.GigTitle
+	data.GigDescription = offer.Description
+	data.Price = offer.Price
+	data.Deadline = int(offer.ExpectedDeliveryDays)
+
+	return nil
+}
+
+// applyPackage prices an order from the gig package and the add-ons the gig
+// service returned.
+func applyPackage(data *types.CreateOrderDTO, pkg *gig.FindGigPackageResponse) {
+	//INFO: THE PACKAGE AND ITS ADD-ONS DECIDE THE PRICE AND DEADLINE, NOT THE CLIENT
+	price, deadline := pkg.Price, int(pkg.DeliveryDays)
+	data.AddOns = make([]types.OrderAddOn, len(pkg.AddOns))
+	for i, a := range pkg.AddOns {
+		price += a.Price
+		deadline += int(a.DeliveryDaysDelta)
+		data.AddOns[i] = types.OrderAddOn{
+			ID:                a.Id,
+			Title:             a.Title,
+			Price:             a.Price,
+			DeliveryDaysDelta: int(a.DeliveryDaysDelta),
+		}
+	}
+
+	data.GigTitle = pkg.GigTitle
+	data.Price = price
+	//HACK: EXPRESS DELIVERY CAN NOT BRING THE DEADLINE UNDER ONE DAY
+	data.Deadline = max(deadline, 1)
+	data.PackageTier = pkg.Tier
+	data.PackageName = pkg.Name
+	data.Revisions = uint(pkg.Revisions)
+}
+
 func (oh *OrderHttpHandler) ConfirmPayment(c *fiber.Ctx) error {
 	ctx, cancel := context.WithTimeout(c.UserContext(), 1*time.Second)
 	defer cancel()
@@ -306,13 +444,15 @@ func (oh *OrderHttpHandler) HandleStripeWebhook(c *fiber.Ctx) error {
 				return
 			}
 
-			_, err = oh.orderSvc.ChangeOrderStatus(ctx, *o, types.PENDING, fmt.Sprintf("Buyer Has Paid This Order. Order Status Change To [%s]", types.PENDING))
+			o, err = oh.orderSvc.ChangeOrderStatus(ctx, *o, types.PENDING, fmt.Sprintf("Buyer Has Paid This Order. Order Status Change To [%s]", types.PENDING))
 			if err != nil {
 				log.Printf("Changing order status error:\n+%v", err)
 				return
 			}
 
 			sellerEmail := pi.Metadata["seller_email"]
+			oh.publishWebhookEvent(sellerEmail, "order.created", orderWebhookData(o))
+
 			cc, err := oh.grpcClient.GetClient(types.NOTIFICATION_SERVICE)
 			if err != nil {
 				log.Printf("HandleStripeWebhook Error:\n+%v", err)
@@ -323,6 +463,7 @@ func (oh *OrderHttpHandler) HandleStripeWebhook(c *fiber.Ctx) error {
 			_, err = notificationGrpcClient.NotifySellerOrderHasBeenMade(context.TODO(), &notification.NotifySellerGotAnOrderRequest{
 				ReceiverEmail: sellerEmail,
 				Message:       fmt.Sprintf("You Receive An Order From Buyer [%s]", o.BuyerID),
+				Locale:        pi.Metadata["seller_locale"],
 				Detail: &notification.OrderDetail{
 					GigTitle:       o.GigTitle,
 					GigDescription: o.GigDescription,
@@ -371,8 +512,10 @@ func (oh *OrderHttpHandler) BuyerMarkOrderAsComplete(c *fiber.Ctx) error {
 
 	userGrpcClient := user.NewUserServiceClient(cc)
 	s, err := userGrpcClient.UpdateSellerBalance(ctx, &user.UpdateSellerBalanceRequest{
-		SellerId: o.SellerID,
-		Amount:   o.Price,
+		SellerId:    o.SellerID,
+		Amount:      o.Price,
+		OrderId:     o.ID,
+		PlatformFee: uint64(o.ServiceFee),
 	})
 	if err != nil {
 		log.Printf("OrderComplete error:\n+%v", err)
@@ -395,6 +538,7 @@ func (oh *OrderHttpHandler) BuyerMarkOrderAsComplete(c *fiber.Ctx) error {
 			OrderId:              o.ID,
 			SellerCurrentBalance: strconv.FormatUint(s.AccountBalance, 10),
 			Url:                  fmt.Sprintf("%s/orders/%s", os.Getenv("CLIENT_URL"), o.ID),
+			Locale:               locale.FromCountry(s.Country),
 		})
 		if err != nil {
 			log.Printf("OrderComplete error:\n+%v", err)
@@ -486,6 +630,8 @@ func (oh *OrderHttpHandler) SellerCancellingOrder(c *fiber.Ctx) error {
 		return fiber.NewError(http.StatusInternalServerError, "Error while updating Order Status")
 	}
 
+	go oh.publishWebhookEvent(s.Email, "order.canceled", orderWebhookData(o))
+
 	//HACK: SEND EMAIL TO BUYER THAT THE SELLER HAS CANCEL THE ORDER
 	//IGNORE THE ERROR FROM CODE FLOW
 	go func() {
@@ -510,6 +656,7 @@ func (oh *OrderHttpHandler) SellerCancellingOrder(c *fiber.Ctx) error {
 		notificationGrpcClient := notification.NewNotificationServiceClient(cc)
 		_, err = notificationGrpcClient.SellerCanceledAnOrder(context.TODO(), &notification.SellerCancelOrderRequest{
 			ReceiverEmail: b.Email,
+			Locale:        locale.FromCountry(b.Country),
 			Url:           fmt.Sprintf("%s/orders/%s", os.Getenv("CLIENT_URL"), o.ID),
 		})
 		if err != nil {
@@ -591,6 +738,7 @@ func (oh *OrderHttpHandler) RequestDeadlineExtension(c *fiber.Ctx) error {
 		notificationGrpcClient := notification.NewNotificationServiceClient(cc)
 		_, err = notificationGrpcClient.SellerRequestDeadlineExtension(context.TODO(), &notification.SellerDeadlineExtensionRequest{
 			ReceiverEmail: b.Email,
+			Locale:        locale.FromCountry(b.Country),
 			Url:           fmt.Sprintf("%s/orders/%s", os.Getenv("CLIENT_URL"), o.ID),
 		})
 		if err != nil {
@@ -665,6 +813,7 @@ func (oh *OrderHttpHandler) BuyerDeadlineExtensionResponse(c *fiber.Ctx) error {
 		notificationGrpcClient := notification.NewNotificationServiceClient(cc)
 		_, err = notificationGrpcClient.BuyerDeadlineExtensionResponse(context.TODO(), &notification.BuyerDeadlineExtension{
 			ReceiverEmail: b.Email,
+			Locale:        locale.FromCountry(b.Country),
 			Url:           fmt.Sprintf("%s/orders/%s", os.Getenv("CLIENT_URL"), o.ID),
 		})
 		if err != nil {
@@ -757,6 +906,7 @@ func (oh *OrderHttpHandler) BuyerRefundingOrder(c *fiber.Ctx) error {
 			notificationGrpcClient := notification.NewNotificationServiceClient(cc)
 			_, err = notificationGrpcClient.BuyerRefundsAnOrder(context.TODO(), &notification.BuyerRefundsOrderRequest{
 				ReceiverEmail: b.Email,
+				Locale:        locale.FromCountry(b.Country),
 				Url:           fmt.Sprintf("%s/orders/%s", os.Getenv("CLIENT_URL"), o.ID),
 			})
 			if err != nil {
@@ -838,6 +988,7 @@ func (oh *OrderHttpHandler) SellerDeliverOrder(c *fiber.Ctx) error {
 		notificationGrpcClient := notification.NewNotificationServiceClient(cc)
 		_, err = notificationGrpcClient.NotifyBuyerSellerDeliveredOrder(context.TODO(), &notification.NotifyBuyerOrderDeliveredRequest{
 			ReceiverEmail: b.Email,
+			Locale:        locale.FromCountry(b.Country),
 			Url:           fmt.Sprintf("%s/orders/%s", os.Getenv("CLIENT_URL"), o.ID),
 		})
 		if err != nil {
@@ -896,6 +1047,7 @@ func (oh *OrderHttpHandler) SellerAcknowledgeOrder(c *fiber.Ctx) error {
 		notificationGrpcClient := notification.NewNotificationServiceClient(cc)
 		_, err = notificationGrpcClient.NotifyBuyerOrderHasAcknowledged(context.TODO(), &notification.NotifyBuyerOrderAcknowledgeRequest{
 			ReceiverEmail: b.Email,
+			Locale:        locale.FromCountry(b.Country),
 			Url:           fmt.Sprintf("%s/orders/%s", os.Getenv("CLIENT_URL"), o.ID),
 		})
 		if err != nil {
@@ -944,7 +1096,7 @@ func (oh *OrderHttpHandler) BuyerResponseForDeliveredOrder(c *fiber.Ctx) error {
 		newCtx, canc := context.WithTimeout(context.Background(), 200*time.Millisecond)
 		defer canc()
 
-		cc, err := oh.grpcClient.GetClient(types.NOTIFICATION_SERVICE)
+		cc, err := oh.grpcClient.GetClient(types.USER_SERVICE)
 		if err != nil {
 			log.Printf("BuyerResponseForDeliveredOrder Error:\n+%v", err)
 			return
@@ -959,6 +1111,11 @@ func (oh *OrderHttpHandler) BuyerResponseForDeliveredOrder(c *fiber.Ctx) error {
 			return
 		}
 
+		deliveryData := orderWebhookData(o)
+		deliveryData["deliveryId"] = data.ID.String()
+		deliveryData["buyerNote"] = data.BuyerNote
+		oh.publishWebhookEvent(s.Email, "order.delivery_responded", deliveryData)
+
 		cc, err = oh.grpcClient.GetClient(types.NOTIFICATION_SERVICE)
 		if err != nil {
 			log.Printf("BuyerResponseForDeliveredOrder Error:\n+%v", err)
@@ -966,8 +1123,23 @@ func (oh *OrderHttpHandler) BuyerResponseForDeliveredOrder(c *fiber.Ctx) error {
 		}
 
 		notificationGrpcClient := notification.NewNotificationServiceClient(cc)
-		_, err = notificationGrpcClient.NotifySellerBuyerResponseDeliveredOrder(context.TODO(), &notification.NotifySellerBuyerResponseDeliveredOrderRequest{
-			ReceiverEmail: s.Email,
+		payload, err := structpb.NewStruct(map[string]interface{}{
+			"OrderURL": fmt.Sprintf("%s/orders/%s", os.Getenv("CLIENT_URL"), o.ID),
+		})
+		if err != nil {
+			log.Printf("BuyerResponseForDeliveredOrder Error:\n+%v", err)
+			return
+		}
+
+		_, err = notificationGrpcClient.Publish(context.TODO(), &notification.NotificationEvent{
+			Type: notification.NotificationEventType_SELLER_BUYER_RESPONDED_DELIVERY,
+			Recipients: []*notification.NotificationRecipient{
+				{
+					Email:  s.Email,
+					Locale: locale.FromCountry(s.Country),
+				},
+			},
+			Payload: payload,
 		})
 		if err != nil {
 			log.Printf("BuyerResponseForDeliveredOrder Error:\n+%v", err)
@@ -1042,3 +1214,46 @@ func (oh *OrderHttpHandler) StripeTOSAcceptance(c *fiber.Ctx) error {
 		"result": result,
 	})
 }
+
+// INFO: WEBHOOK PAYLOADS ONLY CARRY WHAT THE SELLER CAN ALREADY SEE IN THE ORDER PAGE
+func orderWebhookData(o *types.Order) map[string]interface{} {
+	return map[string]interface{}{
+		"orderId":    o.ID,
+		"sellerId":   o.SellerID,
+		"buyerId":    o.BuyerID,
+		"gigTitle":   o.GigTitle,
+		"status":     string(o.Status),
+		"price":      o.Price,
+		"serviceFee": o.ServiceFee,
+		"deadline":   o.Deadline.Format(time.RFC3339),
+	}
+}
+
+// publishWebhookEvent forwards an order event to the seller's webhook
+// endpoints, failures are only logged so they never break the order flow.
+func (oh *OrderHttpHandler) publishWebhookEvent(sellerEmail, event string, data map[string]interface{}) {
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+
+	cc, err := oh.grpcClient.GetClient(types.NOTIFICATION_SERVICE)
+	if err != nil {
+		log.Printf("publishWebhookEvent error:\n+%v", err)
+		return
+	}
+
+	payload, err := structpb.NewStruct(data)
+	if err != nil {
+		log.Printf("publishWebhookEvent error:\n+%v", err)
+		return
+	}
+
+	notificationGrpcClient := notification.NewNotificationServiceClient(cc)
+	_, err = notificationGrpcClient.PublishWebhookEvent(ctx, &notification.WebhookEvent{
+		OwnerEmail: sellerEmail,
+		Event:      event,
+		Data:       payload,
+	})
+	if err != nil {
+		log.Printf("publishWebhookEvent error:\n+%v", err)
+	}
+}
@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"testing"
+
+	"github.com/Akihira77/gojobber/services/7-order/types"
+	"github.com/Akihira77/gojobber/services/common/genproto/gig"
+)
+
+func TestApplyPackage(t *testing.T) {
+	data := &types.CreateOrderDTO{
+		GigTitle: "from the client",
+		Price:    1,
+		Deadline: 1,
+	}
+	applyPackage(data, &gig.FindGigPackageResponse{
+		GigTitle:     "I will design your logo",
+		Tier:         "STANDARD",
+		Name:         "Standard",
+		Price:        100,
+		DeliveryDays: 5,
+		Revisions:    2,
+	})
+
+	if data.GigTitle != "I will design your logo" || data.Price != 100 || data.Deadline != 5 {
+		t.Errorf("order = %q %d %d days, want the package title, price and delivery days", data.GigTitle, data.Price, data.Deadline)
+	}
+	if data.PackageTier != "STANDARD" || data.PackageName != "Standard" || data.Revisions != 2 {
+		t.Errorf("package = %s %q %d revisions, want STANDARD Standard 2 revisions", data.PackageTier, data.PackageName, data.Revisions)
+	}
+	if len(data.AddOns) != 0 {
+		t.Errorf("add-ons = %d, want none", len(data.AddOns))
+	}
+}
+
+func TestApplyPackageAddsTheAddOns(t *testing.T) {
+	tests := []struct {
+		name         string
+		addOns       []*gig.GigAddOn
+		wantPrice    uint64
+		wantDeadline int
+	}{
+		{
+			name: "extras",
+			addOns: []*gig.GigAddOn{
+				{Id: "a1", Title: "Source file", Price: 20, DeliveryDaysDelta: 1},
+				{Id: "a2", Title: "Express delivery", Price: 30, DeliveryDaysDelta: -2},
+			},
+			wantPrice:    150,
+			wantDeadline: 4,
+		},
+		{
+			name: "express below one day",
+			addOns: []*gig.GigAddOn{
+				{Id: "a3", Title: "Same day", Price: 80, DeliveryDaysDelta: -9},
+			},
+			wantPrice:    180,
+			wantDeadline: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		data := &types.CreateOrderDTO{}
+		applyPackage(data, &gig.FindGigPackageResponse{
+			Price:        100,
+			DeliveryDays: 5,
+			AddOns:       tt.addOns,
+		})
+
+		if data.Price != tt.wantPrice || data.Deadline != tt.wantDeadline {
+			t.Errorf("%s: order = %d %d days, want %d %d days", tt.name, data.Price, data.Deadline, tt.wantPrice, tt.wantDeadline)
+		}
+		if len(data.AddOns) != len(tt.addOns) {
+			t.Fatalf("%s: add-ons = %d, want %d", tt.name, len(data.AddOns), len(tt.addOns))
+		}
+		for i, a := range tt.addOns {
+			got := data.AddOns[i]
+			if got.ID != a.Id || got.Title != a.Title || got.Price != a.Price || got.DeliveryDaysDelta != int(a.DeliveryDaysDelta) {
+				t.Errorf("%s: add-on %d = %+v, want a snapshot of %s", tt.name, i, got, a.Id)
+			}
+		}
+	}
+}
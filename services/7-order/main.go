@@ -33,6 +33,18 @@ func main() {
 	// 	log.Fatalf("Error applying DB setup %v", err)
 	// }
 
+	for _, column := range []string{"GigID", "PackageID", "PackageTier", "PackageName", "Revisions", "AddOns"} {
+		if !db.Migrator().HasColumn(&types.Order{}, column) {
+			err = db.
+				Debug().
+				Migrator().
+				AddColumn(&types.Order{}, column)
+			if err != nil {
+				log.Fatalf("Error adding orders %s column: %v", column, err)
+			}
+		}
+	}
+
 	stripe.Key = os.Getenv("STRIPE_SECRET_KEY")
 	cld := util.NewCloudinary()
 
@@ -40,6 +52,7 @@ func main() {
 	ccs.AddClient(types.USER_SERVICE, os.Getenv("USER_GRPC_PORT"))
 	ccs.AddClient(types.NOTIFICATION_SERVICE, os.Getenv("NOTIFICATION_GRPC_PORT"))
 	ccs.AddClient(types.CHAT_SERVICE, os.Getenv("CHAT_GRPC_PORT"))
+	ccs.AddClient(types.GIG_SERVICE, os.Getenv("GIG_GRPC_PORT"))
 
 	go NewHttpServer(db, cld, ccs)
 
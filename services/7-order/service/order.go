@@ -5,7 +5,6 @@ import (
 	"database/sql"
 	"fmt"
 	"log"
-	"math"
 	"time"
 
 	"github.com/Akihira77/gojobber/services/7-order/types"
@@ -31,6 +30,8 @@ type OrderServiceImpl interface {
 	OrderDeliveredResponse(ctx context.Context, o types.Order, r *types.BuyerResponseOrderDelivered) (*types.Order, error)
 	FindMyOrderNotifications(ctx context.Context, userID string) ([]types.OrderNotificationDTO, error)
 	MarkReadsMyOrderNotifications(ctx context.Context, userID string) error
+	FindSellerOrderStats(ctx context.Context, sellerIDs []string) ([]types.SellerOrderStats, error)
+	FindUserOrdersForExport(ctx context.Context, buyerID, sellerID string) ([]types.OrderExport, error)
 }
 
 func NewOrderService(db *gorm.DB) OrderServiceImpl {
@@ -87,12 +88,21 @@ func (os *OrderService) SaveOrder(ctx context.Context, data *types.CreateOrderDT
 		GigDescription:     data.GigDescription,
 		Price:              data.Price,
 		Status:             types.AWAITING_PAYMENT,
-		ServiceFee:         uint(math.Ceil((25 / 1000) * float64(data.Price))),
+		ServiceFee:         types.ServiceFee(data.Price),
 		PaymentIntentID:    data.PaymentIntentID,
 		StripeClientSecret: data.StripeClientSecret,
 		StartDate:          startDate,
 		Deadline:           startDate.AddDate(0, 0, data.Deadline),
 		InvoiceID:          fmt.Sprintf("JI%s", util.RandomStr(30)),
+		GigID:              data.GigID,
+		PackageID:          data.PackageID,
+		PackageTier:        data.PackageTier,
+		PackageName:        data.PackageName,
+		Revisions:          data.Revisions,
+		AddOns:             data.AddOns,
+	}
+	if newOrder.AddOns == nil {
+		newOrder.AddOns = []types.OrderAddOn{}
 	}
 
 	result := tx.
@@ -385,3 +395,84 @@ func (os *OrderService) FindOrdersBySellerID(ctx context.Context, id string) ([]
 
 	return o, result.Error
 }
+
+// FindSellerOrderStats counts the finished orders of every seller, a completed
+// order is on time when its first delivery is not later than the deadline.
+func (os *OrderService) FindSellerOrderStats(ctx context.Context, sellerIDs []string) ([]types.SellerOrderStats, error) {
+	var stats []types.SellerOrderStats
+	if len(sellerIDs) == 0 {
+		return stats, nil
+	}
+
+	result := os.db.
+		Debug().
+		WithContext(ctx).
+		Raw(`
+			SELECT
+				orders.seller_id,
+				COUNT(*) AS finished_orders,
+				COUNT(*) FILTER (WHERE orders.status = ?) AS completed_orders,
+				COUNT(*) FILTER (WHERE orders.status = ? AND deliveries.first_delivered_date <= orders.deadline) AS on_time_orders,
+				COUNT(*) FILTER (WHERE orders.status = ?) AS canceled_orders
+			FROM orders
+			LEFT JOIN (
+				SELECT order_id, MIN(delivered_date) AS first_delivered_date
+				FROM delivered_histories
+				GROUP BY order_id
+			) deliveries ON deliveries.order_id = orders.id
+			WHERE orders.seller_id IN ? AND orders.status IN ?
+			GROUP BY orders.seller_id
+		`,
+			types.COMPLETED,
+			types.COMPLETED,
+			types.CANCELED,
+			sellerIDs,
+			[]types.OrderStatus{types.COMPLETED, types.CANCELED, types.REFUNDED}).
+		Scan(&stats)
+
+	return stats, result.Error
+}
+
+// FindUserOrdersForExport returns the orders the user bought and, when they
+// are a seller, sold, with their events and deliveries.
+func (os *OrderService) FindUserOrdersForExport(ctx context.Context, buyerID, sellerID string) ([]types.OrderExport, error) {
+	var orders []types.Order
+	result := os.db.
+		WithContext(ctx).
+		Model(&types.Order{}).
+		Preload("OrderEvents", func(db *gorm.DB) *gorm.DB {
+			return db.Order("created_at")
+		}).
+		Preload("DeliveredHistories", func(db *gorm.DB) *gorm.DB {
+			return db.Order("delivered_date")
+		}).
+		Where("buyer_id = ? OR (seller_id = ? AND seller_id <> '')", buyerID, sellerID).
+		Order("start_date").
+		Find(&orders)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	exports := make([]types.OrderExport, len(orders))
+	for i, o := range orders {
+		exports[i] = types.OrderExport{
+			ID:                 o.ID,
+			SellerID:           o.SellerID,
+			BuyerID:            o.BuyerID,
+			GigTitle:           o.GigTitle,
+			GigDescription:     o.GigDescription,
+			Status:             o.Status,
+			Price:              o.Price,
+			ServiceFee:         o.ServiceFee,
+			PackageName:        o.PackageName,
+			AddOns:             o.AddOns,
+			InvoiceID:          o.InvoiceID,
+			StartDate:          o.StartDate,
+			Deadline:           o.Deadline,
+			OrderEvents:        o.OrderEvents,
+			DeliveredHistories: o.DeliveredHistories,
+		}
+	}
+
+	return exports, nil
+}
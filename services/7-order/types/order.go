@@ -78,6 +78,13 @@ type Order struct {
 	InvoiceID          string             `json:"invoiceId,omitempty"`
 	StartDate          time.Time          `json:"startDate" gorm:"not null;"`
 	Deadline           time.Time          `json:"deadline" gorm:"not null;"`
+	// NOTE: A SNAPSHOT OF THE ORDERED GIG PACKAGE, EMPTY FOR CUSTOM OFFERS
+	GigID       string       `json:"gigId,omitempty" gorm:"not null;default:'';"`
+	PackageID   string       `json:"packageId,omitempty" gorm:"not null;default:'';"`
+	PackageTier string       `json:"packageTier,omitempty" gorm:"not null;default:'';"`
+	PackageName string       `json:"packageName,omitempty" gorm:"not null;default:'';"`
+	Revisions   uint         `json:"revisions" gorm:"not null;default:0;"`
+	AddOns      []OrderAddOn `json:"addOns" gorm:"type:jsonb;not null;default:'[]';serializer:json;"`
 	// Unread             bool               `json:"unread" gorm:"default:true;not null;"`
 }
 
@@ -90,17 +97,80 @@ type OrderNotificationDTO struct {
 	Deadline  time.Time   `json:"deadline"`
 }
 
+// SellerOrderStats summarizes the finished orders of a seller, they feed the
+// seller level computed by the user service.
+type SellerOrderStats struct {
+	SellerID        string `json:"sellerId"`
+	FinishedOrders  int64  `json:"finishedOrders"`
+	CompletedOrders int64  `json:"completedOrders"`
+	OnTimeOrders    int64  `json:"onTimeOrders"`
+	CanceledOrders  int64  `json:"canceledOrders"`
+}
+
+// OFFER_PENDING is the status of a chat offer that has not been paid for yet.
+const OFFER_PENDING = "PENDING"
+
+// SERVICE_FEE_PER_MILLE is the platform fee taken from the order price, 2.5%.
+// It is posted to the ledger as platform revenue and the seller is credited
+// the rest.
+const SERVICE_FEE_PER_MILLE = 25
+
+// ServiceFee rounds the fee up to a whole currency unit. It is computed on
+// integers, a float rate would round some prices up by a whole unit.
+func ServiceFee(price uint64) uint {
+	return uint((price*SERVICE_FEE_PER_MILLE + 999) / 1000)
+}
+
+// OrderAddOn is a gig add-on as it was when the order was placed, its price is
+// already part of Order.Price.
+type OrderAddOn struct {
+	ID                string `json:"id"`
+	Title             string `json:"title"`
+	Price             uint64 `json:"price"`
+	DeliveryDaysDelta int    `json:"deliveryDaysDelta"`
+}
+
+// OrderExport is an order handed out in a personal data export, the payment
+// intent and its client secret are left out.
+type OrderExport struct {
+	ID                 string             `json:"id"`
+	SellerID           string             `json:"sellerId"`
+	BuyerID            string             `json:"buyerId"`
+	GigTitle           string             `json:"gigTitle"`
+	GigDescription     string             `json:"gigDescription"`
+	Status             OrderStatus        `json:"status"`
+	Price              uint64             `json:"price"`
+	ServiceFee         uint               `json:"serviceFee"`
+	PackageName        string             `json:"packageName,omitempty"`
+	AddOns             []OrderAddOn       `json:"addOns"`
+	InvoiceID          string             `json:"invoiceId,omitempty"`
+	StartDate          time.Time          `json:"startDate"`
+	Deadline           time.Time          `json:"deadline"`
+	OrderEvents        []OrderEvent       `json:"orderEvents"`
+	DeliveredHistories []DeliveredHistory `json:"deliveredHistories"`
+}
+
+// CreateOrderDTO never takes the price and deadline from the client. A custom
+// offer (MessageID) is priced from the offer in the chat service and every
+// other order names a gig package, priced with the add-ons in AddOnIDs.
 type CreateOrderDTO struct {
-	SellerID           string `json:"sellerId" validate:"required"`
-	BuyerID            string `json:"buyerId"`
-	GigTitle           string `json:"gigTitle" validate:"required"`
-	GigDescription     string `json:"gigDescription"`
-	Price              uint64 `json:"price" validate:"required"`
-	ServiceFee         uint   `json:"serviceFee"`
-	PaymentIntentID    string `json:"paymentIntentId"`
-	StripeClientSecret string `json:"stripeClientSecret"`
-	Deadline           int    `json:"deadline" validate:"required,gt=0,lte=365"`
-	MessageID          string `json:"messageId,omitempty"`
+	SellerID           string       `json:"sellerId" validate:"required"`
+	BuyerID            string       `json:"buyerId"`
+	GigTitle           string       `json:"gigTitle"`
+	GigDescription     string       `json:"gigDescription"`
+	Price              uint64       `json:"price"`
+	ServiceFee         uint         `json:"serviceFee"`
+	PaymentIntentID    string       `json:"paymentIntentId"`
+	StripeClientSecret string       `json:"stripeClientSecret"`
+	Deadline           int          `json:"deadline" validate:"gte=0,lte=365"`
+	MessageID          string       `json:"messageId,omitempty" validate:"omitempty,uuid"`
+	GigID              string       `json:"gigId,omitempty" validate:"required_with=PackageID MessageID,omitempty,uuid"`
+	PackageID          string       `json:"packageId,omitempty" validate:"required_without=MessageID,excluded_with=MessageID,omitempty,uuid"`
+	AddOnIDs           []string     `json:"addOnIds,omitempty" validate:"max=10,unique,dive,uuid"`
+	PackageTier        string       `json:"-"`
+	PackageName        string       `json:"-"`
+	Revisions          uint         `json:"-"`
+	AddOns             []OrderAddOn `json:"-"`
 }
 
 type DeadlineExtensionRequest struct {
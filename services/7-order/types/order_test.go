@@ -0,0 +1,24 @@
+package types
+
+import "testing"
+
+func TestServiceFee(t *testing.T) {
+	tests := []struct {
+		price uint64
+		want  uint
+	}{
+		{price: 0, want: 0},
+		{price: 1, want: 1},
+		{price: 40, want: 1},
+		{price: 100, want: 3},
+		{price: 200, want: 5},
+		{price: 1000, want: 25},
+		{price: 1001, want: 26},
+	}
+
+	for _, tt := range tests {
+		if got := ServiceFee(tt.price); got != tt.want {
+			t.Errorf("ServiceFee(%d) = %d, want %d", tt.price, got, tt.want)
+		}
+	}
+}
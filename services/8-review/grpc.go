@@ -4,6 +4,8 @@ import (
 	"log"
 	"net"
 
+	"github.com/Akihira77/gojobber/services/8-review/handler"
+	"github.com/Akihira77/gojobber/services/8-review/service"
 	"google.golang.org/grpc"
 	"gorm.io/gorm"
 )
@@ -26,6 +28,9 @@ func (s *gRPCServer) Run(db *gorm.DB) error {
 
 	grpcServer := grpc.NewServer()
 
+	reviewSvc := service.NewReviewService(db)
+	handler.NewReviewGRPCHandler(grpcServer, reviewSvc)
+
 	log.Println("starting grpc server on", s.addr)
 
 	return grpcServer.Serve(lis)
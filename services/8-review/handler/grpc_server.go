@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/Akihira77/gojobber/services/8-review/service"
+	"github.com/Akihira77/gojobber/services/8-review/types"
+	"github.com/Akihira77/gojobber/services/common/genproto/review"
+	"google.golang.org/grpc"
+)
+
+type ReviewGrpcHandler struct {
+	reviewSvc service.ReviewServiceImpl
+	review.UnimplementedReviewServiceServer
+}
+
+func NewReviewGRPCHandler(grpc *grpc.Server, reviewSvc service.ReviewServiceImpl) {
+	gRPCHandler := &ReviewGrpcHandler{
+		reviewSvc: reviewSvc,
+	}
+
+	review.RegisterReviewServiceServer(grpc, gRPCHandler)
+}
+
+func (h *ReviewGrpcHandler) FindSellerRatingStats(ctx context.Context, req *review.FindSellerRatingStatsRequest) (*review.FindSellerRatingStatsResponse, error) {
+	log.Println("FindSellerRatingStats receive data", req)
+
+	stats, err := h.reviewSvc.FindSellerRatingStats(ctx, req.SellerIds)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &review.FindSellerRatingStatsResponse{
+		Stats: make(map[string]*review.SellerRatingStats, len(stats)),
+	}
+	for _, s := range stats {
+		res.Stats[s.SellerID] = &review.SellerRatingStats{
+			RatingsCount: s.RatingsCount,
+			RatingSum:    s.RatingSum,
+		}
+	}
+
+	return res, nil
+}
+
+func (h *ReviewGrpcHandler) ExportReviewData(ctx context.Context, req *review.ExportReviewDataRequest) (*review.ExportReviewDataResponse, error) {
+	log.Println("ExportReviewData receive data", req)
+
+	written, received, err := h.reviewSvc.FindUserReviews(ctx, req.UserId, req.SellerId)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(struct {
+		Written  []types.Review `json:"written"`
+		Received []types.Review `json:"received"`
+	}{
+		Written:  written,
+		Received: received,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &review.ExportReviewDataResponse{
+		Data: data,
+	}, nil
+}
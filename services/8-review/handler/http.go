@@ -13,9 +13,11 @@ import (
 	"github.com/Akihira77/gojobber/services/8-review/util"
 	"github.com/Akihira77/gojobber/services/common/genproto/notification"
 	"github.com/Akihira77/gojobber/services/common/genproto/user"
+	"github.com/Akihira77/gojobber/services/common/locale"
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/structpb"
 	"gorm.io/gorm"
 )
 
@@ -104,9 +106,28 @@ func (rh *ReviewHandler) Add(c *fiber.Ctx) error {
 		}
 
 		notificationGrpcClient := notification.NewNotificationServiceClient(cc)
+		payload, err := structpb.NewStruct(map[string]interface{}{
+			"reviewId": r.ID.String(),
+			"sellerId": r.SellerID,
+			"buyerId":  r.BuyerID,
+			"rating":   r.Rating,
+			"review":   r.Review,
+		})
+		if err == nil {
+			_, err = notificationGrpcClient.PublishWebhookEvent(context.TODO(), &notification.WebhookEvent{
+				OwnerEmail: s.Email,
+				Event:      "review.created",
+				Data:       payload,
+			})
+		}
+		if err != nil {
+			log.Printf("Add Review Error:\n%+v", err)
+		}
+
 		_, err = notificationGrpcClient.NotifySellerGotAReview(context.TODO(), &notification.NotifySellerGotAReviewRequest{
 			ReceiverEmail: s.Email,
 			Message:       fmt.Sprintf("Buyer [%s] Giving You A Rating [%v] And Review:\n%s", data.BuyerID, data.Rating, data.Review),
+			Locale:        locale.FromCountry(s.Country),
 		})
 		if err != nil {
 			log.Printf("Add Review Error:\n%+v", err)
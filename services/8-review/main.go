@@ -40,5 +40,11 @@ func main() {
 	ccs.AddClient(types.USER_SERVICE, os.Getenv("USER_GRPC_PORT"))
 	ccs.AddClient(types.NOTIFICATION_SERVICE, os.Getenv("NOTIFICATION_GRPC_PORT"))
 
-	NewHttpServer(db, ccs)
+	go NewHttpServer(db, ccs)
+
+	grpcServer := NewGRPCServer(os.Getenv("REVIEW_GRPC_PORT"))
+	err = grpcServer.Run(db)
+	if err != nil {
+		log.Fatalf("Failed running GRPC Server %v", err)
+	}
 }
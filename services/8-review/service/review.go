@@ -21,6 +21,8 @@ type ReviewServiceImpl interface {
 	Add(ctx context.Context, data types.UpsertReviewDTO) (*types.Review, error)
 	Update(ctx context.Context, data types.Review) (*types.Review, error)
 	Remove(ctx context.Context, reviewID string) error
+	FindSellerRatingStats(ctx context.Context, sellerIDs []string) ([]types.SellerRatingStats, error)
+	FindUserReviews(ctx context.Context, buyerID, sellerID string) ([]types.Review, []types.Review, error)
 }
 
 func NewReviewService(db *gorm.DB) ReviewServiceImpl {
@@ -106,3 +108,46 @@ func (rs *ReviewService) Update(ctx context.Context, data types.Review) (*types.
 
 	return &data, result.Error
 }
+
+func (rs *ReviewService) FindSellerRatingStats(ctx context.Context, sellerIDs []string) ([]types.SellerRatingStats, error) {
+	var stats []types.SellerRatingStats
+	if len(sellerIDs) == 0 {
+		return stats, nil
+	}
+
+	result := rs.db.
+		Debug().
+		WithContext(ctx).
+		Model(&types.Review{}).
+		Select("seller_id, COUNT(*) AS ratings_count, SUM(rating) AS rating_sum").
+		Where("seller_id IN ?", sellerIDs).
+		Group("seller_id").
+		Scan(&stats)
+
+	return stats, result.Error
+}
+
+// FindUserReviews returns the reviews the user wrote as a buyer and the ones
+// they received as a seller.
+func (rs *ReviewService) FindUserReviews(ctx context.Context, buyerID, sellerID string) ([]types.Review, []types.Review, error) {
+	written := []types.Review{}
+	result := rs.db.
+		WithContext(ctx).
+		Model(&types.Review{}).
+		Where("buyer_id = ?", buyerID).
+		Order("created_at").
+		Find(&written)
+	if result.Error != nil || sellerID == "" {
+		return written, []types.Review{}, result.Error
+	}
+
+	received := []types.Review{}
+	result = rs.db.
+		WithContext(ctx).
+		Model(&types.Review{}).
+		Where("seller_id = ?", sellerID).
+		Order("created_at").
+		Find(&received)
+
+	return written, received, result.Error
+}
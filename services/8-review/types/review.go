@@ -16,6 +16,12 @@ type Review struct {
 	CreatedAt time.Time `json:"createdAt" gorm:"not null;"`
 }
 
+type SellerRatingStats struct {
+	SellerID     string `json:"sellerId"`
+	RatingsCount int64  `json:"ratingsCount"`
+	RatingSum    int64  `json:"ratingSum"`
+}
+
 type UpsertReviewDTO struct {
 	SellerID string `json:"sellerId" validate:"required"`
 	BuyerID  string `json:"buyerId" validate:"required"`
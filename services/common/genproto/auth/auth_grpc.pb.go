@@ -20,6 +20,7 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	AuthService_FindUserByUserID_FullMethodName = "/AuthService/FindUserByUserID"
+	AuthService_ExportAuthData_FullMethodName   = "/AuthService/ExportAuthData"
 )
 
 // AuthServiceClient is the client API for AuthService service.
@@ -27,6 +28,7 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type AuthServiceClient interface {
 	FindUserByUserID(ctx context.Context, in *FindUserRequest, opts ...grpc.CallOption) (*FindUserResponse, error)
+	ExportAuthData(ctx context.Context, in *ExportAuthDataRequest, opts ...grpc.CallOption) (*ExportAuthDataResponse, error)
 }
 
 type authServiceClient struct {
@@ -47,11 +49,22 @@ func (c *authServiceClient) FindUserByUserID(ctx context.Context, in *FindUserRe
 	return out, nil
 }
 
+func (c *authServiceClient) ExportAuthData(ctx context.Context, in *ExportAuthDataRequest, opts ...grpc.CallOption) (*ExportAuthDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportAuthDataResponse)
+	err := c.cc.Invoke(ctx, AuthService_ExportAuthData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthServiceServer is the server API for AuthService service.
 // All implementations must embed UnimplementedAuthServiceServer
 // for forward compatibility.
 type AuthServiceServer interface {
 	FindUserByUserID(context.Context, *FindUserRequest) (*FindUserResponse, error)
+	ExportAuthData(context.Context, *ExportAuthDataRequest) (*ExportAuthDataResponse, error)
 	mustEmbedUnimplementedAuthServiceServer()
 }
 
@@ -65,6 +78,9 @@ type UnimplementedAuthServiceServer struct{}
 func (UnimplementedAuthServiceServer) FindUserByUserID(context.Context, *FindUserRequest) (*FindUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindUserByUserID not implemented")
 }
+func (UnimplementedAuthServiceServer) ExportAuthData(context.Context, *ExportAuthDataRequest) (*ExportAuthDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportAuthData not implemented")
+}
 func (UnimplementedAuthServiceServer) mustEmbedUnimplementedAuthServiceServer() {}
 func (UnimplementedAuthServiceServer) testEmbeddedByValue()                     {}
 
@@ -104,6 +120,24 @@ func _AuthService_FindUserByUserID_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_ExportAuthData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportAuthDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).ExportAuthData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_ExportAuthData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).ExportAuthData(ctx, req.(*ExportAuthDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthService_ServiceDesc is the grpc.ServiceDesc for AuthService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -115,6 +149,10 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FindUserByUserID",
 			Handler:    _AuthService_FindUserByUserID_Handler,
 		},
+		{
+			MethodName: "ExportAuthData",
+			Handler:    _AuthService_ExportAuthData_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "auth.proto",
@@ -21,6 +21,9 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	ChatService_BuyerAcceptedOffer_FullMethodName = "/ChatService/BuyerAcceptedOffer"
+	ChatService_FindOffer_FullMethodName          = "/ChatService/FindOffer"
+	ChatService_FindUnreadMessages_FullMethodName = "/ChatService/FindUnreadMessages"
+	ChatService_ExportChatData_FullMethodName     = "/ChatService/ExportChatData"
 )
 
 // ChatServiceClient is the client API for ChatService service.
@@ -28,6 +31,9 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ChatServiceClient interface {
 	BuyerAcceptedOffer(ctx context.Context, in *BuyerAcceptedOfferRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	FindOffer(ctx context.Context, in *FindOfferRequest, opts ...grpc.CallOption) (*FindOfferResponse, error)
+	FindUnreadMessages(ctx context.Context, in *FindUnreadMessagesRequest, opts ...grpc.CallOption) (*FindUnreadMessagesResponse, error)
+	ExportChatData(ctx context.Context, in *ExportChatDataRequest, opts ...grpc.CallOption) (*ExportChatDataResponse, error)
 }
 
 type chatServiceClient struct {
@@ -48,11 +54,44 @@ func (c *chatServiceClient) BuyerAcceptedOffer(ctx context.Context, in *BuyerAcc
 	return out, nil
 }
 
+func (c *chatServiceClient) FindOffer(ctx context.Context, in *FindOfferRequest, opts ...grpc.CallOption) (*FindOfferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindOfferResponse)
+	err := c.cc.Invoke(ctx, ChatService_FindOffer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) FindUnreadMessages(ctx context.Context, in *FindUnreadMessagesRequest, opts ...grpc.CallOption) (*FindUnreadMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindUnreadMessagesResponse)
+	err := c.cc.Invoke(ctx, ChatService_FindUnreadMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) ExportChatData(ctx context.Context, in *ExportChatDataRequest, opts ...grpc.CallOption) (*ExportChatDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportChatDataResponse)
+	err := c.cc.Invoke(ctx, ChatService_ExportChatData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ChatServiceServer is the server API for ChatService service.
 // All implementations must embed UnimplementedChatServiceServer
 // for forward compatibility.
 type ChatServiceServer interface {
 	BuyerAcceptedOffer(context.Context, *BuyerAcceptedOfferRequest) (*emptypb.Empty, error)
+	FindOffer(context.Context, *FindOfferRequest) (*FindOfferResponse, error)
+	FindUnreadMessages(context.Context, *FindUnreadMessagesRequest) (*FindUnreadMessagesResponse, error)
+	ExportChatData(context.Context, *ExportChatDataRequest) (*ExportChatDataResponse, error)
 	mustEmbedUnimplementedChatServiceServer()
 }
 
@@ -66,6 +105,15 @@ type UnimplementedChatServiceServer struct{}
 func (UnimplementedChatServiceServer) BuyerAcceptedOffer(context.Context, *BuyerAcceptedOfferRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BuyerAcceptedOffer not implemented")
 }
+func (UnimplementedChatServiceServer) FindOffer(context.Context, *FindOfferRequest) (*FindOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindOffer not implemented")
+}
+func (UnimplementedChatServiceServer) FindUnreadMessages(context.Context, *FindUnreadMessagesRequest) (*FindUnreadMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindUnreadMessages not implemented")
+}
+func (UnimplementedChatServiceServer) ExportChatData(context.Context, *ExportChatDataRequest) (*ExportChatDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportChatData not implemented")
+}
 func (UnimplementedChatServiceServer) mustEmbedUnimplementedChatServiceServer() {}
 func (UnimplementedChatServiceServer) testEmbeddedByValue()                     {}
 
@@ -105,6 +153,60 @@ func _ChatService_BuyerAcceptedOffer_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChatService_FindOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).FindOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_FindOffer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).FindOffer(ctx, req.(*FindOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_FindUnreadMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindUnreadMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).FindUnreadMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_FindUnreadMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).FindUnreadMessages(ctx, req.(*FindUnreadMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_ExportChatData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportChatDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).ExportChatData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_ExportChatData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).ExportChatData(ctx, req.(*ExportChatDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ChatService_ServiceDesc is the grpc.ServiceDesc for ChatService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -116,6 +218,18 @@ var ChatService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BuyerAcceptedOffer",
 			Handler:    _ChatService_BuyerAcceptedOffer_Handler,
 		},
+		{
+			MethodName: "FindOffer",
+			Handler:    _ChatService_FindOffer_Handler,
+		},
+		{
+			MethodName: "FindUnreadMessages",
+			Handler:    _ChatService_FindUnreadMessages_Handler,
+		},
+		{
+			MethodName: "ExportChatData",
+			Handler:    _ChatService_ExportChatData_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "chat.proto",
@@ -0,0 +1,576 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v3.21.12
+// source: gig.proto
+
+package gig
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// INFO: PERSONAL DATA EXPORT, data IS THE JSON ENCODED PART OF THE SERVICE
+type ExportGigDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SellerId string `protobuf:"bytes,1,opt,name=sellerId,proto3" json:"sellerId,omitempty"`
+}
+
+func (x *ExportGigDataRequest) Reset() {
+	*x = ExportGigDataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gig_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportGigDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportGigDataRequest) ProtoMessage() {}
+
+func (x *ExportGigDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gig_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportGigDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportGigDataRequest) Descriptor() ([]byte, []int) {
+	return file_gig_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExportGigDataRequest) GetSellerId() string {
+	if x != nil {
+		return x.SellerId
+	}
+	return ""
+}
+
+type ExportGigDataResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ExportGigDataResponse) Reset() {
+	*x = ExportGigDataResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gig_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportGigDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportGigDataResponse) ProtoMessage() {}
+
+func (x *ExportGigDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gig_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportGigDataResponse.ProtoReflect.Descriptor instead.
+func (*ExportGigDataResponse) Descriptor() ([]byte, []int) {
+	return file_gig_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ExportGigDataResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// INFO: THE PACKAGE A BUYER ORDERS AND THE ADD-ONS PICKED WITH IT, PRICES ARE IN WHOLE CURRENCY UNITS
+type FindGigPackageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GigId     string   `protobuf:"bytes,1,opt,name=gigId,proto3" json:"gigId,omitempty"`
+	PackageId string   `protobuf:"bytes,2,opt,name=packageId,proto3" json:"packageId,omitempty"`
+	AddOnIds  []string `protobuf:"bytes,3,rep,name=addOnIds,proto3" json:"addOnIds,omitempty"`
+}
+
+func (x *FindGigPackageRequest) Reset() {
+	*x = FindGigPackageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gig_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindGigPackageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindGigPackageRequest) ProtoMessage() {}
+
+func (x *FindGigPackageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gig_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindGigPackageRequest.ProtoReflect.Descriptor instead.
+func (*FindGigPackageRequest) Descriptor() ([]byte, []int) {
+	return file_gig_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FindGigPackageRequest) GetGigId() string {
+	if x != nil {
+		return x.GigId
+	}
+	return ""
+}
+
+func (x *FindGigPackageRequest) GetPackageId() string {
+	if x != nil {
+		return x.PackageId
+	}
+	return ""
+}
+
+func (x *FindGigPackageRequest) GetAddOnIds() []string {
+	if x != nil {
+		return x.AddOnIds
+	}
+	return nil
+}
+
+type GigAddOn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title             string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Price             uint64 `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	DeliveryDaysDelta int32  `protobuf:"varint,4,opt,name=deliveryDaysDelta,proto3" json:"deliveryDaysDelta,omitempty"`
+}
+
+func (x *GigAddOn) Reset() {
+	*x = GigAddOn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gig_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GigAddOn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GigAddOn) ProtoMessage() {}
+
+func (x *GigAddOn) ProtoReflect() protoreflect.Message {
+	mi := &file_gig_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GigAddOn.ProtoReflect.Descriptor instead.
+func (*GigAddOn) Descriptor() ([]byte, []int) {
+	return file_gig_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GigAddOn) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GigAddOn) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *GigAddOn) GetPrice() uint64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *GigAddOn) GetDeliveryDaysDelta() int32 {
+	if x != nil {
+		return x.DeliveryDaysDelta
+	}
+	return 0
+}
+
+type FindGigPackageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GigId        string      `protobuf:"bytes,1,opt,name=gigId,proto3" json:"gigId,omitempty"`
+	SellerId     string      `protobuf:"bytes,2,opt,name=sellerId,proto3" json:"sellerId,omitempty"`
+	GigTitle     string      `protobuf:"bytes,3,opt,name=gigTitle,proto3" json:"gigTitle,omitempty"`
+	Active       bool        `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+	PackageId    string      `protobuf:"bytes,5,opt,name=packageId,proto3" json:"packageId,omitempty"`
+	Tier         string      `protobuf:"bytes,6,opt,name=tier,proto3" json:"tier,omitempty"`
+	Name         string      `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
+	Description  string      `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	Price        uint64      `protobuf:"varint,9,opt,name=price,proto3" json:"price,omitempty"`
+	DeliveryDays uint32      `protobuf:"varint,10,opt,name=deliveryDays,proto3" json:"deliveryDays,omitempty"`
+	Revisions    uint32      `protobuf:"varint,11,opt,name=revisions,proto3" json:"revisions,omitempty"`
+	Features     []string    `protobuf:"bytes,12,rep,name=features,proto3" json:"features,omitempty"`
+	AddOns       []*GigAddOn `protobuf:"bytes,13,rep,name=addOns,proto3" json:"addOns,omitempty"`
+}
+
+func (x *FindGigPackageResponse) Reset() {
+	*x = FindGigPackageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gig_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindGigPackageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindGigPackageResponse) ProtoMessage() {}
+
+func (x *FindGigPackageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gig_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindGigPackageResponse.ProtoReflect.Descriptor instead.
+func (*FindGigPackageResponse) Descriptor() ([]byte, []int) {
+	return file_gig_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FindGigPackageResponse) GetGigId() string {
+	if x != nil {
+		return x.GigId
+	}
+	return ""
+}
+
+func (x *FindGigPackageResponse) GetSellerId() string {
+	if x != nil {
+		return x.SellerId
+	}
+	return ""
+}
+
+func (x *FindGigPackageResponse) GetGigTitle() string {
+	if x != nil {
+		return x.GigTitle
+	}
+	return ""
+}
+
+func (x *FindGigPackageResponse) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *FindGigPackageResponse) GetPackageId() string {
+	if x != nil {
+		return x.PackageId
+	}
+	return ""
+}
+
+func (x *FindGigPackageResponse) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+func (x *FindGigPackageResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FindGigPackageResponse) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *FindGigPackageResponse) GetPrice() uint64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *FindGigPackageResponse) GetDeliveryDays() uint32 {
+	if x != nil {
+		return x.DeliveryDays
+	}
+	return 0
+}
+
+func (x *FindGigPackageResponse) GetRevisions() uint32 {
+	if x != nil {
+		return x.Revisions
+	}
+	return 0
+}
+
+func (x *FindGigPackageResponse) GetFeatures() []string {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+func (x *FindGigPackageResponse) GetAddOns() []*GigAddOn {
+	if x != nil {
+		return x.AddOns
+	}
+	return nil
+}
+
+var File_gig_proto protoreflect.FileDescriptor
+
+var file_gig_proto_rawDesc = []byte{
+	0x0a, 0x09, 0x67, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x32, 0x0a, 0x14, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x69, 0x67, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0x2b, 0x0a, 0x15, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x69, 0x67, 0x44, 0x61, 0x74, 0x61,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x67, 0x0a, 0x15,
+	0x46, 0x69, 0x6e, 0x64, 0x47, 0x69, 0x67, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x69, 0x67, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x69, 0x67, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x64, 0x64,
+	0x4f, 0x6e, 0x49, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x61, 0x64, 0x64,
+	0x4f, 0x6e, 0x49, 0x64, 0x73, 0x22, 0x74, 0x0a, 0x08, 0x47, 0x69, 0x67, 0x41, 0x64, 0x64, 0x4f,
+	0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x2c, 0x0a,
+	0x11, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x44, 0x61, 0x79, 0x73, 0x44, 0x65, 0x6c,
+	0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x79, 0x44, 0x61, 0x79, 0x73, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x22, 0xfd, 0x02, 0x0a, 0x16,
+	0x46, 0x69, 0x6e, 0x64, 0x47, 0x69, 0x67, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x69, 0x67, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x69, 0x67, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x69, 0x67, 0x54,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x67, 0x69, 0x67, 0x54,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69,
+	0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x65, 0x72, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x44, 0x61, 0x79, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0c, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x44, 0x61, 0x79, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
+	0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x06, 0x61, 0x64, 0x64, 0x4f,
+	0x6e, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x47, 0x69, 0x67, 0x41, 0x64,
+	0x64, 0x4f, 0x6e, 0x52, 0x06, 0x61, 0x64, 0x64, 0x4f, 0x6e, 0x73, 0x32, 0x93, 0x01, 0x0a, 0x0a,
+	0x47, 0x69, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x40, 0x0a, 0x0d, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x47, 0x69, 0x67, 0x44, 0x61, 0x74, 0x61, 0x12, 0x15, 0x2e, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x47, 0x69, 0x67, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x69, 0x67, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0e,
+	0x46, 0x69, 0x6e, 0x64, 0x47, 0x69, 0x67, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x16,
+	0x2e, 0x46, 0x69, 0x6e, 0x64, 0x47, 0x69, 0x67, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x47, 0x69, 0x67,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x42, 0x21, 0x5a, 0x1f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x41, 0x6b, 0x69, 0x68, 0x69, 0x72, 0x61, 0x37, 0x37, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2f, 0x67, 0x69, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_gig_proto_rawDescOnce sync.Once
+	file_gig_proto_rawDescData = file_gig_proto_rawDesc
+)
+
+func file_gig_proto_rawDescGZIP() []byte {
+	file_gig_proto_rawDescOnce.Do(func() {
+		file_gig_proto_rawDescData = protoimpl.X.CompressGZIP(file_gig_proto_rawDescData)
+	})
+	return file_gig_proto_rawDescData
+}
+
+var file_gig_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_gig_proto_goTypes = []any{
+	(*ExportGigDataRequest)(nil),   // 0: ExportGigDataRequest
+	(*ExportGigDataResponse)(nil),  // 1: ExportGigDataResponse
+	(*FindGigPackageRequest)(nil),  // 2: FindGigPackageRequest
+	(*GigAddOn)(nil),               // 3: GigAddOn
+	(*FindGigPackageResponse)(nil), // 4: FindGigPackageResponse
+}
+var file_gig_proto_depIdxs = []int32{
+	3, // 0: FindGigPackageResponse.addOns:type_name -> GigAddOn
+	0, // 1: GigService.ExportGigData:input_type -> ExportGigDataRequest
+	2, // 2: GigService.FindGigPackage:input_type -> FindGigPackageRequest
+	1, // 3: GigService.ExportGigData:output_type -> ExportGigDataResponse
+	4, // 4: GigService.FindGigPackage:output_type -> FindGigPackageResponse
+	3, // [3:5] is the sub-list for method output_type
+	1, // [1:3] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_gig_proto_init() }
+func file_gig_proto_init() {
+	if File_gig_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_gig_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportGigDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gig_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*ExportGigDataResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gig_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*FindGigPackageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gig_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*GigAddOn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gig_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*FindGigPackageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_gig_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_gig_proto_goTypes,
+		DependencyIndexes: file_gig_proto_depIdxs,
+		MessageInfos:      file_gig_proto_msgTypes,
+	}.Build()
+	File_gig_proto = out.File
+	file_gig_proto_rawDesc = nil
+	file_gig_proto_goTypes = nil
+	file_gig_proto_depIdxs = nil
+}
@@ -0,0 +1,159 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v3.21.12
+// source: gig.proto
+
+package gig
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	GigService_ExportGigData_FullMethodName  = "/GigService/ExportGigData"
+	GigService_FindGigPackage_FullMethodName = "/GigService/FindGigPackage"
+)
+
+// GigServiceClient is the client API for GigService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GigServiceClient interface {
+	ExportGigData(ctx context.Context, in *ExportGigDataRequest, opts ...grpc.CallOption) (*ExportGigDataResponse, error)
+	FindGigPackage(ctx context.Context, in *FindGigPackageRequest, opts ...grpc.CallOption) (*FindGigPackageResponse, error)
+}
+
+type gigServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGigServiceClient(cc grpc.ClientConnInterface) GigServiceClient {
+	return &gigServiceClient{cc}
+}
+
+func (c *gigServiceClient) ExportGigData(ctx context.Context, in *ExportGigDataRequest, opts ...grpc.CallOption) (*ExportGigDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportGigDataResponse)
+	err := c.cc.Invoke(ctx, GigService_ExportGigData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gigServiceClient) FindGigPackage(ctx context.Context, in *FindGigPackageRequest, opts ...grpc.CallOption) (*FindGigPackageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindGigPackageResponse)
+	err := c.cc.Invoke(ctx, GigService_FindGigPackage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GigServiceServer is the server API for GigService service.
+// All implementations must embed UnimplementedGigServiceServer
+// for forward compatibility.
+type GigServiceServer interface {
+	ExportGigData(context.Context, *ExportGigDataRequest) (*ExportGigDataResponse, error)
+	FindGigPackage(context.Context, *FindGigPackageRequest) (*FindGigPackageResponse, error)
+	mustEmbedUnimplementedGigServiceServer()
+}
+
+// UnimplementedGigServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGigServiceServer struct{}
+
+func (UnimplementedGigServiceServer) ExportGigData(context.Context, *ExportGigDataRequest) (*ExportGigDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportGigData not implemented")
+}
+func (UnimplementedGigServiceServer) FindGigPackage(context.Context, *FindGigPackageRequest) (*FindGigPackageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindGigPackage not implemented")
+}
+func (UnimplementedGigServiceServer) mustEmbedUnimplementedGigServiceServer() {}
+func (UnimplementedGigServiceServer) testEmbeddedByValue()                    {}
+
+// UnsafeGigServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GigServiceServer will
+// result in compilation errors.
+type UnsafeGigServiceServer interface {
+	mustEmbedUnimplementedGigServiceServer()
+}
+
+func RegisterGigServiceServer(s grpc.ServiceRegistrar, srv GigServiceServer) {
+	// If the following call pancis, it indicates UnimplementedGigServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GigService_ServiceDesc, srv)
+}
+
+func _GigService_ExportGigData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportGigDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GigServiceServer).ExportGigData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GigService_ExportGigData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GigServiceServer).ExportGigData(ctx, req.(*ExportGigDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GigService_FindGigPackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindGigPackageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GigServiceServer).FindGigPackage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GigService_FindGigPackage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GigServiceServer).FindGigPackage(ctx, req.(*FindGigPackageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GigService_ServiceDesc is the grpc.ServiceDesc for GigService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GigService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "GigService",
+	HandlerType: (*GigServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExportGigData",
+			Handler:    _GigService_ExportGigData_Handler,
+		},
+		{
+			MethodName: "FindGigPackage",
+			Handler:    _GigService_FindGigPackage_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gig.proto",
+}
@@ -10,6 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -22,6 +23,293 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// INFO: GENERIC EVENTS, NEW NOTIFICATIONS ONLY NEED A NEW EVENT TYPE AND TEMPLATE
+type NotificationEventType int32
+
+const (
+	NotificationEventType_NOTIFICATION_EVENT_UNSPECIFIED    NotificationEventType = 0
+	NotificationEventType_USER_VERIFYING_EMAIL              NotificationEventType = 1
+	NotificationEventType_USER_FORGOT_PASSWORD              NotificationEventType = 2
+	NotificationEventType_USER_SUCCESS_RESET_PASSWORD       NotificationEventType = 3
+	NotificationEventType_CHAT_MESSAGE                      NotificationEventType = 4
+	NotificationEventType_SELLER_COMPLETED_ORDER            NotificationEventType = 5
+	NotificationEventType_SELLER_REQUEST_DEADLINE_EXTENSION NotificationEventType = 6
+	NotificationEventType_SELLER_CANCELED_ORDER             NotificationEventType = 7
+	NotificationEventType_BUYER_DEADLINE_EXTENSION_RESPONSE NotificationEventType = 8
+	NotificationEventType_BUYER_REFUNDS_ORDER               NotificationEventType = 9
+	NotificationEventType_SELLER_GOT_AN_ORDER               NotificationEventType = 10
+	NotificationEventType_SELLER_GOT_A_REVIEW               NotificationEventType = 11
+	NotificationEventType_BUYER_ORDER_DELIVERED             NotificationEventType = 12
+	NotificationEventType_BUYER_ORDER_ACKNOWLEDGED          NotificationEventType = 13
+	NotificationEventType_SELLER_BUYER_RESPONDED_DELIVERY   NotificationEventType = 14
+	NotificationEventType_SELLER_PAYOUT_PAID                NotificationEventType = 15
+	NotificationEventType_SELLER_PAYOUT_FAILED              NotificationEventType = 16
+	NotificationEventType_USER_DATA_EXPORT_READY            NotificationEventType = 17
+)
+
+// Enum value maps for NotificationEventType.
+var (
+	NotificationEventType_name = map[int32]string{
+		0:  "NOTIFICATION_EVENT_UNSPECIFIED",
+		1:  "USER_VERIFYING_EMAIL",
+		2:  "USER_FORGOT_PASSWORD",
+		3:  "USER_SUCCESS_RESET_PASSWORD",
+		4:  "CHAT_MESSAGE",
+		5:  "SELLER_COMPLETED_ORDER",
+		6:  "SELLER_REQUEST_DEADLINE_EXTENSION",
+		7:  "SELLER_CANCELED_ORDER",
+		8:  "BUYER_DEADLINE_EXTENSION_RESPONSE",
+		9:  "BUYER_REFUNDS_ORDER",
+		10: "SELLER_GOT_AN_ORDER",
+		11: "SELLER_GOT_A_REVIEW",
+		12: "BUYER_ORDER_DELIVERED",
+		13: "BUYER_ORDER_ACKNOWLEDGED",
+		14: "SELLER_BUYER_RESPONDED_DELIVERY",
+		15: "SELLER_PAYOUT_PAID",
+		16: "SELLER_PAYOUT_FAILED",
+		17: "USER_DATA_EXPORT_READY",
+	}
+	NotificationEventType_value = map[string]int32{
+		"NOTIFICATION_EVENT_UNSPECIFIED":    0,
+		"USER_VERIFYING_EMAIL":              1,
+		"USER_FORGOT_PASSWORD":              2,
+		"USER_SUCCESS_RESET_PASSWORD":       3,
+		"CHAT_MESSAGE":                      4,
+		"SELLER_COMPLETED_ORDER":            5,
+		"SELLER_REQUEST_DEADLINE_EXTENSION": 6,
+		"SELLER_CANCELED_ORDER":             7,
+		"BUYER_DEADLINE_EXTENSION_RESPONSE": 8,
+		"BUYER_REFUNDS_ORDER":               9,
+		"SELLER_GOT_AN_ORDER":               10,
+		"SELLER_GOT_A_REVIEW":               11,
+		"BUYER_ORDER_DELIVERED":             12,
+		"BUYER_ORDER_ACKNOWLEDGED":          13,
+		"SELLER_BUYER_RESPONDED_DELIVERY":   14,
+		"SELLER_PAYOUT_PAID":                15,
+		"SELLER_PAYOUT_FAILED":              16,
+		"USER_DATA_EXPORT_READY":            17,
+	}
+)
+
+func (x NotificationEventType) Enum() *NotificationEventType {
+	p := new(NotificationEventType)
+	*p = x
+	return p
+}
+
+func (x NotificationEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotificationEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_notification_proto_enumTypes[0].Descriptor()
+}
+
+func (NotificationEventType) Type() protoreflect.EnumType {
+	return &file_notification_proto_enumTypes[0]
+}
+
+func (x NotificationEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotificationEventType.Descriptor instead.
+func (NotificationEventType) EnumDescriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{0}
+}
+
+type NotificationRecipient struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email  string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Locale string `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+}
+
+func (x *NotificationRecipient) Reset() {
+	*x = NotificationRecipient{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notification_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotificationRecipient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationRecipient) ProtoMessage() {}
+
+func (x *NotificationRecipient) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationRecipient.ProtoReflect.Descriptor instead.
+func (*NotificationRecipient) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *NotificationRecipient) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *NotificationRecipient) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+// NOTE: PAYLOAD KEYS ARE THE VARIABLES OF THE EVENT TEMPLATE (e.g. "OrderURL"),
+// templateName OPTIONALLY OVERRIDES THE DEFAULT TEMPLATE OF THE EVENT TYPE
+type NotificationEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type         NotificationEventType    `protobuf:"varint,1,opt,name=type,proto3,enum=NotificationEventType" json:"type,omitempty"`
+	Recipients   []*NotificationRecipient `protobuf:"bytes,2,rep,name=recipients,proto3" json:"recipients,omitempty"`
+	Payload      *structpb.Struct         `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	TemplateName string                   `protobuf:"bytes,4,opt,name=templateName,proto3" json:"templateName,omitempty"`
+}
+
+func (x *NotificationEvent) Reset() {
+	*x = NotificationEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notification_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotificationEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationEvent) ProtoMessage() {}
+
+func (x *NotificationEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationEvent.ProtoReflect.Descriptor instead.
+func (*NotificationEvent) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *NotificationEvent) GetType() NotificationEventType {
+	if x != nil {
+		return x.Type
+	}
+	return NotificationEventType_NOTIFICATION_EVENT_UNSPECIFIED
+}
+
+func (x *NotificationEvent) GetRecipients() []*NotificationRecipient {
+	if x != nil {
+		return x.Recipients
+	}
+	return nil
+}
+
+func (x *NotificationEvent) GetPayload() *structpb.Struct {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *NotificationEvent) GetTemplateName() string {
+	if x != nil {
+		return x.TemplateName
+	}
+	return ""
+}
+
+// INFO: SELLER WEBHOOKS, DELIVERED TO EVERY ENDPOINT OF ownerEmail SUBSCRIBED TO event
+type WebhookEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerEmail string           `protobuf:"bytes,1,opt,name=ownerEmail,proto3" json:"ownerEmail,omitempty"`
+	Event      string           `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	Data       *structpb.Struct `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *WebhookEvent) Reset() {
+	*x = WebhookEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notification_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookEvent) ProtoMessage() {}
+
+func (x *WebhookEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookEvent.ProtoReflect.Descriptor instead.
+func (*WebhookEvent) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WebhookEvent) GetOwnerEmail() string {
+	if x != nil {
+		return x.OwnerEmail
+	}
+	return ""
+}
+
+func (x *WebhookEvent) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *WebhookEvent) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
 // INFO: AUTH SERVICE
 type VerifyingEmailRequest struct {
 	state         protoimpl.MessageState
@@ -31,12 +319,13 @@ type VerifyingEmailRequest struct {
 	ReceiverEmail    string `protobuf:"bytes,1,opt,name=receiverEmail,proto3" json:"receiverEmail,omitempty"`
 	HtmlTemplateName string `protobuf:"bytes,2,opt,name=htmlTemplateName,proto3" json:"htmlTemplateName,omitempty"`
 	VerifyLink       string `protobuf:"bytes,3,opt,name=verifyLink,proto3" json:"verifyLink,omitempty"`
+	Locale           string `protobuf:"bytes,4,opt,name=locale,proto3" json:"locale,omitempty"`
 }
 
 func (x *VerifyingEmailRequest) Reset() {
 	*x = VerifyingEmailRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_notification_proto_msgTypes[0]
+		mi := &file_notification_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -49,7 +338,7 @@ func (x *VerifyingEmailRequest) String() string {
 func (*VerifyingEmailRequest) ProtoMessage() {}
 
 func (x *VerifyingEmailRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_proto_msgTypes[0]
+	mi := &file_notification_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -62,7 +351,7 @@ func (x *VerifyingEmailRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VerifyingEmailRequest.ProtoReflect.Descriptor instead.
 func (*VerifyingEmailRequest) Descriptor() ([]byte, []int) {
-	return file_notification_proto_rawDescGZIP(), []int{0}
+	return file_notification_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *VerifyingEmailRequest) GetReceiverEmail() string {
@@ -86,6 +375,13 @@ func (x *VerifyingEmailRequest) GetVerifyLink() string {
 	return ""
 }
 
+func (x *VerifyingEmailRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
 type ForgotPasswordRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -95,12 +391,13 @@ type ForgotPasswordRequest struct {
 	HtmlTemplateName string `protobuf:"bytes,2,opt,name=htmlTemplateName,proto3" json:"htmlTemplateName,omitempty"`
 	ResetLink        string `protobuf:"bytes,3,opt,name=resetLink,proto3" json:"resetLink,omitempty"`
 	Username         string `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	Locale           string `protobuf:"bytes,5,opt,name=locale,proto3" json:"locale,omitempty"`
 }
 
 func (x *ForgotPasswordRequest) Reset() {
 	*x = ForgotPasswordRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_notification_proto_msgTypes[1]
+		mi := &file_notification_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -113,7 +410,7 @@ func (x *ForgotPasswordRequest) String() string {
 func (*ForgotPasswordRequest) ProtoMessage() {}
 
 func (x *ForgotPasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_proto_msgTypes[1]
+	mi := &file_notification_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -126,7 +423,7 @@ func (x *ForgotPasswordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ForgotPasswordRequest.ProtoReflect.Descriptor instead.
 func (*ForgotPasswordRequest) Descriptor() ([]byte, []int) {
-	return file_notification_proto_rawDescGZIP(), []int{1}
+	return file_notification_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ForgotPasswordRequest) GetReceiverEmail() string {
@@ -157,6 +454,13 @@ func (x *ForgotPasswordRequest) GetUsername() string {
 	return ""
 }
 
+func (x *ForgotPasswordRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
 type SuccessResetPasswordRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -165,12 +469,13 @@ type SuccessResetPasswordRequest struct {
 	ReceiverEmail    string `protobuf:"bytes,1,opt,name=receiverEmail,proto3" json:"receiverEmail,omitempty"`
 	HtmlTemplateName string `protobuf:"bytes,2,opt,name=htmlTemplateName,proto3" json:"htmlTemplateName,omitempty"`
 	Username         string `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	Locale           string `protobuf:"bytes,5,opt,name=locale,proto3" json:"locale,omitempty"`
 }
 
 func (x *SuccessResetPasswordRequest) Reset() {
 	*x = SuccessResetPasswordRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_notification_proto_msgTypes[2]
+		mi := &file_notification_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -183,7 +488,7 @@ func (x *SuccessResetPasswordRequest) String() string {
 func (*SuccessResetPasswordRequest) ProtoMessage() {}
 
 func (x *SuccessResetPasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_proto_msgTypes[2]
+	mi := &file_notification_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -191,63 +496,237 @@ func (x *SuccessResetPasswordRequest) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuccessResetPasswordRequest.ProtoReflect.Descriptor instead.
+func (*SuccessResetPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SuccessResetPasswordRequest) GetReceiverEmail() string {
+	if x != nil {
+		return x.ReceiverEmail
+	}
+	return ""
+}
+
+func (x *SuccessResetPasswordRequest) GetHtmlTemplateName() string {
+	if x != nil {
+		return x.HtmlTemplateName
+	}
+	return ""
+}
+
+func (x *SuccessResetPasswordRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *SuccessResetPasswordRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+// INFO: CHAT SERVICE
+type EmailChatNotificationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReceiverEmail  string `protobuf:"bytes,1,opt,name=receiverEmail,proto3" json:"receiverEmail,omitempty"`
+	SenderEmail    string `protobuf:"bytes,2,opt,name=senderEmail,proto3" json:"senderEmail,omitempty"`
+	Message        string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	MessageId      string `protobuf:"bytes,4,opt,name=messageId,proto3" json:"messageId,omitempty"`
+	ConversationId string `protobuf:"bytes,5,opt,name=conversationId,proto3" json:"conversationId,omitempty"`
+	Locale         string `protobuf:"bytes,6,opt,name=locale,proto3" json:"locale,omitempty"`
+}
+
+func (x *EmailChatNotificationRequest) Reset() {
+	*x = EmailChatNotificationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notification_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmailChatNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmailChatNotificationRequest) ProtoMessage() {}
+
+func (x *EmailChatNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmailChatNotificationRequest.ProtoReflect.Descriptor instead.
+func (*EmailChatNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EmailChatNotificationRequest) GetReceiverEmail() string {
+	if x != nil {
+		return x.ReceiverEmail
+	}
+	return ""
+}
+
+func (x *EmailChatNotificationRequest) GetSenderEmail() string {
+	if x != nil {
+		return x.SenderEmail
+	}
+	return ""
+}
+
+func (x *EmailChatNotificationRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *EmailChatNotificationRequest) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *EmailChatNotificationRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *EmailChatNotificationRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+// NOTE: NOT AN RPC, STORED IN THE OUTBOX WHEN CHAT EMAILS ARE COALESCED
+type ChatDigestMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SenderEmail    string                 `protobuf:"bytes,1,opt,name=senderEmail,proto3" json:"senderEmail,omitempty"`
+	Message        string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	MessageId      string                 `protobuf:"bytes,3,opt,name=messageId,proto3" json:"messageId,omitempty"`
+	ConversationId string                 `protobuf:"bytes,4,opt,name=conversationId,proto3" json:"conversationId,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+}
+
+func (x *ChatDigestMessage) Reset() {
+	*x = ChatDigestMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_notification_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChatDigestMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatDigestMessage) ProtoMessage() {}
+
+func (x *ChatDigestMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatDigestMessage.ProtoReflect.Descriptor instead.
+func (*ChatDigestMessage) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ChatDigestMessage) GetSenderEmail() string {
+	if x != nil {
+		return x.SenderEmail
+	}
+	return ""
 }
 
-// Deprecated: Use SuccessResetPasswordRequest.ProtoReflect.Descriptor instead.
-func (*SuccessResetPasswordRequest) Descriptor() ([]byte, []int) {
-	return file_notification_proto_rawDescGZIP(), []int{2}
+func (x *ChatDigestMessage) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
 }
 
-func (x *SuccessResetPasswordRequest) GetReceiverEmail() string {
+func (x *ChatDigestMessage) GetMessageId() string {
 	if x != nil {
-		return x.ReceiverEmail
+		return x.MessageId
 	}
 	return ""
 }
 
-func (x *SuccessResetPasswordRequest) GetHtmlTemplateName() string {
+func (x *ChatDigestMessage) GetConversationId() string {
 	if x != nil {
-		return x.HtmlTemplateName
+		return x.ConversationId
 	}
 	return ""
 }
 
-func (x *SuccessResetPasswordRequest) GetUsername() string {
+func (x *ChatDigestMessage) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Username
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-// INFO: CHAT SERVICE
-type EmailChatNotificationRequest struct {
+type ChatDigest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ReceiverEmail string `protobuf:"bytes,1,opt,name=receiverEmail,proto3" json:"receiverEmail,omitempty"`
-	SenderEmail   string `protobuf:"bytes,2,opt,name=senderEmail,proto3" json:"senderEmail,omitempty"`
-	Message       string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	ReceiverEmail string               `protobuf:"bytes,1,opt,name=receiverEmail,proto3" json:"receiverEmail,omitempty"`
+	Messages      []*ChatDigestMessage `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+	Locale        string               `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`
 }
 
-func (x *EmailChatNotificationRequest) Reset() {
-	*x = EmailChatNotificationRequest{}
+func (x *ChatDigest) Reset() {
+	*x = ChatDigest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_notification_proto_msgTypes[3]
+		mi := &file_notification_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EmailChatNotificationRequest) String() string {
+func (x *ChatDigest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EmailChatNotificationRequest) ProtoMessage() {}
+func (*ChatDigest) ProtoMessage() {}
 
-func (x *EmailChatNotificationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_proto_msgTypes[3]
+func (x *ChatDigest) ProtoReflect() protoreflect.Message {
+	mi := &file_notification_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -258,28 +737,28 @@ func (x *EmailChatNotificationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EmailChatNotificationRequest.ProtoReflect.Descriptor instead.
-func (*EmailChatNotificationRequest) Descriptor() ([]byte, []int) {
-	return file_notification_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ChatDigest.ProtoReflect.Descriptor instead.
+func (*ChatDigest) Descriptor() ([]byte, []int) {
+	return file_notification_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *EmailChatNotificationRequest) GetReceiverEmail() string {
+func (x *ChatDigest) GetReceiverEmail() string {
 	if x != nil {
 		return x.ReceiverEmail
 	}
 	return ""
 }
 
-func (x *EmailChatNotificationRequest) GetSenderEmail() string {
+func (x *ChatDigest) GetMessages() []*ChatDigestMessage {
 	if x != nil {
-		return x.SenderEmail
+		return x.Messages
 	}
-	return ""
+	return nil
 }
 
-func (x *EmailChatNotificationRequest) GetMessage() string {
+func (x *ChatDigest) GetLocale() string {
 	if x != nil {
-		return x.Message
+		return x.Locale
 	}
 	return ""
 }
@@ -295,12 +774,13 @@ type SellerCompletedAnOrderRequest struct {
 	OrderId              string `protobuf:"bytes,3,opt,name=orderId,proto3" json:"orderId,omitempty"`
 	SellerCurrentBalance string `protobuf:"bytes,4,opt,name=sellerCurrentBalance,proto3" json:"sellerCurrentBalance,omitempty"`
 	Url                  string `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"`
+	Locale               string `protobuf:"bytes,6,opt,name=locale,proto3" json:"locale,omitempty"`
 }
 
 func (x *SellerCompletedAnOrderRequest) Reset() {
 	*x = SellerCompletedAnOrderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_notification_proto_msgTypes[4]
+		mi := &file_notification_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -313,7 +793,7 @@ func (x *SellerCompletedAnOrderRequest) String() string {
 func (*SellerCompletedAnOrderRequest) ProtoMessage() {}
 
 func (x *SellerCompletedAnOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_proto_msgTypes[4]
+	mi := &file_notification_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -326,7 +806,7 @@ func (x *SellerCompletedAnOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SellerCompletedAnOrderRequest.ProtoReflect.Descriptor instead.
 func (*SellerCompletedAnOrderRequest) Descriptor() ([]byte, []int) {
-	return file_notification_proto_rawDescGZIP(), []int{4}
+	return file_notification_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *SellerCompletedAnOrderRequest) GetReceiverEmail() string {
@@ -364,6 +844,13 @@ func (x *SellerCompletedAnOrderRequest) GetUrl() string {
 	return ""
 }
 
+func (x *SellerCompletedAnOrderRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
 type SellerDeadlineExtensionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -371,12 +858,13 @@ type SellerDeadlineExtensionRequest struct {
 
 	ReceiverEmail string `protobuf:"bytes,1,opt,name=receiverEmail,proto3" json:"receiverEmail,omitempty"`
 	Url           string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Locale        string `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`
 }
 
 func (x *SellerDeadlineExtensionRequest) Reset() {
 	*x = SellerDeadlineExtensionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_notification_proto_msgTypes[5]
+		mi := &file_notification_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -389,7 +877,7 @@ func (x *SellerDeadlineExtensionRequest) String() string {
 func (*SellerDeadlineExtensionRequest) ProtoMessage() {}
 
 func (x *SellerDeadlineExtensionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_proto_msgTypes[5]
+	mi := &file_notification_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -402,7 +890,7 @@ func (x *SellerDeadlineExtensionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SellerDeadlineExtensionRequest.ProtoReflect.Descriptor instead.
 func (*SellerDeadlineExtensionRequest) Descriptor() ([]byte, []int) {
-	return file_notification_proto_rawDescGZIP(), []int{5}
+	return file_notification_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *SellerDeadlineExtensionRequest) GetReceiverEmail() string {
@@ -419,6 +907,13 @@ func (x *SellerDeadlineExtensionRequest) GetUrl() string {
 	return ""
 }
 
+func (x *SellerDeadlineExtensionRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
 type SellerCancelOrderRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -426,12 +921,13 @@ type SellerCancelOrderRequest struct {
 
 	ReceiverEmail string `protobuf:"bytes,1,opt,name=receiverEmail,proto3" json:"receiverEmail,omitempty"`
 	Url           string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Locale        string `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`
 }
 
 func (x *SellerCancelOrderRequest) Reset() {
 	*x = SellerCancelOrderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_notification_proto_msgTypes[6]
+		mi := &file_notification_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -444,7 +940,7 @@ func (x *SellerCancelOrderRequest) String() string {
 func (*SellerCancelOrderRequest) ProtoMessage() {}
 
 func (x *SellerCancelOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_proto_msgTypes[6]
+	mi := &file_notification_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -457,7 +953,7 @@ func (x *SellerCancelOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SellerCancelOrderRequest.ProtoReflect.Descriptor instead.
 func (*SellerCancelOrderRequest) Descriptor() ([]byte, []int) {
-	return file_notification_proto_rawDescGZIP(), []int{6}
+	return file_notification_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *SellerCancelOrderRequest) GetReceiverEmail() string {
@@ -474,6 +970,13 @@ func (x *SellerCancelOrderRequest) GetUrl() string {
 	return ""
 }
 
+func (x *SellerCancelOrderRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
 type BuyerDeadlineExtension struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -481,12 +984,13 @@ type BuyerDeadlineExtension struct {
 
 	ReceiverEmail string `protobuf:"bytes,1,opt,name=receiverEmail,proto3" json:"receiverEmail,omitempty"`
 	Url           string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Locale        string `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`
 }
 
 func (x *BuyerDeadlineExtension) Reset() {
 	*x = BuyerDeadlineExtension{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_notification_proto_msgTypes[7]
+		mi := &file_notification_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -499,7 +1003,7 @@ func (x *BuyerDeadlineExtension) String() string {
 func (*BuyerDeadlineExtension) ProtoMessage() {}
 
 func (x *BuyerDeadlineExtension) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_proto_msgTypes[7]
+	mi := &file_notification_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -512,7 +1016,7 @@ func (x *BuyerDeadlineExtension) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuyerDeadlineExtension.ProtoReflect.Descriptor instead.
 func (*BuyerDeadlineExtension) Descriptor() ([]byte, []int) {
-	return file_notification_proto_rawDescGZIP(), []int{7}
+	return file_notification_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *BuyerDeadlineExtension) GetReceiverEmail() string {
@@ -529,6 +1033,13 @@ func (x *BuyerDeadlineExtension) GetUrl() string {
 	return ""
 }
 
+func (x *BuyerDeadlineExtension) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
 type BuyerRefundsOrderRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -536,12 +1047,13 @@ type BuyerRefundsOrderRequest struct {
 
 	ReceiverEmail string `protobuf:"bytes,1,opt,name=receiverEmail,proto3" json:"receiverEmail,omitempty"`
 	Url           string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Locale        string `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`
 }
 
 func (x *BuyerRefundsOrderRequest) Reset() {
 	*x = BuyerRefundsOrderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_notification_proto_msgTypes[8]
+		mi := &file_notification_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -554,7 +1066,7 @@ func (x *BuyerRefundsOrderRequest) String() string {
 func (*BuyerRefundsOrderRequest) ProtoMessage() {}
 
 func (x *BuyerRefundsOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_proto_msgTypes[8]
+	mi := &file_notification_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -567,7 +1079,7 @@ func (x *BuyerRefundsOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BuyerRefundsOrderRequest.ProtoReflect.Descriptor instead.
 func (*BuyerRefundsOrderRequest) Descriptor() ([]byte, []int) {
-	return file_notification_proto_rawDescGZIP(), []int{8}
+	return file_notification_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *BuyerRefundsOrderRequest) GetReceiverEmail() string {
@@ -584,6 +1096,13 @@ func (x *BuyerRefundsOrderRequest) GetUrl() string {
 	return ""
 }
 
+func (x *BuyerRefundsOrderRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
 type OrderDetail struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -599,7 +1118,7 @@ type OrderDetail struct {
 func (x *OrderDetail) Reset() {
 	*x = OrderDetail{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_notification_proto_msgTypes[9]
+		mi := &file_notification_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -612,7 +1131,7 @@ func (x *OrderDetail) String() string {
 func (*OrderDetail) ProtoMessage() {}
 
 func (x *OrderDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_proto_msgTypes[9]
+	mi := &file_notification_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -625,7 +1144,7 @@ func (x *OrderDetail) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OrderDetail.ProtoReflect.Descriptor instead.
 func (*OrderDetail) Descriptor() ([]byte, []int) {
-	return file_notification_proto_rawDescGZIP(), []int{9}
+	return file_notification_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *OrderDetail) GetGigTitle() string {
@@ -671,12 +1190,13 @@ type NotifySellerGotAnOrderRequest struct {
 	ReceiverEmail string       `protobuf:"bytes,1,opt,name=receiverEmail,proto3" json:"receiverEmail,omitempty"`
 	Message       string       `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	Detail        *OrderDetail `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
+	Locale        string       `protobuf:"bytes,4,opt,name=locale,proto3" json:"locale,omitempty"`
 }
 
 func (x *NotifySellerGotAnOrderRequest) Reset() {
 	*x = NotifySellerGotAnOrderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_notification_proto_msgTypes[10]
+		mi := &file_notification_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -689,7 +1209,7 @@ func (x *NotifySellerGotAnOrderRequest) String() string {
 func (*NotifySellerGotAnOrderRequest) ProtoMessage() {}
 
 func (x *NotifySellerGotAnOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_proto_msgTypes[10]
+	mi := &file_notification_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -702,7 +1222,7 @@ func (x *NotifySellerGotAnOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotifySellerGotAnOrderRequest.ProtoReflect.Descriptor instead.
 func (*NotifySellerGotAnOrderRequest) Descriptor() ([]byte, []int) {
-	return file_notification_proto_rawDescGZIP(), []int{10}
+	return file_notification_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *NotifySellerGotAnOrderRequest) GetReceiverEmail() string {
@@ -726,6 +1246,13 @@ func (x *NotifySellerGotAnOrderRequest) GetDetail() *OrderDetail {
 	return nil
 }
 
+func (x *NotifySellerGotAnOrderRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
 type NotifySellerGotAReviewRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -733,12 +1260,13 @@ type NotifySellerGotAReviewRequest struct {
 
 	ReceiverEmail string `protobuf:"bytes,1,opt,name=receiverEmail,proto3" json:"receiverEmail,omitempty"`
 	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Locale        string `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`
 }
 
 func (x *NotifySellerGotAReviewRequest) Reset() {
 	*x = NotifySellerGotAReviewRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_notification_proto_msgTypes[11]
+		mi := &file_notification_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -751,7 +1279,7 @@ func (x *NotifySellerGotAReviewRequest) String() string {
 func (*NotifySellerGotAReviewRequest) ProtoMessage() {}
 
 func (x *NotifySellerGotAReviewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_proto_msgTypes[11]
+	mi := &file_notification_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -764,7 +1292,7 @@ func (x *NotifySellerGotAReviewRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotifySellerGotAReviewRequest.ProtoReflect.Descriptor instead.
 func (*NotifySellerGotAReviewRequest) Descriptor() ([]byte, []int) {
-	return file_notification_proto_rawDescGZIP(), []int{11}
+	return file_notification_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *NotifySellerGotAReviewRequest) GetReceiverEmail() string {
@@ -781,6 +1309,13 @@ func (x *NotifySellerGotAReviewRequest) GetMessage() string {
 	return ""
 }
 
+func (x *NotifySellerGotAReviewRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
 type NotifyBuyerOrderDeliveredRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -788,12 +1323,13 @@ type NotifyBuyerOrderDeliveredRequest struct {
 
 	ReceiverEmail string `protobuf:"bytes,1,opt,name=receiverEmail,proto3" json:"receiverEmail,omitempty"`
 	Url           string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Locale        string `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`
 }
 
 func (x *NotifyBuyerOrderDeliveredRequest) Reset() {
 	*x = NotifyBuyerOrderDeliveredRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_notification_proto_msgTypes[12]
+		mi := &file_notification_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -806,7 +1342,7 @@ func (x *NotifyBuyerOrderDeliveredRequest) String() string {
 func (*NotifyBuyerOrderDeliveredRequest) ProtoMessage() {}
 
 func (x *NotifyBuyerOrderDeliveredRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_proto_msgTypes[12]
+	mi := &file_notification_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -819,7 +1355,7 @@ func (x *NotifyBuyerOrderDeliveredRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotifyBuyerOrderDeliveredRequest.ProtoReflect.Descriptor instead.
 func (*NotifyBuyerOrderDeliveredRequest) Descriptor() ([]byte, []int) {
-	return file_notification_proto_rawDescGZIP(), []int{12}
+	return file_notification_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *NotifyBuyerOrderDeliveredRequest) GetReceiverEmail() string {
@@ -836,6 +1372,13 @@ func (x *NotifyBuyerOrderDeliveredRequest) GetUrl() string {
 	return ""
 }
 
+func (x *NotifyBuyerOrderDeliveredRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
 type NotifyBuyerOrderAcknowledgeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -843,12 +1386,13 @@ type NotifyBuyerOrderAcknowledgeRequest struct {
 
 	ReceiverEmail string `protobuf:"bytes,1,opt,name=receiverEmail,proto3" json:"receiverEmail,omitempty"`
 	Url           string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Locale        string `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`
 }
 
 func (x *NotifyBuyerOrderAcknowledgeRequest) Reset() {
 	*x = NotifyBuyerOrderAcknowledgeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_notification_proto_msgTypes[13]
+		mi := &file_notification_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -861,7 +1405,7 @@ func (x *NotifyBuyerOrderAcknowledgeRequest) String() string {
 func (*NotifyBuyerOrderAcknowledgeRequest) ProtoMessage() {}
 
 func (x *NotifyBuyerOrderAcknowledgeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_proto_msgTypes[13]
+	mi := &file_notification_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -874,7 +1418,7 @@ func (x *NotifyBuyerOrderAcknowledgeRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use NotifyBuyerOrderAcknowledgeRequest.ProtoReflect.Descriptor instead.
 func (*NotifyBuyerOrderAcknowledgeRequest) Descriptor() ([]byte, []int) {
-	return file_notification_proto_rawDescGZIP(), []int{13}
+	return file_notification_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *NotifyBuyerOrderAcknowledgeRequest) GetReceiverEmail() string {
@@ -891,18 +1435,26 @@ func (x *NotifyBuyerOrderAcknowledgeRequest) GetUrl() string {
 	return ""
 }
 
+func (x *NotifyBuyerOrderAcknowledgeRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
 type NotifySellerBuyerResponseDeliveredOrderRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	ReceiverEmail string `protobuf:"bytes,1,opt,name=receiverEmail,proto3" json:"receiverEmail,omitempty"`
+	Locale        string `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
 }
 
 func (x *NotifySellerBuyerResponseDeliveredOrderRequest) Reset() {
 	*x = NotifySellerBuyerResponseDeliveredOrderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_notification_proto_msgTypes[14]
+		mi := &file_notification_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -915,7 +1467,7 @@ func (x *NotifySellerBuyerResponseDeliveredOrderRequest) String() string {
 func (*NotifySellerBuyerResponseDeliveredOrderRequest) ProtoMessage() {}
 
 func (x *NotifySellerBuyerResponseDeliveredOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notification_proto_msgTypes[14]
+	mi := &file_notification_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -928,7 +1480,7 @@ func (x *NotifySellerBuyerResponseDeliveredOrderRequest) ProtoReflect() protoref
 
 // Deprecated: Use NotifySellerBuyerResponseDeliveredOrderRequest.ProtoReflect.Descriptor instead.
 func (*NotifySellerBuyerResponseDeliveredOrderRequest) Descriptor() ([]byte, []int) {
-	return file_notification_proto_rawDescGZIP(), []int{14}
+	return file_notification_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *NotifySellerBuyerResponseDeliveredOrderRequest) GetReceiverEmail() string {
@@ -938,6 +1490,13 @@ func (x *NotifySellerBuyerResponseDeliveredOrderRequest) GetReceiverEmail() stri
 	return ""
 }
 
+func (x *NotifySellerBuyerResponseDeliveredOrderRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
 var File_notification_proto protoreflect.FileDescriptor
 
 var file_notification_proto_rawDesc = []byte{
@@ -946,201 +1505,316 @@ var file_notification_proto_rawDesc = []byte{
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
 	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0x89, 0x01, 0x0a, 0x15, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x69, 0x6e, 0x67,
-	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d,
-	0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x45, 0x6d, 0x61,
-	0x69, 0x6c, 0x12, 0x2a, 0x0a, 0x10, 0x68, 0x74, 0x6d, 0x6c, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
-	0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x68, 0x74,
-	0x6d, 0x6c, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e,
-	0x0a, 0x0a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4c, 0x69, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x4c, 0x69, 0x6e, 0x6b, 0x22, 0xa3,
-	0x01, 0x0a, 0x15, 0x46, 0x6f, 0x72, 0x67, 0x6f, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65,
-	0x69, 0x76, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x2a,
-	0x0a, 0x10, 0x68, 0x74, 0x6d, 0x6c, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x68, 0x74, 0x6d, 0x6c, 0x54, 0x65,
-	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
-	0x73, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
-	0x65, 0x73, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x8b, 0x01, 0x0a, 0x1b, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72,
-	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x63,
-	0x65, 0x69, 0x76, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x2a, 0x0a, 0x10, 0x68, 0x74,
-	0x6d, 0x6c, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x68, 0x74, 0x6d, 0x6c, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
-	0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61,
-	0x6d, 0x65, 0x22, 0x80, 0x01, 0x0a, 0x1c, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x68, 0x61, 0x74,
-	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x45,
-	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x65,
-	0x69, 0x76, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x6e,
-	0x64, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xc5, 0x01, 0x0a, 0x1d, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72,
-	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69,
-	0x76, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1e, 0x0a,
-	0x0a, 0x62, 0x75, 0x79, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x62, 0x75, 0x79, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x18, 0x0a,
-	0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x14, 0x73, 0x65, 0x6c, 0x6c, 0x65,
-	0x72, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x43, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75,
-	0x72, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x58, 0x0a,
-	0x1e, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x45,
-	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x24, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72,
-	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x52, 0x0a, 0x18, 0x53, 0x65, 0x6c, 0x6c, 0x65,
-	0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x45,
-	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x65,
-	0x69, 0x76, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x50, 0x0a, 0x16, 0x42,
-	0x75, 0x79, 0x65, 0x72, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x78, 0x74, 0x65,
-	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x45, 0x0a, 0x15, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12,
+	0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22, 0xce, 0x01, 0x0a, 0x11, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x72, 0x65, 0x63,
+	0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x69,
+	0x70, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x31, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x07, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x71, 0x0a, 0x0c, 0x57, 0x65, 0x62, 0x68,
+	0x6f, 0x6f, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2b,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xa1, 0x01, 0x0a, 0x15,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x69, 0x6e, 0x67, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
 	0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65,
-	0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x75,
-	0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x52, 0x0a,
-	0x18, 0x42, 0x75, 0x79, 0x65, 0x72, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x63,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x2a, 0x0a, 0x10, 0x68,
+	0x74, 0x6d, 0x6c, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x68, 0x74, 0x6d, 0x6c, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x4c, 0x69, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22,
+	0xbb, 0x01, 0x0a, 0x15, 0x46, 0x6f, 0x72, 0x67, 0x6f, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x63,
 	0x65, 0x69, 0x76, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12,
-	0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
-	0x6c, 0x22, 0xbf, 0x01, 0x0a, 0x0b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69,
-	0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x69, 0x67, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x67, 0x69, 0x67, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x26, 0x0a,
-	0x0e, 0x67, 0x69, 0x67, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x67, 0x69, 0x67, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x64,
-	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c,
-	0x69, 0x6e, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x1d, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x65,
-	0x6c, 0x6c, 0x65, 0x72, 0x47, 0x6f, 0x74, 0x41, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
-	0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65,
-	0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x65, 0x74,
-	0x61, 0x69, 0x6c, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x22, 0x5f, 0x0a, 0x1d, 0x4e,
-	0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x47, 0x6f, 0x74, 0x41, 0x52,
-	0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d,
-	0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x45, 0x6d, 0x61,
-	0x69, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5a, 0x0a, 0x20,
-	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x42, 0x75, 0x79, 0x65, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2a, 0x0a, 0x10, 0x68, 0x74, 0x6d, 0x6c, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x68, 0x74, 0x6d, 0x6c, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72,
+	0x65, 0x73, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x72, 0x65, 0x73, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22, 0xa3, 0x01,
+	0x0a, 0x1b, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a,
+	0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x45, 0x6d,
+	0x61, 0x69, 0x6c, 0x12, 0x2a, 0x0a, 0x10, 0x68, 0x74, 0x6d, 0x6c, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x68,
+	0x74, 0x6d, 0x6c, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x65, 0x22, 0xde, 0x01, 0x0a, 0x1c, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x68, 0x61,
+	0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72,
+	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x65, 0x22, 0xcf, 0x01, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x74, 0x44, 0x69, 0x67,
+	0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x7a, 0x0a, 0x0a, 0x43, 0x68, 0x61, 0x74, 0x44, 0x69,
+	0x67, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72,
+	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x2e, 0x0a, 0x08, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x43,
+	0x68, 0x61, 0x74, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x65, 0x22, 0xdd, 0x01, 0x0a, 0x1d, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x43, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72,
+	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75,
+	0x79, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x62, 0x75, 0x79, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x14, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x43, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x14, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x65, 0x22, 0x70, 0x0a, 0x1e, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x44, 0x65, 0x61, 0x64,
+	0x6c, 0x69, 0x6e, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72,
+	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x65, 0x22, 0x6a, 0x0a, 0x18, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69,
 	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
 	0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x5c, 0x0a, 0x22, 0x4e, 0x6f, 0x74, 0x69,
-	0x66, 0x79, 0x42, 0x75, 0x79, 0x65, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x41, 0x63, 0x6b, 0x6e,
-	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24,
-	0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x45,
-	0x6d, 0x61, 0x69, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x56, 0x0a, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79,
-	0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x42, 0x75, 0x79, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65,
-	0x69, 0x76, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x32, 0xd7,
-	0x09, 0x0a, 0x13, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x46, 0x0a, 0x12, 0x55, 0x73, 0x65, 0x72, 0x56, 0x65,
-	0x72, 0x69, 0x66, 0x79, 0x69, 0x6e, 0x67, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x16, 0x2e, 0x56,
-	0x65, 0x72, 0x69, 0x66, 0x79, 0x69, 0x6e, 0x67, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x46,
-	0x0a, 0x12, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x72, 0x67, 0x6f, 0x74, 0x50, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x12, 0x16, 0x2e, 0x46, 0x6f, 0x72, 0x67, 0x6f, 0x74, 0x50, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x17, 0x55, 0x73, 0x65, 0x72, 0x53, 0x75,
-	0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x12, 0x1c, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x65, 0x74,
-	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x19, 0x53, 0x65, 0x6e,
-	0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x68, 0x61, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x68,
-	0x61, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
-	0x55, 0x0a, 0x19, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x48, 0x61, 0x73, 0x43, 0x6f, 0x6d, 0x70,
-	0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x53,
-	0x65, 0x6c, 0x6c, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x6e,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65,
+	0x22, 0x68, 0x0a, 0x16, 0x42, 0x75, 0x79, 0x65, 0x72, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e,
+	0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c,
+	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
+	0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22, 0x6a, 0x0a, 0x18, 0x42, 0x75,
+	0x79, 0x65, 0x72, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x16,
+	0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22, 0xbf, 0x01, 0x0a, 0x0b, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x69, 0x67, 0x54, 0x69, 0x74,
+	0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x67, 0x69, 0x67, 0x54, 0x69, 0x74,
+	0x6c, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x67, 0x69, 0x67, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x67, 0x69, 0x67, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x46, 0x65, 0x65,
+	0x12, 0x36, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08,
+	0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x9d, 0x01, 0x0a, 0x1d, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x79, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x47, 0x6f, 0x74, 0x41, 0x6e, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x64, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22, 0x77, 0x0a, 0x1d, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x79, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x47, 0x6f, 0x74, 0x41, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x65, 0x22, 0x72, 0x0a, 0x20, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x42, 0x75, 0x79, 0x65, 0x72,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a,
+	0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22, 0x74, 0x0a, 0x22, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x42,
+	0x75, 0x79, 0x65, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x22, 0x6e, 0x0a, 0x2e, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x42, 0x75, 0x79, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65,
+	0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a,
+	0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x45, 0x6d,
+	0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x2a, 0x9e, 0x04, 0x0a, 0x15,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x4f, 0x54, 0x49, 0x46, 0x49, 0x43,
+	0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x55, 0x53, 0x45,
+	0x52, 0x5f, 0x56, 0x45, 0x52, 0x49, 0x46, 0x59, 0x49, 0x4e, 0x47, 0x5f, 0x45, 0x4d, 0x41, 0x49,
+	0x4c, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x46, 0x4f, 0x52, 0x47,
+	0x4f, 0x54, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x02, 0x12, 0x1f, 0x0a,
+	0x1b, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x52, 0x45,
+	0x53, 0x45, 0x54, 0x5f, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x03, 0x12, 0x10,
+	0x0a, 0x0c, 0x43, 0x48, 0x41, 0x54, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x10, 0x04,
+	0x12, 0x1a, 0x0a, 0x16, 0x53, 0x45, 0x4c, 0x4c, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c,
+	0x45, 0x54, 0x45, 0x44, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x10, 0x05, 0x12, 0x25, 0x0a, 0x21,
+	0x53, 0x45, 0x4c, 0x4c, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x44,
+	0x45, 0x41, 0x44, 0x4c, 0x49, 0x4e, 0x45, 0x5f, 0x45, 0x58, 0x54, 0x45, 0x4e, 0x53, 0x49, 0x4f,
+	0x4e, 0x10, 0x06, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x45, 0x4c, 0x4c, 0x45, 0x52, 0x5f, 0x43, 0x41,
+	0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x10, 0x07, 0x12, 0x25,
+	0x0a, 0x21, 0x42, 0x55, 0x59, 0x45, 0x52, 0x5f, 0x44, 0x45, 0x41, 0x44, 0x4c, 0x49, 0x4e, 0x45,
+	0x5f, 0x45, 0x58, 0x54, 0x45, 0x4e, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f,
+	0x4e, 0x53, 0x45, 0x10, 0x08, 0x12, 0x17, 0x0a, 0x13, 0x42, 0x55, 0x59, 0x45, 0x52, 0x5f, 0x52,
+	0x45, 0x46, 0x55, 0x4e, 0x44, 0x53, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x10, 0x09, 0x12, 0x17,
+	0x0a, 0x13, 0x53, 0x45, 0x4c, 0x4c, 0x45, 0x52, 0x5f, 0x47, 0x4f, 0x54, 0x5f, 0x41, 0x4e, 0x5f,
+	0x4f, 0x52, 0x44, 0x45, 0x52, 0x10, 0x0a, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x45, 0x4c, 0x4c, 0x45,
+	0x52, 0x5f, 0x47, 0x4f, 0x54, 0x5f, 0x41, 0x5f, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x10, 0x0b,
+	0x12, 0x19, 0x0a, 0x15, 0x42, 0x55, 0x59, 0x45, 0x52, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f,
+	0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x45, 0x44, 0x10, 0x0c, 0x12, 0x1c, 0x0a, 0x18, 0x42,
+	0x55, 0x59, 0x45, 0x52, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x41, 0x43, 0x4b, 0x4e, 0x4f,
+	0x57, 0x4c, 0x45, 0x44, 0x47, 0x45, 0x44, 0x10, 0x0d, 0x12, 0x23, 0x0a, 0x1f, 0x53, 0x45, 0x4c,
+	0x4c, 0x45, 0x52, 0x5f, 0x42, 0x55, 0x59, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e,
+	0x44, 0x45, 0x44, 0x5f, 0x44, 0x45, 0x4c, 0x49, 0x56, 0x45, 0x52, 0x59, 0x10, 0x0e, 0x12, 0x16,
+	0x0a, 0x12, 0x53, 0x45, 0x4c, 0x4c, 0x45, 0x52, 0x5f, 0x50, 0x41, 0x59, 0x4f, 0x55, 0x54, 0x5f,
+	0x50, 0x41, 0x49, 0x44, 0x10, 0x0f, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x45, 0x4c, 0x4c, 0x45, 0x52,
+	0x5f, 0x50, 0x41, 0x59, 0x4f, 0x55, 0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x10,
+	0x12, 0x1a, 0x0a, 0x16, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x45, 0x58,
+	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59, 0x10, 0x11, 0x32, 0xd0, 0x0a, 0x0a,
+	0x13, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12,
+	0x12, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3e, 0x0a,
+	0x13, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x0d, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x46, 0x0a,
+	0x12, 0x55, 0x73, 0x65, 0x72, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x69, 0x6e, 0x67, 0x45, 0x6d,
+	0x61, 0x69, 0x6c, 0x12, 0x16, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x69, 0x6e, 0x67, 0x45,
+	0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x12, 0x55, 0x73, 0x65, 0x72, 0x46, 0x6f, 0x72,
+	0x67, 0x6f, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x16, 0x2e, 0x46, 0x6f,
+	0x72, 0x67, 0x6f, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x51, 0x0a,
+	0x17, 0x55, 0x73, 0x65, 0x72, 0x53, 0x75, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1c, 0x2e, 0x53, 0x75, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
+	0x12, 0x54, 0x0a, 0x19, 0x53, 0x65, 0x6e, 0x64, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x68, 0x61,
+	0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e,
+	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x68, 0x61, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x1e, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x45,
-	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x53, 0x65, 0x6c, 0x6c, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x19, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72,
+	0x48, 0x61, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x6e, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5b, 0x0a,
+	0x1e, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x65,
+	0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x1f, 0x2e, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65,
+	0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x15, 0x53, 0x65,
+	0x6c, 0x6c, 0x65, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x65, 0x64, 0x41, 0x6e, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x1e, 0x42, 0x75, 0x79, 0x65,
 	0x72, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x2e, 0x42, 0x75, 0x79,
+	0x65, 0x72, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4a, 0x0a,
+	0x13, 0x42, 0x75, 0x79, 0x65, 0x72, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x41, 0x6e, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x42, 0x75, 0x79, 0x65, 0x72, 0x52, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x73, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x1c, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x79, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x48, 0x61,
+	0x73, 0x42, 0x65, 0x65, 0x6e, 0x4d, 0x61, 0x64, 0x65, 0x12, 0x1e, 0x2e, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x79, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x47, 0x6f, 0x74, 0x41, 0x6e, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x15, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x43, 0x61, 0x6e,
-	0x63, 0x65, 0x6c, 0x65, 0x64, 0x41, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x53,
-	0x65, 0x6c, 0x6c, 0x65, 0x72, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x00, 0x12, 0x53, 0x0a, 0x1e, 0x42, 0x75, 0x79, 0x65, 0x72, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69,
-	0x6e, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x17, 0x2e, 0x42, 0x75, 0x79, 0x65, 0x72, 0x44, 0x65, 0x61, 0x64, 0x6c,
-	0x69, 0x6e, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x13, 0x42, 0x75, 0x79, 0x65, 0x72, 0x52,
-	0x65, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x41, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x19, 0x2e,
-	0x42, 0x75, 0x79, 0x65, 0x72, 0x52, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x73, 0x4f, 0x72, 0x64, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x00, 0x12, 0x58, 0x0a, 0x1c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x65, 0x6c, 0x6c,
-	0x65, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x48, 0x61, 0x73, 0x42, 0x65, 0x65, 0x6e, 0x4d, 0x61,
-	0x64, 0x65, 0x12, 0x1e, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x65, 0x6c, 0x6c, 0x65,
-	0x72, 0x47, 0x6f, 0x74, 0x41, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x16,
+	0x79, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x16, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x65, 0x6c,
+	0x6c, 0x65, 0x72, 0x47, 0x6f, 0x74, 0x41, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x1e, 0x2e,
 	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x47, 0x6f, 0x74, 0x41,
-	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x1e, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53,
-	0x65, 0x6c, 0x6c, 0x65, 0x72, 0x47, 0x6f, 0x74, 0x41, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
-	0x12, 0x5e, 0x0a, 0x1f, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x42, 0x75, 0x79, 0x65, 0x72, 0x53,
-	0x65, 0x6c, 0x6c, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x42, 0x75, 0x79, 0x65,
-	0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
-	0x12, 0x60, 0x0a, 0x1f, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x42, 0x75, 0x79, 0x65, 0x72, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x48, 0x61, 0x73, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64,
-	0x67, 0x65, 0x64, 0x12, 0x23, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x42, 0x75, 0x79, 0x65,
-	0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x41, 0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x00, 0x12, 0x74, 0x0a, 0x27, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x65, 0x6c, 0x6c,
-	0x65, 0x72, 0x42, 0x75, 0x79, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44,
-	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x2f, 0x2e,
-	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x42, 0x75, 0x79, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
-	0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x42, 0x2a, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x6b, 0x69, 0x68, 0x69, 0x72, 0x61, 0x37, 0x37,
-	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x1f, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x79, 0x42, 0x75, 0x79, 0x65, 0x72, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x79, 0x42, 0x75, 0x79, 0x65, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x1f, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x79, 0x42, 0x75, 0x79, 0x65, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x48, 0x61, 0x73, 0x41, 0x63,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x64, 0x12, 0x23, 0x2e, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x79, 0x42, 0x75, 0x79, 0x65, 0x72, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x41, 0x63, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x74, 0x0a, 0x27, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x79, 0x53, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x42, 0x75, 0x79, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x12, 0x2f, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x65, 0x6c,
+	0x6c, 0x65, 0x72, 0x42, 0x75, 0x79, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x42,
+	0x2a, 0x5a, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x6b,
+	0x69, 0x68, 0x69, 0x72, 0x61, 0x37, 0x37, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x6e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1155,62 +1829,80 @@ func file_notification_proto_rawDescGZIP() []byte {
 	return file_notification_proto_rawDescData
 }
 
-var file_notification_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_notification_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_notification_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_notification_proto_goTypes = []any{
-	(*VerifyingEmailRequest)(nil),                          // 0: VerifyingEmailRequest
-	(*ForgotPasswordRequest)(nil),                          // 1: ForgotPasswordRequest
-	(*SuccessResetPasswordRequest)(nil),                    // 2: SuccessResetPasswordRequest
-	(*EmailChatNotificationRequest)(nil),                   // 3: EmailChatNotificationRequest
-	(*SellerCompletedAnOrderRequest)(nil),                  // 4: SellerCompletedAnOrderRequest
-	(*SellerDeadlineExtensionRequest)(nil),                 // 5: SellerDeadlineExtensionRequest
-	(*SellerCancelOrderRequest)(nil),                       // 6: SellerCancelOrderRequest
-	(*BuyerDeadlineExtension)(nil),                         // 7: BuyerDeadlineExtension
-	(*BuyerRefundsOrderRequest)(nil),                       // 8: BuyerRefundsOrderRequest
-	(*OrderDetail)(nil),                                    // 9: OrderDetail
-	(*NotifySellerGotAnOrderRequest)(nil),                  // 10: NotifySellerGotAnOrderRequest
-	(*NotifySellerGotAReviewRequest)(nil),                  // 11: NotifySellerGotAReviewRequest
-	(*NotifyBuyerOrderDeliveredRequest)(nil),               // 12: NotifyBuyerOrderDeliveredRequest
-	(*NotifyBuyerOrderAcknowledgeRequest)(nil),             // 13: NotifyBuyerOrderAcknowledgeRequest
-	(*NotifySellerBuyerResponseDeliveredOrderRequest)(nil), // 14: NotifySellerBuyerResponseDeliveredOrderRequest
-	(*timestamppb.Timestamp)(nil),                          // 15: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                                  // 16: google.protobuf.Empty
+	(NotificationEventType)(0),                             // 0: NotificationEventType
+	(*NotificationRecipient)(nil),                          // 1: NotificationRecipient
+	(*NotificationEvent)(nil),                              // 2: NotificationEvent
+	(*WebhookEvent)(nil),                                   // 3: WebhookEvent
+	(*VerifyingEmailRequest)(nil),                          // 4: VerifyingEmailRequest
+	(*ForgotPasswordRequest)(nil),                          // 5: ForgotPasswordRequest
+	(*SuccessResetPasswordRequest)(nil),                    // 6: SuccessResetPasswordRequest
+	(*EmailChatNotificationRequest)(nil),                   // 7: EmailChatNotificationRequest
+	(*ChatDigestMessage)(nil),                              // 8: ChatDigestMessage
+	(*ChatDigest)(nil),                                     // 9: ChatDigest
+	(*SellerCompletedAnOrderRequest)(nil),                  // 10: SellerCompletedAnOrderRequest
+	(*SellerDeadlineExtensionRequest)(nil),                 // 11: SellerDeadlineExtensionRequest
+	(*SellerCancelOrderRequest)(nil),                       // 12: SellerCancelOrderRequest
+	(*BuyerDeadlineExtension)(nil),                         // 13: BuyerDeadlineExtension
+	(*BuyerRefundsOrderRequest)(nil),                       // 14: BuyerRefundsOrderRequest
+	(*OrderDetail)(nil),                                    // 15: OrderDetail
+	(*NotifySellerGotAnOrderRequest)(nil),                  // 16: NotifySellerGotAnOrderRequest
+	(*NotifySellerGotAReviewRequest)(nil),                  // 17: NotifySellerGotAReviewRequest
+	(*NotifyBuyerOrderDeliveredRequest)(nil),               // 18: NotifyBuyerOrderDeliveredRequest
+	(*NotifyBuyerOrderAcknowledgeRequest)(nil),             // 19: NotifyBuyerOrderAcknowledgeRequest
+	(*NotifySellerBuyerResponseDeliveredOrderRequest)(nil), // 20: NotifySellerBuyerResponseDeliveredOrderRequest
+	(*structpb.Struct)(nil),                                // 21: google.protobuf.Struct
+	(*timestamppb.Timestamp)(nil),                          // 22: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                                  // 23: google.protobuf.Empty
 }
 var file_notification_proto_depIdxs = []int32{
-	15, // 0: OrderDetail.deadline:type_name -> google.protobuf.Timestamp
-	9,  // 1: NotifySellerGotAnOrderRequest.detail:type_name -> OrderDetail
-	0,  // 2: NotificationService.UserVerifyingEmail:input_type -> VerifyingEmailRequest
-	1,  // 3: NotificationService.UserForgotPassword:input_type -> ForgotPasswordRequest
-	2,  // 4: NotificationService.UserSucessResetPassword:input_type -> SuccessResetPasswordRequest
-	3,  // 5: NotificationService.SendEmailChatNotification:input_type -> EmailChatNotificationRequest
-	4,  // 6: NotificationService.SellerHasCompletedAnOrder:input_type -> SellerCompletedAnOrderRequest
-	5,  // 7: NotificationService.SellerRequestDeadlineExtension:input_type -> SellerDeadlineExtensionRequest
-	6,  // 8: NotificationService.SellerCanceledAnOrder:input_type -> SellerCancelOrderRequest
-	7,  // 9: NotificationService.BuyerDeadlineExtensionResponse:input_type -> BuyerDeadlineExtension
-	8,  // 10: NotificationService.BuyerRefundsAnOrder:input_type -> BuyerRefundsOrderRequest
-	10, // 11: NotificationService.NotifySellerOrderHasBeenMade:input_type -> NotifySellerGotAnOrderRequest
-	11, // 12: NotificationService.NotifySellerGotAReview:input_type -> NotifySellerGotAReviewRequest
-	12, // 13: NotificationService.NotifyBuyerSellerDeliveredOrder:input_type -> NotifyBuyerOrderDeliveredRequest
-	13, // 14: NotificationService.NotifyBuyerOrderHasAcknowledged:input_type -> NotifyBuyerOrderAcknowledgeRequest
-	14, // 15: NotificationService.NotifySellerBuyerResponseDeliveredOrder:input_type -> NotifySellerBuyerResponseDeliveredOrderRequest
-	16, // 16: NotificationService.UserVerifyingEmail:output_type -> google.protobuf.Empty
-	16, // 17: NotificationService.UserForgotPassword:output_type -> google.protobuf.Empty
-	16, // 18: NotificationService.UserSucessResetPassword:output_type -> google.protobuf.Empty
-	16, // 19: NotificationService.SendEmailChatNotification:output_type -> google.protobuf.Empty
-	16, // 20: NotificationService.SellerHasCompletedAnOrder:output_type -> google.protobuf.Empty
-	16, // 21: NotificationService.SellerRequestDeadlineExtension:output_type -> google.protobuf.Empty
-	16, // 22: NotificationService.SellerCanceledAnOrder:output_type -> google.protobuf.Empty
-	16, // 23: NotificationService.BuyerDeadlineExtensionResponse:output_type -> google.protobuf.Empty
-	16, // 24: NotificationService.BuyerRefundsAnOrder:output_type -> google.protobuf.Empty
-	16, // 25: NotificationService.NotifySellerOrderHasBeenMade:output_type -> google.protobuf.Empty
-	16, // 26: NotificationService.NotifySellerGotAReview:output_type -> google.protobuf.Empty
-	16, // 27: NotificationService.NotifyBuyerSellerDeliveredOrder:output_type -> google.protobuf.Empty
-	16, // 28: NotificationService.NotifyBuyerOrderHasAcknowledged:output_type -> google.protobuf.Empty
-	16, // 29: NotificationService.NotifySellerBuyerResponseDeliveredOrder:output_type -> google.protobuf.Empty
-	16, // [16:30] is the sub-list for method output_type
-	2,  // [2:16] is the sub-list for method input_type
-	2,  // [2:2] is the sub-list for extension type_name
-	2,  // [2:2] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
+	0,  // 0: NotificationEvent.type:type_name -> NotificationEventType
+	1,  // 1: NotificationEvent.recipients:type_name -> NotificationRecipient
+	21, // 2: NotificationEvent.payload:type_name -> google.protobuf.Struct
+	21, // 3: WebhookEvent.data:type_name -> google.protobuf.Struct
+	22, // 4: ChatDigestMessage.createdAt:type_name -> google.protobuf.Timestamp
+	8,  // 5: ChatDigest.messages:type_name -> ChatDigestMessage
+	22, // 6: OrderDetail.deadline:type_name -> google.protobuf.Timestamp
+	15, // 7: NotifySellerGotAnOrderRequest.detail:type_name -> OrderDetail
+	2,  // 8: NotificationService.Publish:input_type -> NotificationEvent
+	3,  // 9: NotificationService.PublishWebhookEvent:input_type -> WebhookEvent
+	4,  // 10: NotificationService.UserVerifyingEmail:input_type -> VerifyingEmailRequest
+	5,  // 11: NotificationService.UserForgotPassword:input_type -> ForgotPasswordRequest
+	6,  // 12: NotificationService.UserSucessResetPassword:input_type -> SuccessResetPasswordRequest
+	7,  // 13: NotificationService.SendEmailChatNotification:input_type -> EmailChatNotificationRequest
+	10, // 14: NotificationService.SellerHasCompletedAnOrder:input_type -> SellerCompletedAnOrderRequest
+	11, // 15: NotificationService.SellerRequestDeadlineExtension:input_type -> SellerDeadlineExtensionRequest
+	12, // 16: NotificationService.SellerCanceledAnOrder:input_type -> SellerCancelOrderRequest
+	13, // 17: NotificationService.BuyerDeadlineExtensionResponse:input_type -> BuyerDeadlineExtension
+	14, // 18: NotificationService.BuyerRefundsAnOrder:input_type -> BuyerRefundsOrderRequest
+	16, // 19: NotificationService.NotifySellerOrderHasBeenMade:input_type -> NotifySellerGotAnOrderRequest
+	17, // 20: NotificationService.NotifySellerGotAReview:input_type -> NotifySellerGotAReviewRequest
+	18, // 21: NotificationService.NotifyBuyerSellerDeliveredOrder:input_type -> NotifyBuyerOrderDeliveredRequest
+	19, // 22: NotificationService.NotifyBuyerOrderHasAcknowledged:input_type -> NotifyBuyerOrderAcknowledgeRequest
+	20, // 23: NotificationService.NotifySellerBuyerResponseDeliveredOrder:input_type -> NotifySellerBuyerResponseDeliveredOrderRequest
+	23, // 24: NotificationService.Publish:output_type -> google.protobuf.Empty
+	23, // 25: NotificationService.PublishWebhookEvent:output_type -> google.protobuf.Empty
+	23, // 26: NotificationService.UserVerifyingEmail:output_type -> google.protobuf.Empty
+	23, // 27: NotificationService.UserForgotPassword:output_type -> google.protobuf.Empty
+	23, // 28: NotificationService.UserSucessResetPassword:output_type -> google.protobuf.Empty
+	23, // 29: NotificationService.SendEmailChatNotification:output_type -> google.protobuf.Empty
+	23, // 30: NotificationService.SellerHasCompletedAnOrder:output_type -> google.protobuf.Empty
+	23, // 31: NotificationService.SellerRequestDeadlineExtension:output_type -> google.protobuf.Empty
+	23, // 32: NotificationService.SellerCanceledAnOrder:output_type -> google.protobuf.Empty
+	23, // 33: NotificationService.BuyerDeadlineExtensionResponse:output_type -> google.protobuf.Empty
+	23, // 34: NotificationService.BuyerRefundsAnOrder:output_type -> google.protobuf.Empty
+	23, // 35: NotificationService.NotifySellerOrderHasBeenMade:output_type -> google.protobuf.Empty
+	23, // 36: NotificationService.NotifySellerGotAReview:output_type -> google.protobuf.Empty
+	23, // 37: NotificationService.NotifyBuyerSellerDeliveredOrder:output_type -> google.protobuf.Empty
+	23, // 38: NotificationService.NotifyBuyerOrderHasAcknowledged:output_type -> google.protobuf.Empty
+	23, // 39: NotificationService.NotifySellerBuyerResponseDeliveredOrder:output_type -> google.protobuf.Empty
+	24, // [24:40] is the sub-list for method output_type
+	8,  // [8:24] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_notification_proto_init() }
@@ -1220,7 +1912,7 @@ func file_notification_proto_init() {
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_notification_proto_msgTypes[0].Exporter = func(v any, i int) any {
-			switch v := v.(*VerifyingEmailRequest); i {
+			switch v := v.(*NotificationRecipient); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1232,7 +1924,7 @@ func file_notification_proto_init() {
 			}
 		}
 		file_notification_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*ForgotPasswordRequest); i {
+			switch v := v.(*NotificationEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1244,7 +1936,7 @@ func file_notification_proto_init() {
 			}
 		}
 		file_notification_proto_msgTypes[2].Exporter = func(v any, i int) any {
-			switch v := v.(*SuccessResetPasswordRequest); i {
+			switch v := v.(*WebhookEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1256,7 +1948,7 @@ func file_notification_proto_init() {
 			}
 		}
 		file_notification_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*EmailChatNotificationRequest); i {
+			switch v := v.(*VerifyingEmailRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1268,7 +1960,7 @@ func file_notification_proto_init() {
 			}
 		}
 		file_notification_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*SellerCompletedAnOrderRequest); i {
+			switch v := v.(*ForgotPasswordRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1280,7 +1972,7 @@ func file_notification_proto_init() {
 			}
 		}
 		file_notification_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*SellerDeadlineExtensionRequest); i {
+			switch v := v.(*SuccessResetPasswordRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1292,7 +1984,7 @@ func file_notification_proto_init() {
 			}
 		}
 		file_notification_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*SellerCancelOrderRequest); i {
+			switch v := v.(*EmailChatNotificationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1304,7 +1996,7 @@ func file_notification_proto_init() {
 			}
 		}
 		file_notification_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*BuyerDeadlineExtension); i {
+			switch v := v.(*ChatDigestMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1316,7 +2008,7 @@ func file_notification_proto_init() {
 			}
 		}
 		file_notification_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*BuyerRefundsOrderRequest); i {
+			switch v := v.(*ChatDigest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1328,7 +2020,7 @@ func file_notification_proto_init() {
 			}
 		}
 		file_notification_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*OrderDetail); i {
+			switch v := v.(*SellerCompletedAnOrderRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1340,7 +2032,7 @@ func file_notification_proto_init() {
 			}
 		}
 		file_notification_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*NotifySellerGotAnOrderRequest); i {
+			switch v := v.(*SellerDeadlineExtensionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1352,7 +2044,7 @@ func file_notification_proto_init() {
 			}
 		}
 		file_notification_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*NotifySellerGotAReviewRequest); i {
+			switch v := v.(*SellerCancelOrderRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1364,7 +2056,7 @@ func file_notification_proto_init() {
 			}
 		}
 		file_notification_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*NotifyBuyerOrderDeliveredRequest); i {
+			switch v := v.(*BuyerDeadlineExtension); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1376,7 +2068,7 @@ func file_notification_proto_init() {
 			}
 		}
 		file_notification_proto_msgTypes[13].Exporter = func(v any, i int) any {
-			switch v := v.(*NotifyBuyerOrderAcknowledgeRequest); i {
+			switch v := v.(*BuyerRefundsOrderRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1388,6 +2080,66 @@ func file_notification_proto_init() {
 			}
 		}
 		file_notification_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*OrderDetail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notification_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*NotifySellerGotAnOrderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notification_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*NotifySellerGotAReviewRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notification_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*NotifyBuyerOrderDeliveredRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notification_proto_msgTypes[18].Exporter = func(v any, i int) any {
+			switch v := v.(*NotifyBuyerOrderAcknowledgeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_notification_proto_msgTypes[19].Exporter = func(v any, i int) any {
 			switch v := v.(*NotifySellerBuyerResponseDeliveredOrderRequest); i {
 			case 0:
 				return &v.state
@@ -1405,13 +2157,14 @@ func file_notification_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_notification_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   15,
+			NumEnums:      1,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_notification_proto_goTypes,
 		DependencyIndexes: file_notification_proto_depIdxs,
+		EnumInfos:         file_notification_proto_enumTypes,
 		MessageInfos:      file_notification_proto_msgTypes,
 	}.Build()
 	File_notification_proto = out.File
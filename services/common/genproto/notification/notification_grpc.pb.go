@@ -20,6 +20,8 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
+	NotificationService_Publish_FullMethodName                                 = "/NotificationService/Publish"
+	NotificationService_PublishWebhookEvent_FullMethodName                     = "/NotificationService/PublishWebhookEvent"
 	NotificationService_UserVerifyingEmail_FullMethodName                      = "/NotificationService/UserVerifyingEmail"
 	NotificationService_UserForgotPassword_FullMethodName                      = "/NotificationService/UserForgotPassword"
 	NotificationService_UserSucessResetPassword_FullMethodName                 = "/NotificationService/UserSucessResetPassword"
@@ -40,13 +42,16 @@ const (
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type NotificationServiceClient interface {
-	// NOTE: From Auth Service
+	Publish(ctx context.Context, in *NotificationEvent, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	PublishWebhookEvent(ctx context.Context, in *WebhookEvent, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	//NOTE: THE RPCS BELOW ARE KEPT AS SHIMS OVER Publish WHILE SERVICES MIGRATE
+	//NOTE: From Auth Service
 	UserVerifyingEmail(ctx context.Context, in *VerifyingEmailRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UserForgotPassword(ctx context.Context, in *ForgotPasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UserSucessResetPassword(ctx context.Context, in *SuccessResetPasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	// NOTE: From Chat Service
+	//NOTE: From Chat Service
 	SendEmailChatNotification(ctx context.Context, in *EmailChatNotificationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	// NOTE: From Order Service
+	//NOTE: From Order Service
 	SellerHasCompletedAnOrder(ctx context.Context, in *SellerCompletedAnOrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SellerRequestDeadlineExtension(ctx context.Context, in *SellerDeadlineExtensionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SellerCanceledAnOrder(ctx context.Context, in *SellerCancelOrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -67,6 +72,26 @@ func NewNotificationServiceClient(cc grpc.ClientConnInterface) NotificationServi
 	return &notificationServiceClient{cc}
 }
 
+func (c *notificationServiceClient) Publish(ctx context.Context, in *NotificationEvent, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, NotificationService_Publish_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) PublishWebhookEvent(ctx context.Context, in *WebhookEvent, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, NotificationService_PublishWebhookEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *notificationServiceClient) UserVerifyingEmail(ctx context.Context, in *VerifyingEmailRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -211,13 +236,16 @@ func (c *notificationServiceClient) NotifySellerBuyerResponseDeliveredOrder(ctx
 // All implementations must embed UnimplementedNotificationServiceServer
 // for forward compatibility.
 type NotificationServiceServer interface {
-	// NOTE: From Auth Service
+	Publish(context.Context, *NotificationEvent) (*emptypb.Empty, error)
+	PublishWebhookEvent(context.Context, *WebhookEvent) (*emptypb.Empty, error)
+	//NOTE: THE RPCS BELOW ARE KEPT AS SHIMS OVER Publish WHILE SERVICES MIGRATE
+	//NOTE: From Auth Service
 	UserVerifyingEmail(context.Context, *VerifyingEmailRequest) (*emptypb.Empty, error)
 	UserForgotPassword(context.Context, *ForgotPasswordRequest) (*emptypb.Empty, error)
 	UserSucessResetPassword(context.Context, *SuccessResetPasswordRequest) (*emptypb.Empty, error)
-	// NOTE: From Chat Service
+	//NOTE: From Chat Service
 	SendEmailChatNotification(context.Context, *EmailChatNotificationRequest) (*emptypb.Empty, error)
-	// NOTE: From Order Service
+	//NOTE: From Order Service
 	SellerHasCompletedAnOrder(context.Context, *SellerCompletedAnOrderRequest) (*emptypb.Empty, error)
 	SellerRequestDeadlineExtension(context.Context, *SellerDeadlineExtensionRequest) (*emptypb.Empty, error)
 	SellerCanceledAnOrder(context.Context, *SellerCancelOrderRequest) (*emptypb.Empty, error)
@@ -238,6 +266,12 @@ type NotificationServiceServer interface {
 // pointer dereference when methods are called.
 type UnimplementedNotificationServiceServer struct{}
 
+func (UnimplementedNotificationServiceServer) Publish(context.Context, *NotificationEvent) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Publish not implemented")
+}
+func (UnimplementedNotificationServiceServer) PublishWebhookEvent(context.Context, *WebhookEvent) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishWebhookEvent not implemented")
+}
 func (UnimplementedNotificationServiceServer) UserVerifyingEmail(context.Context, *VerifyingEmailRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UserVerifyingEmail not implemented")
 }
@@ -301,6 +335,42 @@ func RegisterNotificationServiceServer(s grpc.ServiceRegistrar, srv Notification
 	s.RegisterService(&NotificationService_ServiceDesc, srv)
 }
 
+func _NotificationService_Publish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotificationEvent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).Publish(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_Publish_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).Publish(ctx, req.(*NotificationEvent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_PublishWebhookEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhookEvent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).PublishWebhookEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_PublishWebhookEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).PublishWebhookEvent(ctx, req.(*WebhookEvent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _NotificationService_UserVerifyingEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(VerifyingEmailRequest)
 	if err := dec(in); err != nil {
@@ -560,6 +630,14 @@ var NotificationService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "NotificationService",
 	HandlerType: (*NotificationServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Publish",
+			Handler:    _NotificationService_Publish_Handler,
+		},
+		{
+			MethodName: "PublishWebhookEvent",
+			Handler:    _NotificationService_PublishWebhookEvent_Handler,
+		},
 		{
 			MethodName: "UserVerifyingEmail",
 			Handler:    _NotificationService_UserVerifyingEmail_Handler,
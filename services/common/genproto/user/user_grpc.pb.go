@@ -21,8 +21,11 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	UserService_SaveBuyerData_FullMethodName       = "/UserService/SaveBuyerData"
 	UserService_FindSeller_FullMethodName          = "/UserService/FindSeller"
+	UserService_FindSellers_FullMethodName         = "/UserService/FindSellers"
 	UserService_UpdateSellerBalance_FullMethodName = "/UserService/UpdateSellerBalance"
 	UserService_FindBuyer_FullMethodName           = "/UserService/FindBuyer"
+	UserService_FindSavedGigs_FullMethodName       = "/UserService/FindSavedGigs"
+	UserService_IsBlocked_FullMethodName           = "/UserService/IsBlocked"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -31,8 +34,11 @@ const (
 type UserServiceClient interface {
 	SaveBuyerData(ctx context.Context, in *SaveBuyerRequest, opts ...grpc.CallOption) (*SaveBuyerResponse, error)
 	FindSeller(ctx context.Context, in *FindSellerRequest, opts ...grpc.CallOption) (*FindSellerResponse, error)
+	FindSellers(ctx context.Context, in *FindSellersRequest, opts ...grpc.CallOption) (*FindSellersResponse, error)
 	UpdateSellerBalance(ctx context.Context, in *UpdateSellerBalanceRequest, opts ...grpc.CallOption) (*UpdateSellerBalanceResponse, error)
 	FindBuyer(ctx context.Context, in *FindBuyerRequest, opts ...grpc.CallOption) (*FindBuyerResponse, error)
+	FindSavedGigs(ctx context.Context, in *FindSavedGigsRequest, opts ...grpc.CallOption) (*FindSavedGigsResponse, error)
+	IsBlocked(ctx context.Context, in *IsBlockedRequest, opts ...grpc.CallOption) (*IsBlockedResponse, error)
 }
 
 type userServiceClient struct {
@@ -63,6 +69,16 @@ func (c *userServiceClient) FindSeller(ctx context.Context, in *FindSellerReques
 	return out, nil
 }
 
+func (c *userServiceClient) FindSellers(ctx context.Context, in *FindSellersRequest, opts ...grpc.CallOption) (*FindSellersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindSellersResponse)
+	err := c.cc.Invoke(ctx, UserService_FindSellers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) UpdateSellerBalance(ctx context.Context, in *UpdateSellerBalanceRequest, opts ...grpc.CallOption) (*UpdateSellerBalanceResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateSellerBalanceResponse)
@@ -83,14 +99,37 @@ func (c *userServiceClient) FindBuyer(ctx context.Context, in *FindBuyerRequest,
 	return out, nil
 }
 
+func (c *userServiceClient) FindSavedGigs(ctx context.Context, in *FindSavedGigsRequest, opts ...grpc.CallOption) (*FindSavedGigsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindSavedGigsResponse)
+	err := c.cc.Invoke(ctx, UserService_FindSavedGigs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) IsBlocked(ctx context.Context, in *IsBlockedRequest, opts ...grpc.CallOption) (*IsBlockedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IsBlockedResponse)
+	err := c.cc.Invoke(ctx, UserService_IsBlocked_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
 type UserServiceServer interface {
 	SaveBuyerData(context.Context, *SaveBuyerRequest) (*SaveBuyerResponse, error)
 	FindSeller(context.Context, *FindSellerRequest) (*FindSellerResponse, error)
+	FindSellers(context.Context, *FindSellersRequest) (*FindSellersResponse, error)
 	UpdateSellerBalance(context.Context, *UpdateSellerBalanceRequest) (*UpdateSellerBalanceResponse, error)
 	FindBuyer(context.Context, *FindBuyerRequest) (*FindBuyerResponse, error)
+	FindSavedGigs(context.Context, *FindSavedGigsRequest) (*FindSavedGigsResponse, error)
+	IsBlocked(context.Context, *IsBlockedRequest) (*IsBlockedResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -107,12 +146,21 @@ func (UnimplementedUserServiceServer) SaveBuyerData(context.Context, *SaveBuyerR
 func (UnimplementedUserServiceServer) FindSeller(context.Context, *FindSellerRequest) (*FindSellerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindSeller not implemented")
 }
+func (UnimplementedUserServiceServer) FindSellers(context.Context, *FindSellersRequest) (*FindSellersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindSellers not implemented")
+}
 func (UnimplementedUserServiceServer) UpdateSellerBalance(context.Context, *UpdateSellerBalanceRequest) (*UpdateSellerBalanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateSellerBalance not implemented")
 }
 func (UnimplementedUserServiceServer) FindBuyer(context.Context, *FindBuyerRequest) (*FindBuyerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindBuyer not implemented")
 }
+func (UnimplementedUserServiceServer) FindSavedGigs(context.Context, *FindSavedGigsRequest) (*FindSavedGigsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindSavedGigs not implemented")
+}
+func (UnimplementedUserServiceServer) IsBlocked(context.Context, *IsBlockedRequest) (*IsBlockedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsBlocked not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -170,6 +218,24 @@ func _UserService_FindSeller_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_FindSellers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindSellersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).FindSellers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_FindSellers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).FindSellers(ctx, req.(*FindSellersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_UpdateSellerBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateSellerBalanceRequest)
 	if err := dec(in); err != nil {
@@ -206,6 +272,42 @@ func _UserService_FindBuyer_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_FindSavedGigs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindSavedGigsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).FindSavedGigs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_FindSavedGigs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).FindSavedGigs(ctx, req.(*FindSavedGigsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_IsBlocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IsBlockedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).IsBlocked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_IsBlocked_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).IsBlocked(ctx, req.(*IsBlockedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -221,6 +323,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FindSeller",
 			Handler:    _UserService_FindSeller_Handler,
 		},
+		{
+			MethodName: "FindSellers",
+			Handler:    _UserService_FindSellers_Handler,
+		},
 		{
 			MethodName: "UpdateSellerBalance",
 			Handler:    _UserService_UpdateSellerBalance_Handler,
@@ -229,6 +335,14 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FindBuyer",
 			Handler:    _UserService_FindBuyer_Handler,
 		},
+		{
+			MethodName: "FindSavedGigs",
+			Handler:    _UserService_FindSavedGigs_Handler,
+		},
+		{
+			MethodName: "IsBlocked",
+			Handler:    _UserService_IsBlocked_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user.proto",
@@ -0,0 +1,51 @@
+package locale
+
+import "strings"
+
+// DEFAULT is used whenever a locale is unknown or has no translation.
+const DEFAULT = "en"
+
+// NOTE: KEYS FOLLOW THE COUNTRY NAMES USERS PICK WHEN SIGNING UP
+var countryLocales = map[string]string{
+	"Indonesia":     "id",
+	"Malaysia":      "ms",
+	"Spain":         "es",
+	"Mexico":        "es",
+	"Argentina":     "es",
+	"Colombia":      "es",
+	"Chile":         "es",
+	"Peru":          "es",
+	"France":        "fr",
+	"Germany":       "de",
+	"Austria":       "de",
+	"Brazil":        "pt",
+	"Portugal":      "pt",
+	"Italy":         "it",
+	"Netherlands":   "nl",
+	"Japan":         "ja",
+	"China":         "zh",
+	"Vietnam":       "vi",
+	"Viet Nam":      "vi",
+	"Thailand":      "th",
+	"Philippines":   "en",
+	"United States": "en",
+}
+
+// FromCountry maps a country name to the locale notifications are sent in.
+func FromCountry(country string) string {
+	if l, ok := countryLocales[strings.TrimSpace(country)]; ok {
+		return l
+	}
+
+	return DEFAULT
+}
+
+// Normalize reduces a locale such as "id-ID" or "en_US" to its language.
+func Normalize(l string) string {
+	l = strings.ToLower(strings.TrimSpace(l))
+	if i := strings.IndexAny(l, "-_"); i >= 0 {
+		l = l[:i]
+	}
+
+	return l
+}
@@ -0,0 +1,77 @@
+// Package testdb opens the in-memory SQLite databases the service tests run
+// their gorm queries on, there is no Postgres in CI.
+package testdb
+
+import (
+	"database/sql/driver"
+	"strings"
+	"testing"
+	"time"
+
+	sqlite "github.com/glebarez/go-sqlite"
+	gormsqlite "github.com/glebarez/sqlite"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// TIME_FORMAT is how the driver writes a time.Time, times compared in SQL
+// must be written the same way.
+const TIME_FORMAT = "2006-01-02 15:04:05.999999999-07:00"
+
+// init registers the Postgres functions the models and queries use.
+func init() {
+	sqlite.MustRegisterScalarFunction("uuid_generate_v4", 0, func(ctx *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+		return uuid.NewString(), nil
+	})
+	sqlite.MustRegisterScalarFunction("now", 0, func(ctx *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+		return time.Now().Format(TIME_FORMAT), nil
+	})
+}
+
+// Open returns an empty database with the models migrated and the statements
+// run, in that order. It is closed when the test ends.
+func Open(t testing.TB, models []interface{}, statements ...string) *gorm.DB {
+	t.Helper()
+
+	db, err := gorm.Open(gormsqlite.Open(":memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatalf("open db: %v", err)
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("open db: %v", err)
+	}
+	//NOTE: EVERY CONNECTION TO :memory: IS A NEW DATABASE
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() {
+		sqlDB.Close()
+	})
+
+	//INFO: SQLITE ONLY TAKES A FUNCTION CALL AS A DEFAULT INSIDE PARENTHESES
+	for _, model := range models {
+		stmt := &gorm.Statement{DB: db}
+		if err := stmt.Parse(model); err != nil {
+			t.Fatalf("parse %T: %v", model, err)
+		}
+		for _, f := range stmt.Schema.Fields {
+			if strings.Contains(f.DefaultValue, "(") && !strings.HasPrefix(f.DefaultValue, "(") {
+				f.DefaultValue = "(" + f.DefaultValue + ")"
+			}
+		}
+	}
+
+	if err := db.AutoMigrate(models...); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	for _, s := range statements {
+		if err := db.Exec(s).Error; err != nil {
+			t.Fatalf("exec %q: %v", s, err)
+		}
+	}
+
+	return db
+}